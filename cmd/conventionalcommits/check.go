@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/leodido/go-conventionalcommits"
+	"github.com/leodido/go-conventionalcommits/parser"
+)
+
+// isTerminal tells whether the file is a terminal.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// scissors is the line of the git commit message template separating the commit message from the diff following it.
+const scissors = "# ------------------------ >8 ------------------------"
+
+// cleanup strips the git commit message template from the commit message, like git commit --cleanup=strip does.
+//
+// It drops everything after the scissors line and the comment lines,
+// strips the trailing white-spaces, collapses consecutive blank lines, and drops the leading and trailing ones.
+func cleanup(input []byte) []byte {
+	lines := []string{}
+	for _, line := range strings.Split(string(input), "\n") {
+		if line == scissors {
+			break
+		}
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" && (len(lines) == 0 || lines[len(lines)-1] == "") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return []byte(strings.Join(lines, "\n"))
+}
+
+// check validates the commit message, printing all the errors it contains as diagnostics.
+//
+// It exits with an error when the commit message is not valid (eg., in a commit-msg git hook).
+func check(args []string) {
+	fs := flag.NewFlagSet("check", flag.ExitOnError)
+	types := conventionalcommits.TypesConventional
+	fs.Var(&types, "types", typesUsage())
+	gitmoji := fs.Bool("gitmoji", false, "accept a gitmoji before the type")
+	color := fs.Bool("color", isTerminal(os.Stderr), "color the diagnostics")
+	fs.Parse(args)
+
+	// Git strips the comments, and the trailing newlines, from the commit messages
+	input := cleanup(read(fs.Arg(0)))
+
+	opts := []conventionalcommits.MachineOption{
+		parser.WithTypes(types),
+		parser.WithErrorRecovery(),
+	}
+	if *gitmoji {
+		opts = append(opts, parser.WithGitmoji())
+	}
+	if _, err := parser.NewMachine(opts...).Parse(input); err != nil {
+		fmt.Fprint(os.Stderr, parser.Diagnostic(input, err, *color))
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMain runs the command, in place of the tests, when the test binary executes itself (see run).
+func TestMain(m *testing.M) {
+	if os.Getenv("CONVENTIONALCOMMITS_RUN_MAIN") == "1" {
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// run executes the command with the given arguments, returning its standard error and whether it succeeded.
+func run(t *testing.T, args ...string) (string, bool) {
+	cmd := exec.Command(os.Args[0], args...)
+	cmd.Env = append(os.Environ(), "CONVENTIONALCOMMITS_RUN_MAIN=1")
+	out, err := cmd.CombinedOutput()
+	if _, ok := err.(*exec.ExitError); err != nil && !ok {
+		t.Fatal(err)
+	}
+
+	return string(out), err == nil
+}
+
+const template = `
+# Please enter the commit message for your changes. Lines starting
+# with '#' will be ignored, and an empty message aborts the commit.
+#
+# On branch main
+# Changes to be committed:
+#	modified:   check.go
+#
+# ------------------------ >8 ------------------------
+# Do not modify or remove the line above.
+# Everything below it will be ignored.
+diff --git a/check.go b/check.go
++invalid: commit message
+`
+
+func TestCleanup(t *testing.T) {
+	cases := []struct {
+		title  string
+		input  string
+		output string
+	}{
+		{"no-comments", "fix: x\n\nbody\n", "fix: x\n\nbody"},
+		{"git-template", "fix: x  \n\n\nbody\n" + template, "fix: x\n\nbody"},
+		{"comment-lines-in-body", "fix: x\n\n# comment\nbody\n\n# comment\n\nmore body\n", "fix: x\n\nbody\n\nmore body"},
+		{"leading-blank-lines", "\n\nfix: x\n", "fix: x"},
+		{"only-git-template", template, ""},
+		{"crlf-line-endings", "fix: x\r\n\r\nbody\r\n", "fix: x\n\nbody"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.title, func(t *testing.T) {
+			assert.Equal(t, tc.output, string(cleanup([]byte(tc.input))))
+		})
+	}
+}
+
+func TestCheckGitTemplate(t *testing.T) {
+	cases := []struct {
+		title string
+		input string
+		ok    bool
+	}{
+		{"valid", "fix: x\n\nbody\n\nSigned-off-by: Leo <leo@example.com>\n" + template, true},
+		{"comments-right-after-header", "fix: x" + template, true},
+		{"comments-after-breaking-change-footer", "feat!: x\n\nBREAKING CHANGE: y\n" + template, true},
+		{"invalid", "fix x\n" + template, false},
+		{"empty", template, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.title, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "COMMIT_EDITMSG")
+			if err := os.WriteFile(path, []byte(tc.input), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			out, ok := run(t, "check", "-color=false", path)
+			assert.Equal(t, tc.ok, ok, out)
+		})
+	}
+}
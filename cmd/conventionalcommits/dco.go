@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/leodido/go-conventionalcommits"
+	"github.com/leodido/go-conventionalcommits/parser"
+)
+
+// dco verifies that the commit messages are signed off by their authors.
+//
+// With the author flag, it checks the commit message in the file.
+// Otherwise, it checks the commits in the git revision range, reporting the ones failing.
+// The commit messages that are not conventional ones (eg., merge commits) get checked against their git trailers.
+// It exits with an error when any commit message fails the check.
+func dco(args []string) {
+	fs := flag.NewFlagSet("dco", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: conventionalcommits dco [flags] <revision range>\n       conventionalcommits dco -author 'Name <email>' [flags] [file]\n\n")
+		fs.PrintDefaults()
+	}
+	types := conventionalcommits.TypesConventional
+	fs.Var(&types, "types", typesUsage())
+	gitmoji := fs.Bool("gitmoji", false, "accept a gitmoji before the type")
+	author := fs.String("author", "", "the author identity ('Name <email>') of the commit message in the file")
+	fs.Parse(args)
+
+	opts := []conventionalcommits.MachineOption{parser.WithTypes(types)}
+	if *gitmoji {
+		opts = append(opts, parser.WithGitmoji())
+	}
+	verify := func(input []byte, author conventionalcommits.Person) error {
+		input = bytes.TrimRight(input, "\n")
+		res, err := parser.NewMachine(opts...).Parse(input)
+		if err != nil || res == nil {
+			// The DCO only concerns the footer trailers of the commit messages that are not conventional ones (eg., merge commits)
+			res = &conventionalcommits.ConventionalCommit{Footers: parser.ParseGitTrailers(input, "")}
+		}
+
+		return conventionalcommits.CheckDCO(res, author)
+	}
+
+	if *author != "" {
+		person, err := conventionalcommits.ParsePerson(*author)
+		if err != nil {
+			exitWithError(fmt.Sprintf("invalid author: %s", err))
+		}
+		if err := verify(read(fs.Arg(0)), person); err != nil {
+			exitWithError(err.Error())
+		}
+		return
+	}
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	// Every commit is its hash, its author name and email, and its message, NUL terminated
+	out, err := exec.Command("git", "log", "-z", "--format=%H%n%an%n%ae%n%B", fs.Arg(0), "--").Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			os.Stderr.Write(exitErr.Stderr)
+		}
+		exitWithError(err.Error())
+	}
+
+	failed := 0
+	for _, commit := range bytes.Split(out, []byte{0}) {
+		parts := bytes.SplitN(commit, []byte("\n"), 4)
+		if len(parts) < 4 {
+			continue
+		}
+		author := conventionalcommits.Person{Name: string(parts[1]), Email: string(parts[2])}
+		if err := verify(parts[3], author); err != nil {
+			fmt.Fprintf(os.Stderr, "%.12s: %s\n", parts[0], err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		exitWithError(fmt.Sprintf("%d commits fail the DCO check", failed))
+	}
+}
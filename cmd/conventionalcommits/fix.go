@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+
+	"github.com/leodido/go-conventionalcommits"
+	"github.com/leodido/go-conventionalcommits/parser"
+)
+
+// fix rewrites the commit message into its canonical form.
+//
+// When reading from a file (eg., in a prepare-commit-msg git hook), it rewrites the file in place.
+// Otherwise, it writes the result to the standard output.
+// In case the commit message can not be repaired, it exits with an error leaving the file untouched.
+func fix(args []string) {
+	fs := flag.NewFlagSet("fix", flag.ExitOnError)
+	width := fs.Int("width", 0, "wrap the body lines at the given number of columns (0 disables wrapping)")
+	types := conventionalcommits.TypesConventional
+	fs.Var(&types, "types", typesUsage())
+	gitmoji := fs.Bool("gitmoji", false, "accept a gitmoji before the type")
+	fs.Parse(args)
+
+	path := fs.Arg(0)
+	input := read(path)
+
+	opts := []conventionalcommits.MachineOption{
+		parser.WithTypes(types),
+	}
+	if *gitmoji {
+		opts = append(opts, parser.WithGitmoji())
+	}
+	res, err := parser.Fix(input, *width, opts...)
+	if err != nil {
+		exitWithError(err.Error())
+	}
+	// Keep the final newline editors and git usually add
+	if bytes.HasSuffix(input, []byte("\n")) {
+		res = append(res, '\n')
+	}
+
+	if path == "" || path == "-" {
+		os.Stdout.Write(res)
+		return
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		exitWithError(err.Error())
+	}
+	if err := os.WriteFile(path, res, info.Mode()); err != nil {
+		exitWithError(err.Error())
+	}
+}
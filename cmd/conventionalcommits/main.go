@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+
+// Command conventionalcommits works with commit messages following the Conventional Commits specification.
+//
+// Usage:
+//
+//	conventionalcommits <command> [flags] [file]
+//
+// The commands are:
+//
+//	check	validate a commit message, reporting all its errors
+//	fix	rewrite a commit message into its canonical form
+//	dco	verify that the commit messages in a git revision range are signed off by their authors
+//
+// When the file is missing (or it is "-"), the commands read the commit message from the standard input.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/leodido/go-conventionalcommits"
+)
+
+const usage = `usage: conventionalcommits <command> [flags] [file]
+
+commands:
+  check  validate a commit message, reporting all its errors
+  fix    rewrite a commit message into its canonical form
+  dco    verify that the commit messages in a git revision range are signed off by their authors
+
+Run 'conventionalcommits <command> -h' for the flags of a command.
+`
+
+func exitWithError(msg string) {
+	fmt.Fprintf(os.Stderr, "error: %s\n", msg)
+	os.Exit(1)
+}
+
+// typesUsage returns the usage of the flags about the type configuration.
+func typesUsage() string {
+	names := []string{}
+	for _, t := range conventionalcommits.TypeConfigs() {
+		names = append(names, t.String())
+	}
+
+	return fmt.Sprintf("the set of types to accept (%s)", strings.Join(names, ", "))
+}
+
+// read returns the content of the file at the given path, or the standard input when the path is empty or "-".
+func read(path string) []byte {
+	var (
+		buf []byte
+		err error
+	)
+	if path == "" || path == "-" {
+		buf, err = io.ReadAll(os.Stdin)
+	} else {
+		buf, err = os.ReadFile(path)
+	}
+	if err != nil {
+		exitWithError(err.Error())
+	}
+
+	return buf
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "check":
+		check(os.Args[2:])
+	case "fix":
+		fix(os.Args[2:])
+	case "dco":
+		dco(os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		fmt.Print(usage)
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+}
@@ -4,6 +4,8 @@ machine common;
 
 ws = ' ';
 
+tab = [\t];
+
 nl = [\n];
 
 dash = '-';
@@ -16,4 +18,23 @@ colon = 0x3A;
 
 exclamation = 0x21;
 
+# UTF-8 encoded characters (see RFC 3629)
+
+utf8_tail = 0x80..0xBF;
+
+utf8_2 = 0xC2..0xDF utf8_tail;
+
+utf8_3 = 0xE0 0xA0..0xBF utf8_tail | 0xE1..0xEC utf8_tail utf8_tail | 0xED 0x80..0x9F utf8_tail | 0xEE..0xEF utf8_tail utf8_tail;
+
+utf8_4 = 0xF0 0x90..0xBF utf8_tail utf8_tail | 0xF1..0xF3 utf8_tail utf8_tail utf8_tail | 0xF4 0x80..0x8F utf8_tail utf8_tail;
+
+# Any non-ASCII character
+utf8_nonascii = utf8_2 | utf8_3 | utf8_4;
+
+# Any valid UTF-8 character
+utf8_any = ascii | utf8_nonascii;
+
+# Printable ASCII characters plus any non-ASCII character
+utf8_print = print | utf8_nonascii;
+
 }%%
\ No newline at end of file
@@ -0,0 +1,31 @@
+%%{
+
+# Code generated by tools/typesgen from common/types.json. DO NOT EDIT.
+
+machine types;
+
+minimal_types_list = 'feat'i | 'fix'i;
+
+conventional_types_list = 'build'i | 'chore'i | 'ci'i | 'docs'i | 'feat'i | 'fix'i | 'perf'i | 'refactor'i | 'revert'i | 'style'i | 'test'i;
+
+falco_types_list = 'build'i | 'chore'i | 'ci'i | 'docs'i | 'feat'i | 'fix'i | 'new'i | 'perf'i | 'revert'i | 'rule'i | 'test'i | 'update'i;
+
+angular_types_list = 'build'i | 'ci'i | 'docs'i | 'feat'i | 'fix'i | 'perf'i | 'refactor'i | 'test'i;
+
+electron_types_list = 'build'i | 'chore'i | 'ci'i | 'docs'i | 'feat'i | 'fix'i | 'perf'i | 'refactor'i | 'style'i | 'test'i | 'vendor'i;
+
+eslint_types_list = 'breaking'i | 'build'i | 'chore'i | 'docs'i | 'fix'i | 'new'i | 'update'i | 'upgrade'i;
+
+minimal_type_aliases_list = 'bugfix'i | 'feature'i | 'hotfix'i;
+
+conventional_type_aliases_list = 'bugfix'i | 'doc'i | 'feature'i | 'hotfix'i | 'tests'i;
+
+falco_type_aliases_list = 'bugfix'i | 'doc'i | 'feature'i | 'hotfix'i | 'tests'i;
+
+angular_type_aliases_list = 'bugfix'i | 'doc'i | 'feature'i | 'hotfix'i | 'tests'i;
+
+electron_type_aliases_list = 'bugfix'i | 'doc'i | 'feature'i | 'hotfix'i | 'tests'i;
+
+eslint_type_aliases_list = 'bugfix'i | 'doc'i | 'hotfix'i;
+
+}%%
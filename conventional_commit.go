@@ -4,7 +4,9 @@
 package conventionalcommits
 
 import (
-	"github.com/sirupsen/logrus"
+	"fmt"
+	"sort"
+	"strings"
 )
 
 // TypeConfig represent the set of types the parser should use.
@@ -21,8 +23,30 @@ const (
 	TypesFalco
 	// TypesFreeForm represents a free-form set of types.
 	TypesFreeForm
+	// TypesAngular represents the set of types of the Angular convention, which also requires lowercase scopes.
+	// See https://github.com/angular/angular/blob/main/CONTRIBUTING.md#commit
+	TypesAngular
+	// TypesElectron represents the set of types that Electron uses for its pull requests.
+	// See https://github.com/electron/electron/blob/main/docs/development/pull-requests.md
+	TypesElectron
+	// TypesESLint represents the set of tags that ESLint uses (also known as the Atom/ESLint convention).
+	// See https://eslint.org/docs/latest/contribute/pull-requests#commit-messages
+	TypesESLint
+	// TypesLinuxKernel represents the Linux kernel style, where the subsystem takes the place of the type.
+	// See https://www.kernel.org/doc/html/latest/process/submitting-patches.html#the-canonical-patch-format
+	TypesLinuxKernel
 )
 
+// TypeAliases returns a copy of the map of the type aliases to their canonical types.
+func TypeAliases() map[string]string {
+	res := make(map[string]string, len(typeAliases))
+	for alias, typ := range typeAliases {
+		res[alias] = typ
+	}
+
+	return res
+}
+
 // TypeConfigurer represents parsers with the option to enable different commit message types.
 type TypeConfigurer interface {
 	WithTypes(t TypeConfig)
@@ -44,16 +68,148 @@ type BestEfforter interface {
 	HasBestEffort() bool
 }
 
+// ErrorRecoverer is an interface that wraps the methods about the error recovery mode.
+type ErrorRecoverer interface {
+	WithErrorRecovery()
+	HasErrorRecovery() bool
+}
+
+// StrictWhitespacer is an interface that wraps the methods about the strict whitespace mode.
+type StrictWhitespacer interface {
+	WithStrictWhitespace()
+	HasStrictWhitespace() bool
+}
+
+// HeaderLimiter represents parsers with the options to limit the header length and the description content.
+type HeaderLimiter interface {
+	WithHeaderMaxLength(n int)
+	WithDescriptionMinLength(n int)
+	WithoutDescriptionTrailingPeriod()
+}
+
+// FooterLimiter represents parsers with the options to customize and restrict the footer and its trailer tokens.
+type FooterLimiter interface {
+	WithFooterInAnyParagraph()
+	WithFooterTokenChars(chars string)
+	WithFooterKeys(keys ...string)
+	WithRequiredFooterKeys(keys ...string)
+	WithoutDuplicateFooterKeys()
+}
+
+// VerbatimBodier is an interface that wraps the methods about the verbatim body mode.
+type VerbatimBodier interface {
+	WithVerbatimBody()
+	HasVerbatimBody() bool
+}
+
+// ZeroCopier is an interface that wraps the methods about the zero-copy mode.
+type ZeroCopier interface {
+	WithZeroCopy()
+	HasZeroCopy() bool
+}
+
+// GitTrailerer is an interface that wraps the methods about the git trailers compatibility mode.
+type GitTrailerer interface {
+	WithGitTrailers(separators string)
+	HasGitTrailers() bool
+}
+
+// Gitmojier is an interface that wraps the methods about the gitmoji mode.
+type Gitmojier interface {
+	WithGitmoji()
+	HasGitmoji() bool
+}
+
+// TypeAliaser is an interface that wraps the methods about the type aliases mode.
+type TypeAliaser interface {
+	WithTypeAliases()
+	HasTypeAliases() bool
+}
+
+// StructuredLogger is the minimal interface the parsers need to log.
+//
+// The arguments following the message are alternating keys and values.
+// Its methods match the ones of *slog.Logger, so that a *slog.Logger can be used as is.
+type StructuredLogger interface {
+	Debug(msg string, args ...any)
+	Info(msg string, args ...any)
+	Error(msg string, args ...any)
+}
+
+// Span represents the position of a part of the input, as byte offsets.
+//
+// Start is inclusive, End is exclusive.
+type Span struct {
+	Start int
+	End   int
+}
+
+// Listener receives the events about what the parsers find, together with their positions.
+//
+// Breaking changes are notified both for the exclamation mark and for the breaking change footer token.
+// The body comes in chunks, usually one for each line.
+// Errors come with the span of the offending character, that is empty at the end of the input.
+type Listener interface {
+	OnType(value string, pos Span)
+	OnScope(value string, pos Span)
+	OnBreaking(pos Span)
+	OnDescription(value string, pos Span)
+	OnBodyChunk(value string, pos Span)
+	OnFooter(key, value string, keyPos, valuePos Span)
+	OnError(err error, pos Span)
+}
+
+// NopListener is a listener ignoring all the events.
+//
+// Embed it to implement only the callbacks you need.
+type NopListener struct{}
+
+// OnType does nothing.
+func (NopListener) OnType(string, Span) {}
+
+// OnScope does nothing.
+func (NopListener) OnScope(string, Span) {}
+
+// OnBreaking does nothing.
+func (NopListener) OnBreaking(Span) {}
+
+// OnDescription does nothing.
+func (NopListener) OnDescription(string, Span) {}
+
+// OnBodyChunk does nothing.
+func (NopListener) OnBodyChunk(string, Span) {}
+
+// OnFooter does nothing.
+func (NopListener) OnFooter(string, string, Span, Span) {}
+
+// OnError does nothing.
+func (NopListener) OnError(error, Span) {}
+
+// Listenable represents parser able to notify a listener.
+type Listenable interface {
+	WithListener(l Listener)
+}
+
 // Logger represents parser able to log.
 type Logger interface {
-	WithLogger(l *logrus.Logger)
+	WithLogger(l StructuredLogger)
 }
 
 // Machine represent a FSM able to parse a conventional commit and return it in an structured way.
 type Machine interface {
 	Parse(input []byte) (Message, error)
 	BestEfforter
+	ErrorRecoverer
+	StrictWhitespacer
+	HeaderLimiter
+	FooterLimiter
+	VerbatimBodier
+	ZeroCopier
+	GitTrailerer
+	Gitmojier
+	TypeAliaser
 	TypeConfigurer
+	Listenable
 	Logger
 }
 
@@ -61,6 +217,10 @@ type Machine interface {
 type MachineOption func(m Machine) Machine
 
 // Message represent a conventional commit message.
+//
+// It only exposes the parts of the commit message, and the predicates about them, so that it is easy to implement.
+// What derives from such parts (eg., the breaking change notes) comes from the functions of this package taking a Message.
+// The accessors of the header parts have the Get prefix since ConventionalCommit has fields with the same names.
 type Message interface {
 	Ok() bool
 	IsBreakingChange() bool
@@ -68,17 +228,58 @@ type Message interface {
 	IsFix() bool
 	VersionBump(VersionBumpStrategy) VersionBump
 	HasFooter() bool
+	GetType() string
+	GetScope() string
+	GetDescription() string
+	GetBody() string
+	GetVerbatimBody() string
+	Footer(key string) string
+	FooterValues(key string) []string
+	FooterKeys() []string
+	BreakingChangeDescription() string
+}
+
+// BreakingChangeSource represents the part of the commit message communicating a breaking change.
+type BreakingChangeSource int
+
+const (
+	// BreakingChangeHeader means the header communicates the breaking change (eg., with the exclamation mark).
+	BreakingChangeHeader BreakingChangeSource = iota
+	// BreakingChangeFooter means a breaking change footer trailer communicates the breaking change.
+	BreakingChangeFooter
+)
+
+// String returns the name of the commit message part communicating the breaking change (ie., "header", "footer").
+func (s BreakingChangeSource) String() string {
+	switch s {
+	case BreakingChangeHeader:
+		return "header"
+	case BreakingChangeFooter:
+		return "footer"
+	}
+
+	return fmt.Sprintf("BreakingChangeSource(%d)", int(s))
+}
+
+// BreakingChange represents a note explaining what a commit message breaks.
+type BreakingChange struct {
+	Note   string
+	Source BreakingChangeSource
 }
 
 // ConventionalCommit represents a commit message as per Conventional Commits specification.
 type ConventionalCommit struct {
+	Gitmoji     *string // optional
 	Type        string
+	TypeAlias   *string // optional
 	Description string
 	Scope       *string // optional
 	Exclamation bool
 	Body        *string             // optional
 	Footers     map[string][]string // optional
 	TypeConfig  TypeConfig
+	// VerbatimBody is the exact text between the blank line after the header and the footer (optional, only in verbatim body mode).
+	VerbatimBody *string
 }
 
 // VersionBumpStrategy represents a strategy how to evaluate the version bump depending on the TypeConfig initially used and the commits type.
@@ -110,7 +311,11 @@ func (c *ConventionalCommit) Ok() bool {
 func (c *ConventionalCommit) IsBreakingChange() bool {
 	_, hasBreakingChangeTrailer := c.Footers["breaking-change"]
 
-	return c.Exclamation || hasBreakingChangeTrailer
+	if c.TypeConfig == TypesESLint && c.Type == "breaking" {
+		return true
+	}
+
+	return c.Exclamation || hasBreakingChangeTrailer || c.gitmojiType() == GitmojiBreaking
 }
 
 // IsFeat tells whether the receiving commit message struct represents a feat change or not.
@@ -118,13 +323,22 @@ func (c *ConventionalCommit) IsFeat() bool {
 	if c.TypeConfig == TypesFalco && c.Type == "new" {
 		return true
 	}
+	if c.TypeConfig == TypesESLint && (c.Type == "new" || c.Type == "update") {
+		return true
+	}
 
-	return c.Type == "feat"
+	return c.Type == "feat" || c.gitmojiType() == "feat"
 }
 
 // IsFix tells whether the receiving commit message struct represents a fix change or not.
 func (c *ConventionalCommit) IsFix() bool {
-	return c.Type == "fix"
+	if c.TypeConfig == TypesLinuxKernel {
+		// Linux kernel fixes reference the commit they fix with a "Fixes:" trailer
+		_, hasFixesTrailer := c.Footers["fixes"]
+
+		return hasFixesTrailer || c.gitmojiType() == "fix"
+	}
+	return c.Type == "fix" || c.gitmojiType() == "fix"
 }
 
 // VersionBump tells which version bump the receiving commit message mandates.
@@ -140,3 +354,152 @@ func (c *ConventionalCommit) VersionBump(strategy VersionBumpStrategy) VersionBu
 func (c *ConventionalCommit) HasFooter() bool {
 	return len(c.Footers) > 0
 }
+
+// GetType returns the (canonical) type of the receiving commit message.
+func (c *ConventionalCommit) GetType() string {
+	return c.Type
+}
+
+// GetScope returns the scope of the receiving commit message, or an empty string when it has no scope.
+func (c *ConventionalCommit) GetScope() string {
+	if c.Scope == nil {
+		return ""
+	}
+
+	return *c.Scope
+}
+
+// GetDescription returns the description of the receiving commit message.
+func (c *ConventionalCommit) GetDescription() string {
+	return c.Description
+}
+
+// GetBody returns the body of the receiving commit message, or an empty string when it has no body.
+func (c *ConventionalCommit) GetBody() string {
+	if c.Body == nil {
+		return ""
+	}
+
+	return *c.Body
+}
+
+// GetVerbatimBody returns the exact body of the receiving commit message, or an empty string when it is missing.
+//
+// It is available only when the parser is in verbatim body mode.
+func (c *ConventionalCommit) GetVerbatimBody() string {
+	if c.VerbatimBody == nil {
+		return ""
+	}
+
+	return *c.VerbatimBody
+}
+
+// NormalizedBody returns the body of the commit message in a canonical form.
+//
+// It strips the carriage returns and the trailing white-spaces of every line,
+// collapses consecutive blank lines into one, and strips the leading and trailing blank lines.
+// It normalizes the verbatim body, when available.
+func NormalizedBody(m Message) string {
+	body := m.GetVerbatimBody()
+	if body == "" {
+		body = m.GetBody()
+	}
+
+	var lines []string
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" && (len(lines) == 0 || lines[len(lines)-1] == "") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Footer returns the first value of the footer trailer with the given key, or an empty string when it is missing.
+//
+// The key is case-insensitive, and "BREAKING CHANGE" is the same as "BREAKING-CHANGE".
+func (c *ConventionalCommit) Footer(key string) string {
+	values := c.FooterValues(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// FooterValues returns all the values of the footer trailers with the given key, in order of appearance.
+//
+// The key is case-insensitive, and "BREAKING CHANGE" is the same as "BREAKING-CHANGE".
+func (c *ConventionalCommit) FooterValues(key string) []string {
+	return c.Footers[footerKey(key)]
+}
+
+// FooterKeys returns the keys of the footer trailers of the receiving commit message, in alphabetical order.
+//
+// The keys are lowercase, and "breaking-change" is the key of both "BREAKING CHANGE" and "BREAKING-CHANGE".
+func (c *ConventionalCommit) FooterKeys() []string {
+	if len(c.Footers) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(c.Footers))
+	for key := range c.Footers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// BreakingChangeDescription returns what the receiving commit message breaks.
+//
+// It is the note of the first breaking change (see BreakingChanges),
+// or an empty string when the commit message is not a breaking change.
+func (c *ConventionalCommit) BreakingChangeDescription() string {
+	notes := BreakingChanges(c)
+	if len(notes) == 0 {
+		return ""
+	}
+
+	return notes[0].Note
+}
+
+// BreakingChanges returns the notes explaining what the commit message breaks, in order of appearance.
+//
+// They are the values of the breaking change footer trailers, whose token is "BREAKING CHANGE" or "BREAKING-CHANGE".
+// As per the specification, when the header communicates a breaking change (eg., "feat!: description")
+// and such trailers are missing, the note is the description.
+// It returns nil when the commit message is not a breaking change.
+func BreakingChanges(m Message) []BreakingChange {
+	var res []BreakingChange
+	for _, value := range m.FooterValues("breaking-change") {
+		res = append(res, BreakingChange{Note: value, Source: BreakingChangeFooter})
+	}
+	if len(res) == 0 && m.IsBreakingChange() {
+		res = append(res, BreakingChange{Note: m.GetDescription(), Source: BreakingChangeHeader})
+	}
+
+	return res
+}
+
+// footerKey returns the key of the footer trailer in the form the parsers store it.
+func footerKey(key string) string {
+	key = strings.ToLower(key)
+	if key == "breaking change" {
+		return "breaking-change"
+	}
+
+	return key
+}
+
+func (c *ConventionalCommit) gitmojiType() string {
+	if c.Gitmoji == nil {
+		return ""
+	}
+
+	return GitmojiType(*c.Gitmoji)
+}
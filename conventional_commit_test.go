@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package conventionalcommits
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypeAliases(t *testing.T) {
+	aliases := TypeAliases()
+	assert.Equal(t, "feat", aliases["feature"])
+	assert.Equal(t, "fix", aliases["bugfix"])
+
+	// Changing the returned map does not change the type aliases
+	aliases["feature"] = "fix"
+	delete(aliases, "bugfix")
+	assert.Equal(t, "feat", TypeAliases()["feature"])
+	assert.Equal(t, "fix", TypeAliases()["bugfix"])
+}
+
+func TestNormalizedBody(t *testing.T) {
+	body := "  first line  \r\n\r\n\r\nsecond line"
+	verbatim := "  first line  \r\n\r\n\r\nsecond line\t\n\n\nthird line\n\n"
+	assert.Equal(t, "", NormalizedBody(&ConventionalCommit{}))
+	assert.Equal(t, "  first line\n\nsecond line", NormalizedBody(&ConventionalCommit{Body: &body}))
+	// The verbatim body, when available, is the one to normalize
+	assert.Equal(t, "  first line\n\nsecond line\n\nthird line", NormalizedBody(&ConventionalCommit{Body: &body, VerbatimBody: &verbatim}))
+}
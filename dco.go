@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package conventionalcommits
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CheckDCO verifies that the commit message complies with the Developer Certificate of Origin (DCO).
+//
+// It does when one of its Signed-off-by footer trailers has the email address of the author, ignoring the case.
+// The commit message can have other sign-offs (eg., of the committer), and sign-offs that are not valid identities.
+// It returns an error for a nil commit message.
+// See https://developercertificate.org
+func CheckDCO(m Message, author Person) error {
+	if c, ok := m.(*ConventionalCommit); m == nil || ok && c == nil {
+		return fmt.Errorf("missing commit message to check for a Signed-off-by footer trailer for %s", author)
+	}
+	for _, value := range m.FooterValues("signed-off-by") {
+		p, err := ParsePerson(value)
+		if err == nil && strings.EqualFold(p.Email, author.Email) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("missing Signed-off-by footer trailer for %s", author)
+}
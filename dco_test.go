@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package conventionalcommits
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckDCO(t *testing.T) {
+	author := Person{Name: "Jane Doe", Email: "jane@example.com"}
+	tests := []struct {
+		title   string
+		message Message
+		err     string
+	}{
+		{
+			"sign-off",
+			&ConventionalCommit{Footers: map[string][]string{"signed-off-by": {"Jane Doe <jane@example.com>"}}},
+			"",
+		},
+		{
+			"sign-off-with-different-case",
+			&ConventionalCommit{Footers: map[string][]string{"signed-off-by": {"J. Doe <JANE@Example.COM>"}}},
+			"",
+		},
+		{
+			"many-sign-offs",
+			&ConventionalCommit{Footers: map[string][]string{"signed-off-by": {"John Doe <john@example.com>", "invalid", "Jane Doe <jane@example.com>"}}},
+			"",
+		},
+		{
+			"no-footer",
+			&ConventionalCommit{},
+			"missing Signed-off-by footer trailer for Jane Doe <jane@example.com>",
+		},
+		{
+			"other-trailer",
+			&ConventionalCommit{Footers: map[string][]string{"co-authored-by": {"Jane Doe <jane@example.com>"}}},
+			"missing Signed-off-by footer trailer for Jane Doe <jane@example.com>",
+		},
+		{
+			"sign-off-of-someone-else",
+			&ConventionalCommit{Footers: map[string][]string{"signed-off-by": {"John Doe <john@example.com>"}}},
+			"missing Signed-off-by footer trailer for Jane Doe <jane@example.com>",
+		},
+		{
+			"nil",
+			nil,
+			"missing commit message to check for a Signed-off-by footer trailer for Jane Doe <jane@example.com>",
+		},
+		{
+			"nil-conventional-commit",
+			(*ConventionalCommit)(nil),
+			"missing commit message to check for a Signed-off-by footer trailer for Jane Doe <jane@example.com>",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.title, func(t *testing.T) {
+			err := CheckDCO(tc.message, author)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package conventionalcommits
+
+import (
+	_ "embed"
+	"encoding/json"
+)
+
+// JSONSchema is the JSON Schema describing the JSON encoding of the commit messages.
+//
+//go:embed conventional_commit.schema.json
+var JSONSchema []byte
+
+// encodedConventionalCommit is the (JSON and YAML) wire format of a commit message.
+//
+// The breaking and bump fields are derived from the others, thus they are ignored when decoding.
+type encodedConventionalCommit struct {
+	Gitmoji      *string             `json:"gitmoji,omitempty" yaml:"gitmoji,omitempty"`
+	Type         string              `json:"type" yaml:"type"`
+	TypeAlias    *string             `json:"type_alias,omitempty" yaml:"type_alias,omitempty"`
+	Scope        *string             `json:"scope,omitempty" yaml:"scope,omitempty"`
+	Exclamation  bool                `json:"exclamation" yaml:"exclamation"`
+	Description  string              `json:"description" yaml:"description"`
+	Body         *string             `json:"body,omitempty" yaml:"body,omitempty"`
+	VerbatimBody *string             `json:"verbatim_body,omitempty" yaml:"verbatim_body,omitempty"`
+	Footers      map[string][]string `json:"footers,omitempty" yaml:"footers,omitempty"`
+	TypeConfig   string              `json:"type_config" yaml:"type_config"`
+	Breaking     bool                `json:"breaking" yaml:"breaking"`
+	Bump         string              `json:"bump" yaml:"bump"`
+}
+
+func (c *ConventionalCommit) encode() *encodedConventionalCommit {
+	return &encodedConventionalCommit{
+		Gitmoji:      c.Gitmoji,
+		Type:         c.Type,
+		TypeAlias:    c.TypeAlias,
+		Scope:        c.Scope,
+		Exclamation:  c.Exclamation,
+		Description:  c.Description,
+		Body:         c.Body,
+		VerbatimBody: c.VerbatimBody,
+		Footers:      c.Footers,
+		TypeConfig:   c.TypeConfig.String(),
+		Breaking:     c.IsBreakingChange(),
+		Bump:         c.VersionBump(DefaultStrategy).String(),
+	}
+}
+
+func (c *ConventionalCommit) decode(e *encodedConventionalCommit) error {
+	typeConfig := TypesMinimal
+	if e.TypeConfig != "" {
+		var err error
+		if typeConfig, err = ParseTypeConfig(e.TypeConfig); err != nil {
+			return err
+		}
+	}
+
+	*c = ConventionalCommit{
+		Gitmoji:      e.Gitmoji,
+		Type:         e.Type,
+		TypeAlias:    e.TypeAlias,
+		Scope:        e.Scope,
+		Exclamation:  e.Exclamation,
+		Description:  e.Description,
+		Body:         e.Body,
+		Footers:      e.Footers,
+		TypeConfig:   typeConfig,
+		VerbatimBody: e.VerbatimBody,
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the receiving commit message as JSON, including whether it is a breaking change and its version bump.
+func (c *ConventionalCommit) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.encode())
+}
+
+// UnmarshalJSON decodes the JSON commit message into the receiver.
+func (c *ConventionalCommit) UnmarshalJSON(data []byte) error {
+	e := &encodedConventionalCommit{}
+	if err := json.Unmarshal(data, e); err != nil {
+		return err
+	}
+
+	return c.decode(e)
+}
+
+// MarshalYAML encodes the receiving commit message as YAML, including whether it is a breaking change and its version bump.
+//
+// It works with the gopkg.in/yaml.v2 and gopkg.in/yaml.v3 packages.
+func (c *ConventionalCommit) MarshalYAML() (interface{}, error) {
+	return c.encode(), nil
+}
+
+// UnmarshalYAML decodes the YAML commit message into the receiver.
+//
+// It works with the gopkg.in/yaml.v2 and gopkg.in/yaml.v3 packages.
+func (c *ConventionalCommit) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	e := &encodedConventionalCommit{}
+	if err := unmarshal(e); err != nil {
+		return err
+	}
+
+	return c.decode(e)
+}
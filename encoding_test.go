@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package conventionalcommits
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestJSONEncoding(t *testing.T) {
+	scope, body := "api", "body"
+	res := &ConventionalCommit{
+		Type:        "feat",
+		Scope:       &scope,
+		Exclamation: true,
+		Description: "new endpoint",
+		Body:        &body,
+		Footers:     map[string][]string{"reviewed-by": {"Leo"}},
+		TypeConfig:  TypesConventional,
+	}
+
+	data, err := json.Marshal(res)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"type": "feat",
+		"scope": "api",
+		"exclamation": true,
+		"description": "new endpoint",
+		"body": "body",
+		"footers": {"reviewed-by": ["Leo"]},
+		"type_config": "conventional",
+		"breaking": true,
+		"bump": "major"
+	}`, string(data))
+
+	decoded := &ConventionalCommit{}
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, res, decoded)
+}
+
+func TestJSONDecoding(t *testing.T) {
+	decoded := &ConventionalCommit{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"type": "fix", "description": "x", "type_config": "falco", "bump": "major"}`), decoded))
+	assert.Equal(t, &ConventionalCommit{Type: "fix", Description: "x", TypeConfig: TypesFalco}, decoded)
+	assert.Equal(t, PatchVersion, decoded.VersionBump(nil))
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"type": "fix", "description": "x"}`), decoded))
+	assert.Equal(t, TypesMinimal, decoded.TypeConfig)
+
+	assert.EqualError(t, json.Unmarshal([]byte(`{"type": "fix", "description": "x", "type_config": "nope"}`), decoded), `unknown type config "nope"`)
+}
+
+func TestYAMLEncoding(t *testing.T) {
+	gitmoji, alias := ":bug:", "bugfix"
+	res := &ConventionalCommit{
+		Gitmoji:     &gitmoji,
+		Type:        "fix",
+		TypeAlias:   &alias,
+		Description: "crash",
+		TypeConfig:  TypesMinimal,
+	}
+
+	data, err := yaml.Marshal(res)
+	assert.NoError(t, err)
+	assert.Equal(t, `gitmoji: ':bug:'
+type: fix
+type_alias: bugfix
+exclamation: false
+description: crash
+type_config: minimal
+breaking: false
+bump: patch
+`, string(data))
+
+	decoded := &ConventionalCommit{}
+	assert.NoError(t, yaml.Unmarshal(data, decoded))
+	assert.Equal(t, res, decoded)
+}
+
+func TestJSONSchema(t *testing.T) {
+	var schema struct {
+		Properties map[string]struct {
+			Enum []string `json:"enum"`
+		} `json:"properties"`
+		Required []string `json:"required"`
+	}
+	assert.NoError(t, json.Unmarshal(JSONSchema, &schema))
+
+	// The enums list all the names
+	configs := []string{}
+	for _, c := range TypeConfigs() {
+		configs = append(configs, c.String())
+	}
+	assert.Equal(t, configs, schema.Properties["type_config"].Enum)
+	bumps := []string{}
+	for _, v := range []VersionBump{UnknownVersion, PatchVersion, MinorVersion, MajorVersion} {
+		bumps = append(bumps, v.String())
+	}
+	assert.Equal(t, bumps, schema.Properties["bump"].Enum)
+
+	gitmoji, alias, scope, body := "✨", "feature", "ui", "body"
+	data, err := json.Marshal(&ConventionalCommit{
+		Gitmoji:      &gitmoji,
+		Type:         "feat",
+		TypeAlias:    &alias,
+		Scope:        &scope,
+		Description:  "x",
+		Body:         &body,
+		Footers:      map[string][]string{"fixes": {"#1"}},
+		VerbatimBody: &body,
+	})
+	assert.NoError(t, err)
+	var encoded map[string]interface{}
+	assert.NoError(t, json.Unmarshal(data, &encoded))
+
+	// The schema describes all the fields, and only them
+	for key := range encoded {
+		assert.Contains(t, schema.Properties, key)
+	}
+	for key := range schema.Properties {
+		assert.Contains(t, encoded, key)
+	}
+	for _, key := range schema.Required {
+		assert.Contains(t, encoded, key)
+	}
+}
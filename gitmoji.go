@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package conventionalcommits
+
+import (
+	"strings"
+)
+
+// GitmojiBreaking is the meaning of the gitmojis introducing breaking changes.
+const GitmojiBreaking = "breaking"
+
+// Gitmojis maps the well-known gitmojis, both as shortcodes and as Unicode emojis, to the commit types they stand for.
+//
+// See https://gitmoji.dev
+var Gitmojis = map[string]string{
+	":sparkles:":            "feat",
+	"✨":                     "feat",
+	":bug:":                 "fix",
+	"🐛":                     "fix",
+	":ambulance:":           "fix",
+	"🚑":                     "fix",
+	":boom:":                GitmojiBreaking,
+	"💥":                     GitmojiBreaking,
+	":memo:":                "docs",
+	"📝":                     "docs",
+	":art:":                 "style",
+	"🎨":                     "style",
+	":zap:":                 "perf",
+	"⚡":                     "perf",
+	":recycle:":             "refactor",
+	"♻":                     "refactor",
+	":white_check_mark:":    "test",
+	"✅":                     "test",
+	":construction_worker:": "ci",
+	"👷":                     "ci",
+	":green_heart:":         "ci",
+	"💚":                     "ci",
+	":package:":             "build",
+	"📦":                     "build",
+	":rewind:":              "revert",
+	"⏪":                     "revert",
+	":wrench:":              "chore",
+	"🔧":                     "chore",
+}
+
+// GitmojiType returns the commit type the input gitmoji stands for.
+//
+// It returns an empty string for unknown gitmojis.
+func GitmojiType(gitmoji string) string {
+	// Ignore the variation selector-16 (U+FE0F) some emojis come with (eg., ⚡️)
+	return Gitmojis[strings.TrimSuffix(gitmoji, "\ufe0f")]
+}
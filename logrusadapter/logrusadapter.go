@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+
+// Package logrusadapter makes a logrus logger usable by the conventional commits parsers.
+//
+// It lives in its own package, so that only who needs it depends on logrus.
+package logrusadapter
+
+import (
+	"fmt"
+
+	"github.com/leodido/go-conventionalcommits"
+	"github.com/sirupsen/logrus"
+)
+
+type logger struct {
+	l *logrus.Logger
+}
+
+// New adapts the given logrus logger to the conventionalcommits.StructuredLogger interface.
+//
+// The key/value pairs become logrus fields.
+func New(l *logrus.Logger) conventionalcommits.StructuredLogger {
+	return &logger{l: l}
+}
+
+func (a *logger) entry(args []any) *logrus.Entry {
+	fields := make(logrus.Fields, len(args)/2)
+	for i := 0; i < len(args); i += 2 {
+		if i+1 == len(args) {
+			// Missing value, use the same key slog uses
+			fields["!BADKEY"] = args[i]
+			break
+		}
+		fields[fmt.Sprint(args[i])] = args[i+1]
+	}
+
+	return a.l.WithFields(fields)
+}
+
+// Debug logs the message at the debug level.
+func (a *logger) Debug(msg string, args ...any) {
+	if a.l.IsLevelEnabled(logrus.DebugLevel) {
+		a.entry(args).Debug(msg)
+	}
+}
+
+// Info logs the message at the info level.
+func (a *logger) Info(msg string, args ...any) {
+	if a.l.IsLevelEnabled(logrus.InfoLevel) {
+		a.entry(args).Info(msg)
+	}
+}
+
+// Error logs the message at the error level.
+func (a *logger) Error(msg string, args ...any) {
+	if a.l.IsLevelEnabled(logrus.ErrorLevel) {
+		a.entry(args).Error(msg)
+	}
+}
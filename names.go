@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package conventionalcommits
+
+import (
+	"fmt"
+	"strings"
+)
+
+var typeConfigNames = []string{
+	TypesMinimal:      "minimal",
+	TypesConventional: "conventional",
+	TypesFalco:        "falco",
+	TypesFreeForm:     "freeform",
+	TypesAngular:      "angular",
+	TypesElectron:     "electron",
+	TypesESLint:       "eslint",
+	TypesLinuxKernel:  "linuxkernel",
+}
+
+// TypeDescription is a commit message type with a short human-readable description of its meaning.
+type TypeDescription struct {
+	Name        string
+	Description string
+}
+
+var versionBumpNames = []string{
+	UnknownVersion: "unknown",
+	PatchVersion:   "patch",
+	MinorVersion:   "minor",
+	MajorVersion:   "major",
+}
+
+// TypeConfigs returns all the known type configurations.
+func TypeConfigs() []TypeConfig {
+	res := make([]TypeConfig, len(typeConfigNames))
+	for i := range typeConfigNames {
+		res[i] = TypeConfig(i)
+	}
+
+	return res
+}
+
+// Types returns the commit message types the type configuration accepts, in alphabetical order.
+//
+// It returns nil for the type configurations accepting any type (ie., free-form, Linux kernel).
+func (t TypeConfig) Types() []string {
+	list, ok := typeDescriptions[t]
+	if !ok {
+		return nil
+	}
+	res := make([]string, len(list))
+	for i, d := range list {
+		res[i] = d.Name
+	}
+
+	return res
+}
+
+// TypeDescriptions returns the commit message types the type configuration accepts, with their descriptions, in alphabetical order.
+//
+// It returns nil for the type configurations accepting any type (ie., free-form, Linux kernel).
+func (t TypeConfig) TypeDescriptions() []TypeDescription {
+	list, ok := typeDescriptions[t]
+	if !ok {
+		return nil
+	}
+
+	return append([]TypeDescription{}, list...)
+}
+
+// String returns the name of the type configuration (eg., "conventional").
+func (t TypeConfig) String() string {
+	if t < 0 || int(t) >= len(typeConfigNames) {
+		return fmt.Sprintf("TypeConfig(%d)", int(t))
+	}
+
+	return typeConfigNames[t]
+}
+
+// ParseTypeConfig returns the type configuration with the given name, ignoring its case.
+func ParseTypeConfig(s string) (TypeConfig, error) {
+	for i, name := range typeConfigNames {
+		if strings.EqualFold(s, name) {
+			return TypeConfig(i), nil
+		}
+	}
+
+	return TypesMinimal, fmt.Errorf("unknown type config %q", s)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t TypeConfig) MarshalText() ([]byte, error) {
+	if t < 0 || int(t) >= len(typeConfigNames) {
+		return nil, fmt.Errorf("unknown type config %d", int(t))
+	}
+
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *TypeConfig) UnmarshalText(text []byte) error {
+	return t.Set(string(text))
+}
+
+// Set implements flag.Value, so that a TypeConfig can be a command line flag.
+func (t *TypeConfig) Set(s string) error {
+	res, err := ParseTypeConfig(s)
+	if err != nil {
+		return err
+	}
+	*t = res
+
+	return nil
+}
+
+// String returns the name of the version bump (eg., "minor").
+func (v VersionBump) String() string {
+	if v < 0 || int(v) >= len(versionBumpNames) {
+		return fmt.Sprintf("VersionBump(%d)", int(v))
+	}
+
+	return versionBumpNames[v]
+}
+
+// ParseVersionBump returns the version bump with the given name, ignoring its case.
+func ParseVersionBump(s string) (VersionBump, error) {
+	for i, name := range versionBumpNames {
+		if strings.EqualFold(s, name) {
+			return VersionBump(i), nil
+		}
+	}
+
+	return UnknownVersion, fmt.Errorf("unknown version bump %q", s)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v VersionBump) MarshalText() ([]byte, error) {
+	if v < 0 || int(v) >= len(versionBumpNames) {
+		return nil, fmt.Errorf("unknown version bump %d", int(v))
+	}
+
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *VersionBump) UnmarshalText(text []byte) error {
+	return v.Set(string(text))
+}
+
+// Set implements flag.Value, so that a VersionBump can be a command line flag.
+func (v *VersionBump) Set(s string) error {
+	res, err := ParseVersionBump(s)
+	if err != nil {
+		return err
+	}
+	*v = res
+
+	return nil
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package conventionalcommits
+
+import (
+	"encoding/json"
+	"flag"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypeConfigNames(t *testing.T) {
+	for _, c := range TypeConfigs() {
+		parsed, err := ParseTypeConfig(strings.ToUpper(c.String()))
+		assert.NoError(t, err)
+		assert.Equal(t, c, parsed)
+	}
+	assert.Len(t, TypeConfigs(), 8)
+	assert.Equal(t, "conventional", TypesConventional.String())
+	assert.Equal(t, "TypeConfig(42)", TypeConfig(42).String())
+
+	_, err := ParseTypeConfig("nope")
+	assert.EqualError(t, err, `unknown type config "nope"`)
+
+	_, err = TypeConfig(42).MarshalText()
+	assert.Error(t, err)
+
+	var config struct {
+		Types TypeConfig `json:"types"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(`{"types": "falco"}`), &config))
+	assert.Equal(t, TypesFalco, config.Types)
+	data, err := json.Marshal(config)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"types":"falco"}`, string(data))
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	types := TypesMinimal
+	fs.Var(&types, "types", "")
+	assert.NoError(t, fs.Parse([]string{"-types", "eslint"}))
+	assert.Equal(t, TypesESLint, types)
+}
+
+func TestTypeConfigTypes(t *testing.T) {
+	assert.Equal(t, []string{"feat", "fix"}, TypesMinimal.Types())
+	assert.Contains(t, TypesConventional.Types(), "refactor")
+	assert.Nil(t, TypesFreeForm.Types())
+	assert.Nil(t, TypesLinuxKernel.Types())
+}
+
+func TestTypeConfigTypeDescriptions(t *testing.T) {
+	assert.Equal(t, []TypeDescription{
+		{Name: "feat", Description: "A new feature"},
+		{Name: "fix", Description: "A bug fix"},
+	}, TypesMinimal.TypeDescriptions())
+	assert.Nil(t, TypesFreeForm.TypeDescriptions())
+	assert.Nil(t, TypesLinuxKernel.TypeDescriptions())
+
+	for _, c := range TypeConfigs() {
+		descriptions := c.TypeDescriptions()
+		types := c.Types()
+		if assert.Len(t, descriptions, len(types), c.String()) {
+			for i, d := range descriptions {
+				assert.Equal(t, types[i], d.Name)
+				assert.NotEmpty(t, d.Description, "%s: %s", c, d.Name)
+			}
+		}
+	}
+
+	// The returned list is a copy
+	descriptions := TypesMinimal.TypeDescriptions()
+	descriptions[0].Name = "changed"
+	assert.Equal(t, "feat", TypesMinimal.TypeDescriptions()[0].Name)
+}
+
+func TestVersionBumpNames(t *testing.T) {
+	for _, v := range []VersionBump{UnknownVersion, PatchVersion, MinorVersion, MajorVersion} {
+		parsed, err := ParseVersionBump(v.String())
+		assert.NoError(t, err)
+		assert.Equal(t, v, parsed)
+	}
+	assert.Equal(t, "minor", MinorVersion.String())
+
+	_, err := ParseVersionBump("huge")
+	assert.EqualError(t, err, `unknown version bump "huge"`)
+
+	var bump VersionBump
+	assert.NoError(t, bump.UnmarshalText([]byte("Major")))
+	assert.Equal(t, MajorVersion, bump)
+	text, err := bump.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "major", string(text))
+}
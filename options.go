@@ -3,10 +3,6 @@
 // Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
 package conventionalcommits
 
-import (
-	"github.com/sirupsen/logrus"
-)
-
 // WithBestEffort ...
 func WithBestEffort() MachineOption {
 	return func(m Machine) Machine {
@@ -16,6 +12,141 @@ func WithBestEffort() MachineOption {
 	}
 }
 
+// WithErrorRecovery ...
+func WithErrorRecovery() MachineOption {
+	return func(m Machine) Machine {
+		m.(ErrorRecoverer).WithErrorRecovery()
+
+		return m
+	}
+}
+
+// WithStrictWhitespace ...
+func WithStrictWhitespace() MachineOption {
+	return func(m Machine) Machine {
+		m.(StrictWhitespacer).WithStrictWhitespace()
+
+		return m
+	}
+}
+
+// WithGitmoji ...
+func WithGitmoji() MachineOption {
+	return func(m Machine) Machine {
+		m.(Gitmojier).WithGitmoji()
+
+		return m
+	}
+}
+
+// WithTypeAliases ...
+func WithTypeAliases() MachineOption {
+	return func(m Machine) Machine {
+		m.(TypeAliaser).WithTypeAliases()
+
+		return m
+	}
+}
+
+// WithHeaderMaxLength ...
+func WithHeaderMaxLength(n int) MachineOption {
+	return func(m Machine) Machine {
+		m.(HeaderLimiter).WithHeaderMaxLength(n)
+
+		return m
+	}
+}
+
+// WithDescriptionMinLength ...
+func WithDescriptionMinLength(n int) MachineOption {
+	return func(m Machine) Machine {
+		m.(HeaderLimiter).WithDescriptionMinLength(n)
+
+		return m
+	}
+}
+
+// WithoutDescriptionTrailingPeriod ...
+func WithoutDescriptionTrailingPeriod() MachineOption {
+	return func(m Machine) Machine {
+		m.(HeaderLimiter).WithoutDescriptionTrailingPeriod()
+
+		return m
+	}
+}
+
+// WithVerbatimBody ...
+func WithVerbatimBody() MachineOption {
+	return func(m Machine) Machine {
+		m.(VerbatimBodier).WithVerbatimBody()
+
+		return m
+	}
+}
+
+// WithZeroCopy ...
+func WithZeroCopy() MachineOption {
+	return func(m Machine) Machine {
+		m.(ZeroCopier).WithZeroCopy()
+
+		return m
+	}
+}
+
+// WithFooterInAnyParagraph ...
+func WithFooterInAnyParagraph() MachineOption {
+	return func(m Machine) Machine {
+		m.(FooterLimiter).WithFooterInAnyParagraph()
+
+		return m
+	}
+}
+
+// WithFooterTokenChars ...
+func WithFooterTokenChars(chars string) MachineOption {
+	return func(m Machine) Machine {
+		m.(FooterLimiter).WithFooterTokenChars(chars)
+
+		return m
+	}
+}
+
+// WithFooterKeys ...
+func WithFooterKeys(keys ...string) MachineOption {
+	return func(m Machine) Machine {
+		m.(FooterLimiter).WithFooterKeys(keys...)
+
+		return m
+	}
+}
+
+// WithRequiredFooterKeys ...
+func WithRequiredFooterKeys(keys ...string) MachineOption {
+	return func(m Machine) Machine {
+		m.(FooterLimiter).WithRequiredFooterKeys(keys...)
+
+		return m
+	}
+}
+
+// WithoutDuplicateFooterKeys ...
+func WithoutDuplicateFooterKeys() MachineOption {
+	return func(m Machine) Machine {
+		m.(FooterLimiter).WithoutDuplicateFooterKeys()
+
+		return m
+	}
+}
+
+// WithGitTrailers ...
+func WithGitTrailers(separators string) MachineOption {
+	return func(m Machine) Machine {
+		m.(GitTrailerer).WithGitTrailers(separators)
+
+		return m
+	}
+}
+
 // WithTypes ...
 func WithTypes(t TypeConfig) MachineOption {
 	return func(m Machine) Machine {
@@ -25,8 +156,17 @@ func WithTypes(t TypeConfig) MachineOption {
 	}
 }
 
+// WithListener ...
+func WithListener(l Listener) MachineOption {
+	return func(m Machine) Machine {
+		m.(Listenable).WithListener(l)
+
+		return m
+	}
+}
+
 // WithLogger ...
-func WithLogger(l *logrus.Logger) MachineOption {
+func WithLogger(l StructuredLogger) MachineOption {
 	return func(m Machine) Machine {
 		m.(Logger).WithLogger(l)
 
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package parser
+
+// bodyBuilder accumulates the body content, avoiding to copy it while it is a contiguous part of the input.
+//
+// The body content comes in many small pieces, so that concatenating them would take quadratic time.
+// Instead, the builder extends the span of the input the body covers.
+// Only in case a piece does not follow the previous ones (eg., after a rewind),
+// the builder copies the content into a buffer that it grows geometrically.
+type bodyBuilder struct {
+	start, end int
+	buf        []byte
+}
+
+// reset empties the receiving body builder.
+func (b *bodyBuilder) reset() {
+	b.start, b.end = 0, 0
+	b.buf = nil
+}
+
+// empty tells whether the receiving body builder has no content.
+func (b *bodyBuilder) empty() bool {
+	return b.buf == nil && b.start == b.end
+}
+
+// contiguous tells whether the content of the receiving body builder is the input from its start to its end.
+func (b *bodyBuilder) contiguous() bool {
+	return b.buf == nil
+}
+
+// add appends the input from the given start to the given end to the body content.
+func (b *bodyBuilder) add(data []byte, start, end int) {
+	switch {
+	case start >= end:
+		return
+	case b.empty():
+		b.start, b.end = start, end
+	case b.contiguous() && start == b.end:
+		b.end = end
+	default:
+		b.copy(data)
+		b.buf = append(b.buf, data[start:end]...)
+	}
+}
+
+// addNewlines appends the given number of newlines to the body content.
+//
+// The newlines usually are the ones of the input preceding the given position, so that the content stays contiguous.
+func (b *bodyBuilder) addNewlines(data []byte, at, n int) {
+	if n <= 0 {
+		return
+	}
+	contiguous := at-n >= 0
+	for i := at - n; contiguous && i < at; i++ {
+		contiguous = data[i] == 10
+	}
+	if contiguous {
+		b.add(data, at-n, at)
+
+		return
+	}
+	b.copy(data)
+	for ; n > 0; n-- {
+		b.buf = append(b.buf, 10)
+	}
+}
+
+// copy moves the body content into the buffer, once.
+func (b *bodyBuilder) copy(data []byte) {
+	if b.buf == nil {
+		b.buf = make([]byte, 0, 2*(b.end-b.start)+64)
+		b.buf = append(b.buf, data[b.start:b.end]...)
+	}
+}
@@ -10,13 +10,16 @@ import (
 )
 
 type conventionalCommit struct {
+	gitmoji     string
 	_type       string
 	descr       string
 	scope       string
 	exclamation bool
 	body        string
+	verbatim    *string
 	footers     map[string][]string
 	typeconfig  conventionalcommits.TypeConfig
+	typealiases bool
 }
 
 func (c *conventionalCommit) minimal() bool {
@@ -27,8 +30,17 @@ func (c *conventionalCommit) export() conventionalcommits.Message {
 	out := &conventionalcommits.ConventionalCommit{}
 	out.Exclamation = c.exclamation
 	out.Type = strings.ToLower(c._type)
+	if c.typealiases && c.typeconfig != conventionalcommits.TypesLinuxKernel {
+		if canonical, ok := conventionalcommits.TypeAliases()[out.Type]; ok {
+			out.Type = canonical
+			out.TypeAlias = &c._type
+		}
+	}
 	out.Description = c.descr
 	out.TypeConfig = c.typeconfig
+	if c.gitmoji != "" {
+		out.Gitmoji = &c.gitmoji
+	}
 	if c.scope != "" {
 		c.scope = strings.ToLower(c.scope)
 		out.Scope = &c.scope
@@ -43,6 +55,7 @@ func (c *conventionalCommit) export() conventionalcommits.Message {
 	if len(c.footers) > 0 {
 		out.Footers = c.footers
 	}
+	out.VerbatimBody = c.verbatim
 
 	return out
 }
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package parser
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// sections maps the error templates to the name of the commit message part they are about.
+var sections = map[string]string{
+	ErrEmpty:                         "header",
+	ErrEarly:                         "header",
+	ErrColon:                         "header",
+	ErrHeaderMaxLength:               "header",
+	ErrGitmoji:                       "gitmoji",
+	ErrGitmojiIncomplete:             "gitmoji",
+	ErrType:                          "type",
+	ErrTypeIncomplete:                "type",
+	ErrScope:                         "scope",
+	ErrScopeIncomplete:               "scope",
+	ErrDescriptionInit:               "description",
+	ErrDescription:                   "description",
+	ErrDescriptionWhitespace:         "description",
+	ErrDescriptionTrailingWhitespace: "description",
+	ErrDescriptionTab:                "description",
+	ErrDescriptionMinLength:          "description",
+	ErrDescriptionTrailingPeriod:     "description",
+	ErrDescriptionEncoding:           "description",
+	ErrNewline:                       "description",
+	ErrMissingBlankLineAtBeginning:   "body",
+	ErrTrailer:                       "footer",
+	ErrTrailerIncomplete:             "footer",
+	ErrTrailerTokenNotAllowed:        "footer",
+	ErrTrailerTokenDuplicate:         "footer",
+	ErrTrailerTokenMissing:           "footer",
+}
+
+// hints maps the error templates to a suggestion about how to fix them.
+var hints = map[string]string{
+	ErrEmpty:                         "write a header like 'fix: description'",
+	ErrEarly:                         "complete the header with a colon and a description",
+	ErrColon:                         "separate the type (and the optional scope) from the description with a colon",
+	ErrHeaderMaxLength:               "shorten the header, moving the details to the body",
+	ErrGitmoji:                       "use a valid gitmoji, either a shortcode (eg., :sparkles:) or an emoji",
+	ErrGitmojiIncomplete:             "use a valid gitmoji, either a shortcode (eg., :sparkles:) or an emoji",
+	ErrType:                          "start the header with a valid commit message type",
+	ErrTypeIncomplete:                "start the header with a valid commit message type",
+	ErrScope:                         "enclose the scope in parentheses, on the header line",
+	ErrScopeIncomplete:               "close the scope with a parenthesis",
+	ErrDescriptionInit:               "put a white-space after the colon",
+	ErrDescription:                   "write a description after the colon, on the header line",
+	ErrDescriptionWhitespace:         "put exactly one white-space after the colon",
+	ErrDescriptionTrailingWhitespace: "remove the trailing white-spaces",
+	ErrDescriptionTab:                "replace the tabs with white-spaces",
+	ErrDescriptionMinLength:          "write a more meaningful description",
+	ErrDescriptionTrailingPeriod:     "remove the trailing period",
+	ErrDescriptionEncoding:           "encode the commit message in UTF-8",
+	ErrNewline:                       "write the description on the header line",
+	ErrMissingBlankLineAtBeginning:   "separate the header from the body with a blank line",
+	ErrTrailer:                       "write the footer trailers as 'Token: value' or 'Token #value'",
+	ErrTrailerIncomplete:             "write the footer trailers as 'Token: value' or 'Token #value'",
+	ErrTrailerTokenNotAllowed:        "use one of the allowed footer trailer tokens",
+	ErrTrailerTokenDuplicate:         "merge the footer trailers with the same token",
+	ErrTrailerTokenMissing:           "add the missing footer trailer at the end of the commit message",
+}
+
+const (
+	ansiReset = "\033[0m"
+	ansiBold  = "\033[1m"
+	ansiRed   = "\033[1;31m"
+	ansiBlue  = "\033[1;34m"
+	ansiCyan  = "\033[1;36m"
+)
+
+// Diagnostic renders the error as a compiler-like diagnostic pointing to its position in the input.
+//
+// It prints the message, the line containing the error with a caret under the offending character,
+// the name of the commit message part, and a hint about how to fix it.
+// The error can be an Error, or an Errors when the parser is in error recovery mode.
+// When colored is true, the output contains ANSI escape codes.
+func Diagnostic(input []byte, err error, colored bool) string {
+	if err == nil {
+		return ""
+	}
+	paint := func(color, s string) string {
+		if !colored {
+			return s
+		}
+		return color + s + ansiReset
+	}
+
+	if errs, ok := err.(Errors); ok {
+		res := make([]string, len(errs))
+		for i, e := range errs {
+			res[i] = Diagnostic(input, e, colored)
+		}
+		return strings.Join(res, "\n")
+	}
+
+	var e *Error
+	if !errors.As(err, &e) {
+		return fmt.Sprintf("%s %s\n", paint(ansiRed, "error:"), paint(ansiBold, err.Error()))
+	}
+
+	offset := e.Offset
+	if offset > len(input) {
+		offset = len(input)
+	}
+	start := bytes.LastIndexByte(input[:offset], '\n') + 1
+	end := bytes.IndexByte(input[start:], '\n')
+	if end < 0 {
+		end = len(input)
+	} else {
+		end += start
+	}
+	if offset > end {
+		// The error is right after the line (eg., a missing blank line)
+		offset = end
+	}
+	line := bytes.Count(input[:start], []byte("\n")) + 1
+	column := utf8.RuneCount(input[start:offset]) + 1
+
+	// Keep the tabs, so that the caret stays aligned
+	padding := []rune(string(input[start:offset]))
+	for i, r := range padding {
+		if r != '\t' {
+			padding[i] = ' '
+		}
+	}
+
+	number := strconv.Itoa(line)
+	gutter := strings.Repeat(" ", len(number))
+	location := fmt.Sprintf("line %d, column %d", line, column)
+	if e.Section != "" {
+		location += fmt.Sprintf(" (%s)", e.Section)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s\n", paint(ansiRed, "error:"), paint(ansiBold, e.reason))
+	fmt.Fprintf(&b, "%s%s %s\n", gutter, paint(ansiBlue, "-->"), location)
+	fmt.Fprintf(&b, "%s %s\n", gutter, paint(ansiBlue, "|"))
+	fmt.Fprintf(&b, "%s %s %s\n", paint(ansiBlue, number), paint(ansiBlue, "|"), input[start:end])
+	fmt.Fprintf(&b, "%s %s %s%s\n", gutter, paint(ansiBlue, "|"), string(padding), paint(ansiRed, "^"))
+	if hint := e.hint(); hint != "" {
+		fmt.Fprintf(&b, "%s %s %s\n", gutter, paint(ansiBlue, "="), paint(ansiCyan, "hint: ")+hint)
+	}
+
+	return b.String()
+}
+
+// hint returns a suggestion about how to fix the error.
+func (e *Error) hint() string {
+	switch len(e.Suggestions) {
+	case 0:
+		return hints[e.template]
+	case 1:
+		return fmt.Sprintf("did you mean '%s'?", e.Suggestions[0])
+	}
+
+	return fmt.Sprintf("did you mean one of '%s'?", strings.Join(e.Suggestions, "', '"))
+}
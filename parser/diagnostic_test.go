@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package parser
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/leodido/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiagnostic(t *testing.T) {
+	cases := []struct {
+		title  string
+		input  string
+		output string
+	}{
+		{
+			"type-with-suggestion",
+			"fxi(ui): description",
+			`error: illegal 'x' character in commit message type
+ --> line 1, column 2 (type)
+  |
+1 | fxi(ui): description
+  |  ^
+  = hint: did you mean 'fix'?
+`,
+		},
+		{
+			"colon",
+			"fix description",
+			`error: expecting colon (':') character, got ' ' character
+ --> line 1, column 4 (header)
+  |
+1 | fix description
+  |    ^
+  = hint: separate the type (and the optional scope) from the description with a colon
+`,
+		},
+		{
+			"body",
+			"fix: description\nbody",
+			`error: missing a blank line
+ --> line 2, column 1 (body)
+  |
+2 | body
+  | ^
+  = hint: separate the header from the body with a blank line
+`,
+		},
+		{
+			"footer",
+			"fix: description\n\nbody\n\nFixes: #1\nAcked-by: Le\to",
+			`error: illegal '	' character in trailer
+ --> line 6, column 13 (footer)
+  |
+6 | Acked-by: Le	o
+  |             ^
+  = hint: write the footer trailers as 'Token: value' or 'Token #value'
+`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.title, func(t *testing.T) {
+			_, err := NewMachine(WithTypes(conventionalcommits.TypesConventional)).Parse([]byte(tc.input))
+			assert.Equal(t, tc.output, Diagnostic([]byte(tc.input), err, false))
+		})
+	}
+}
+
+func TestDiagnosticWithErrorRecovery(t *testing.T) {
+	input := []byte("fix desc\n\nbody\n\nFixes: #1\nBroken footer")
+	_, err := NewMachine(WithErrorRecovery()).Parse(input)
+
+	expected := `error: expecting colon (':') character, got ' ' character
+ --> line 1, column 4 (header)
+  |
+1 | fix desc
+  |    ^
+  = hint: separate the type (and the optional scope) from the description with a colon
+
+error: illegal 'f' character in trailer
+ --> line 6, column 8 (footer)
+  |
+6 | Broken footer
+  |        ^
+  = hint: write the footer trailers as 'Token: value' or 'Token #value'
+`
+	assert.Equal(t, expected, Diagnostic(input, err, false))
+}
+
+func TestDiagnosticWithColors(t *testing.T) {
+	input := []byte("fix")
+	_, err := NewMachine().Parse(input)
+
+	res := Diagnostic(input, err, true)
+	assert.Contains(t, res, "\033[1;31merror:\033[0m")
+	assert.Contains(t, res, "\033[1;31m^\033[0m")
+}
+
+func TestDiagnosticWithoutPosition(t *testing.T) {
+	assert.Equal(t, "", Diagnostic([]byte("fix: x"), nil, false))
+	assert.Equal(t, "error: boom\n", Diagnostic([]byte("fix: x"), errors.New("boom"), false))
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package parser
+
+import (
+	"strings"
+)
+
+// Error represents an error the parser found at a specific position of the input.
+type Error struct {
+	message  string
+	reason   string
+	template string
+	// Section is the name of the commit message part where the error occurred (eg., type, scope, footer).
+	Section string
+	// Offset is the position (in bytes) of the input where the error occurred.
+	Offset int
+	// Column is the position (in characters) of the input where the error occurred.
+	Column int
+	// Fixes contains the edits that, once applied to the input, fix the error.
+	Fixes []Edit
+	// Suggestions contains the commit message types similar to the one in the input, closest first.
+	Suggestions []string
+}
+
+// Error returns the error message, with its column.
+func (e *Error) Error() string {
+	return e.message
+}
+
+// Errors represents the list of errors the parser found in error recovery mode.
+type Errors []error
+
+// Error returns the messages of all the errors, one per line.
+func (e Errors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the errors, so that errors.Is and errors.As can inspect every one of them.
+func (e Errors) Unwrap() []error {
+	return e
+}
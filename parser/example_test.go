@@ -24,13 +24,16 @@ func Example_minimal_withoutbody() {
 	fmt.Println("there are breaking changes?", m.IsBreakingChange())
 	// Output:
 	// (*conventionalcommits.ConventionalCommit)({
+	//  Gitmoji: (*string)(<nil>),
 	//  Type: (string) (len=3) "fix",
+	//  TypeAlias: (*string)(<nil>),
 	//  Description: (string) (len=9) "something",
 	//  Scope: (*string)(<nil>),
 	//  Exclamation: (bool) true,
 	//  Body: (*string)(<nil>),
 	//  Footers: (map[string][]string) <nil>,
-	//  TypeConfig: (conventionalcommits.TypeConfig) 0
+	//  TypeConfig: (conventionalcommits.TypeConfig) minimal,
+	//  VerbatimBody: (*string)(<nil>)
 	// })
 	// there are breaking changes? true
 }
@@ -43,13 +46,16 @@ a blank line is mandatory to start the body part of the commit message!`)
 	fmt.Println(e)
 	// Output:
 	// (*conventionalcommits.ConventionalCommit)({
+	//  Gitmoji: (*string)(<nil>),
 	//  Type: (string) (len=3) "fix",
+	//  TypeAlias: (*string)(<nil>),
 	//  Description: (string) (len=11) "description",
 	//  Scope: (*string)(<nil>),
 	//  Exclamation: (bool) false,
 	//  Body: (*string)(<nil>),
 	//  Footers: (map[string][]string) <nil>,
-	//  TypeConfig: (conventionalcommits.TypeConfig) 0
+	//  TypeConfig: (conventionalcommits.TypeConfig) minimal,
+	//  VerbatimBody: (*string)(<nil>)
 	// })
 	// missing a blank line: col=17
 }
@@ -67,13 +73,16 @@ typos fixed.`)
 	output(m)
 	// Output:
 	// (*conventionalcommits.ConventionalCommit)({
+	//  Gitmoji: (*string)(<nil>),
 	//  Type: (string) (len=3) "fix",
+	//  TypeAlias: (*string)(<nil>),
 	//  Description: (string) (len=1) "x",
 	//  Scope: (*string)(<nil>),
 	//  Exclamation: (bool) false,
 	//  Body: (*string)((len=86) "see the issue for details\n\nbut first a newline\nand then two blank lines:\n\ntypos fixed."),
 	//  Footers: (map[string][]string) <nil>,
-	//  TypeConfig: (conventionalcommits.TypeConfig) 0
+	//  TypeConfig: (conventionalcommits.TypeConfig) minimal,
+	//  VerbatimBody: (*string)(<nil>)
 	// })
 }
 
@@ -95,7 +104,9 @@ Refs #133`)
 	output(m)
 	// Output:
 	// (*conventionalcommits.ConventionalCommit)({
+	//  Gitmoji: (*string)(<nil>),
 	//  Type: (string) (len=3) "fix",
+	//  TypeAlias: (*string)(<nil>),
 	//  Description: (string) (len=27) "correct minor typos in code",
 	//  Scope: (*string)(<nil>),
 	//  Exclamation: (bool) false,
@@ -108,7 +119,8 @@ Refs #133`)
 	//    (string) (len=1) "Z"
 	//   }
 	//  },
-	//  TypeConfig: (conventionalcommits.TypeConfig) 1
+	//  TypeConfig: (conventionalcommits.TypeConfig) conventional,
+	//  VerbatimBody: (*string)(<nil>)
 	// })
 }
 
@@ -118,12 +130,15 @@ func Example_breaking_freeformtype_with_scope() {
 	output(m)
 	// Output:
 	// (*conventionalcommits.ConventionalCommit)({
+	//  Gitmoji: (*string)(<nil>),
 	//  Type: (string) (len=3) "kvm",
+	//  TypeAlias: (*string)(<nil>),
 	//  Description: (string) (len=56) "Truncate base/index GPR value on address calc in !64-bit",
 	//  Scope: (*string)((len=4) "nvmx"),
 	//  Exclamation: (bool) true,
 	//  Body: (*string)(<nil>),
 	//  Footers: (map[string][]string) <nil>,
-	//  TypeConfig: (conventionalcommits.TypeConfig) 3
+	//  TypeConfig: (conventionalcommits.TypeConfig) freeform,
+	//  VerbatimBody: (*string)(<nil>)
 	// })
 }
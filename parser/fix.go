@@ -0,0 +1,369 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package parser
+
+import (
+	"bytes"
+	"errors"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/leodido/go-conventionalcommits"
+)
+
+// scissors is the line of the git commit message template separating the commit message from the diff following it.
+//
+// Git ignores it, and everything after it (see git commit --cleanup=scissors).
+const scissors = "# ------------------------ >8 ------------------------"
+
+// maxFixIterations limits the parse and fix rounds, since every round can only fix the errors found before the first unrecoverable one.
+const maxFixIterations = 10
+
+var (
+	// ErrFixChangesText is the error Fix returns when repairing the commit message would change the words of its header.
+	ErrFixChangesText = errors.New("can not repair the commit message without changing the words of its header")
+	// ErrMixedLineEndings is the error Fix returns when the commit message mixes CRLF and LF line endings.
+	ErrMixedLineEndings = errors.New("can not repair a commit message mixing CRLF and LF line endings")
+	// ErrFixNoMessage is the error Fix returns when the parser gives it back no commit message to repair.
+	ErrFixNoMessage = errors.New("can not repair the commit message without a parsed commit message")
+)
+
+var (
+	breakingChangeTrailer = regexp.MustCompile(`(?i)^breaking[ -]change(:| #)[ \t]*`)
+	trailerLine           = regexp.MustCompile(`^([[:alnum:]]+(-[[:alnum:]]+)*: |[[:alnum:]]+(-[[:alnum:]]+)* #|BREAKING CHANGE: )`)
+	listItem              = regexp.MustCompile(`^\s*([-*+]|[0-9]+[.)])\s+`)
+)
+
+// Fix rewrites the input commit message into its canonical form.
+//
+// It lowercases the type, leaves exactly one white-space after the colon,
+// separates the header, the body, and the footer with exactly one blank line,
+// normalizes the breaking change footer token to "BREAKING CHANGE",
+// and strips the trailing white-spaces.
+// When width is greater than zero, it also wraps the body lines longer than width characters,
+// leaving code blocks untouched.
+//
+// The options configure the parser used to validate the input, and to find the mechanical fixes (see ApplyFixes).
+// When the commit message can not be repaired, Fix returns the error of the parser.
+// On an empty, or white-space only, input it returns the same error of the parser on an empty one.
+// Fix never changes the words of the header, but the case of the type or a single character typo in it:
+// when the mechanical fixes would, it returns ErrFixChangesText.
+// It preserves the CRLF line endings, while it refuses the commit messages mixing them with the LF ones.
+// It leaves the comment lines of the git commit message template, and everything after its scissors line, untouched.
+func Fix(input []byte, width int, options ...conventionalcommits.MachineOption) ([]byte, error) {
+	crlf := bytes.Contains(input, []byte("\r\n"))
+	if crlf {
+		if bytes.Count(input, []byte("\r\n")) != bytes.Count(input, []byte("\n")) {
+			return nil, ErrMixedLineEndings
+		}
+		input = bytes.ReplaceAll(input, []byte("\r\n"), []byte("\n"))
+	}
+
+	lines, template := splitTemplate(strings.Split(string(input), "\n"))
+	lines = normalizeLines(lines)
+	if len(lines) == 0 {
+		_, err := NewMachine(options...).Parse(nil)
+
+		return nil, err
+	}
+	header := lines[0]
+	data := []byte(strings.Join(lines, "\n"))
+
+	// Apply the fixes the parser suggests until it does not complain anymore
+	var err error
+	for i := 0; i < maxFixIterations; i++ {
+		_, err = NewMachine(append(options, WithErrorRecovery())...).Parse(data)
+		if err == nil {
+			break
+		}
+		fixed := ApplyFixes(data, err)
+		if bytes.Equal(fixed, data) {
+			break
+		}
+		data = fixed
+	}
+	res, err := NewMachine(options...).Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	commit, ok := res.(*conventionalcommits.ConventionalCommit)
+	if !ok || commit == nil {
+		return nil, ErrFixNoMessage
+	}
+
+	lines = strings.Split(string(data), "\n")
+	lines[0] = fixHeader(lines[0], commit)
+	lines = normalizeLines(separateFooter(lines))
+	if width > 0 {
+		lines = wrapBody(lines, width)
+	}
+	data = []byte(strings.Join(lines, "\n"))
+
+	// Make sure the canonical form is still a valid commit message, with the same words
+	res, err = NewMachine(options...).Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	commit, ok = res.(*conventionalcommits.ConventionalCommit)
+	if !ok || commit == nil {
+		return nil, ErrFixNoMessage
+	}
+	if !sameHeaderWords(header, lines[0], commit) {
+		return nil, ErrFixChangesText
+	}
+	if template != nil {
+		data = []byte(strings.Join(append(lines, template...), "\n"))
+	}
+	if crlf {
+		data = bytes.ReplaceAll(data, []byte("\n"), []byte("\r\n"))
+	}
+
+	return data, nil
+}
+
+// headerWords splits the header at white-spaces and at the punctuation separating its parts.
+func headerWords(header string) []string {
+	return strings.FieldsFunc(header, func(r rune) bool {
+		return unicode.IsSpace(r) || strings.ContainsRune(":()!", r)
+	})
+}
+
+// sameHeaderWords tells whether the fixed header has the same words of the input one.
+//
+// The type can differ in case, or by a single character typo (eg., "Fxi" in place of "fix").
+func sameHeaderWords(input, fixed string, commit *conventionalcommits.ConventionalCommit) bool {
+	before, after := headerWords(input), headerWords(fixed)
+	if len(before) != len(after) {
+		return false
+	}
+	typ := 0
+	if commit.Gitmoji != nil {
+		typ = len(headerWords(*commit.Gitmoji))
+	}
+	for i := range before {
+		switch {
+		case before[i] == after[i]:
+		case i == typ && strings.EqualFold(before[i], after[i]):
+		case i == typ && editDistance(strings.ToLower(before[i]), strings.ToLower(after[i])) <= 1:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// isFence tells whether the line opens or closes a fenced code block.
+func isFence(line string) bool {
+	trimmed := strings.TrimLeft(line, " ")
+
+	return strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")
+}
+
+// isComment tells whether the line is a comment of the git commit message template.
+func isComment(line string) bool {
+	return strings.HasPrefix(line, "#")
+}
+
+// splitTemplate separates the commit message from the git commit message template ending it.
+//
+// The template is made of the comment lines, and the blank lines between them, ending the commit message,
+// plus the scissors line and everything after it.
+func splitTemplate(lines []string) ([]string, []string) {
+	end := len(lines)
+	for i, line := range lines {
+		if line == scissors {
+			end = i
+			break
+		}
+	}
+	start := end
+	comments := end < len(lines)
+	for start > 0 && (isComment(lines[start-1]) || strings.TrimSpace(lines[start-1]) == "") {
+		start--
+		comments = comments || isComment(lines[start])
+	}
+	if !comments {
+		return lines, nil
+	}
+
+	return lines[:start], lines[start:]
+}
+
+// normalizeLines strips the trailing white-spaces, collapses consecutive blank lines,
+// and normalizes the breaking change footer token of the trailers ending the commit message.
+//
+// Lines in code blocks, and comment lines, are left untouched.
+func normalizeLines(lines []string) []string {
+	res := make([]string, 0, len(lines))
+	code := false
+	footer := trailersStart(lines)
+	for i, line := range lines {
+		if isFence(line) {
+			code = !code
+		} else if code || isComment(line) {
+			res = append(res, line)
+			continue
+		}
+		line = strings.TrimRight(line, " \t")
+		if line == "" && (len(res) == 0 || res[len(res)-1] == "") {
+			continue
+		}
+		if i >= footer {
+			if m := breakingChangeTrailer.FindStringSubmatch(line); m != nil {
+				sep := ": "
+				if m[1] == " #" {
+					sep = " #"
+				}
+				line = "BREAKING CHANGE" + sep + line[len(m[0]):]
+			}
+		}
+		res = append(res, line)
+	}
+	for len(res) > 0 && res[len(res)-1] == "" {
+		res = res[:len(res)-1]
+	}
+
+	return res
+}
+
+// fixHeader lowercases the type and leaves exactly one white-space after the colon.
+func fixHeader(header string, commit *conventionalcommits.ConventionalCommit) string {
+	start := 0
+	if commit.Gitmoji != nil {
+		start = strings.Index(header, *commit.Gitmoji) + len(*commit.Gitmoji)
+		for start < len(header) && header[start] == ' ' {
+			start++
+		}
+	}
+	end := start + strings.IndexAny(header[start:], "(!:")
+	if end < start {
+		return header
+	}
+	typ := header[start:end]
+	// Linux kernel subsystems (eg., ARM, KVM) are not lowercase
+	if commit.TypeConfig != conventionalcommits.TypesLinuxKernel {
+		typ = strings.ToLower(typ)
+	}
+
+	// The description is the remainder of the header after the colon
+	rest := strings.TrimRight(header[:len(header)-len(commit.Description)], " \t")
+
+	return header[:start] + typ + rest[end:] + " " + strings.TrimLeft(commit.Description, " \t")
+}
+
+// trailersStart returns the index of the first line of the trailers ending the last paragraph, header excluded.
+//
+// The trailers can be preceded by body text in the same paragraph, and followed by lines continuing their values.
+// It returns the number of lines when there are no trailers.
+func trailersStart(lines []string) int {
+	end := len(lines)
+	for end > 0 && strings.TrimSpace(lines[end-1]) == "" {
+		end--
+	}
+	k := len(lines)
+	for i := end - 1; i > 0; i-- {
+		line := strings.TrimRight(lines[i], " \t")
+		if line == "" {
+			break
+		}
+		if trailerLine.MatchString(line) || breakingChangeTrailer.MatchString(line) {
+			k = i
+		} else if !strings.HasPrefix(line, " ") {
+			break
+		}
+	}
+
+	return k
+}
+
+// separateFooter inserts a blank line between the body text and the footer trailers following it.
+func separateFooter(lines []string) []string {
+	last := len(lines)
+	for last > 1 && lines[last-1] != "" {
+		last--
+	}
+	if last <= 1 {
+		// No body
+		return lines
+	}
+
+	k := trailersStart(lines)
+	if k == len(lines) || k == last {
+		return lines
+	}
+
+	res := append([]string{}, lines[:k]...)
+	res = append(res, "")
+
+	return append(res, lines[k:]...)
+}
+
+// wrapBody wraps the body lines longer than width characters.
+//
+// It does not touch the header, the footer trailers, the lines in code blocks, the indented lines, and the comment lines.
+func wrapBody(lines []string, width int) []string {
+	if len(lines) < 3 {
+		return lines
+	}
+	// The footer is the last paragraph, when it only contains trailers
+	end := len(lines)
+	for end > 2 && lines[end-1] != "" {
+		end--
+	}
+	if !trailerLine.MatchString(lines[end]) {
+		end = len(lines)
+	}
+	for _, line := range lines[end:] {
+		if !trailerLine.MatchString(line) && !strings.HasPrefix(line, " ") {
+			end = len(lines)
+			break
+		}
+	}
+
+	res := append([]string{}, lines[:2]...)
+	code := false
+	for _, line := range lines[2:end] {
+		if isFence(line) {
+			code = !code
+		}
+		if code || isFence(line) || isComment(line) || strings.HasPrefix(line, "    ") || strings.HasPrefix(line, "\t") {
+			res = append(res, line)
+			continue
+		}
+		res = append(res, wrapLine(line, width)...)
+	}
+
+	return append(res, lines[end:]...)
+}
+
+// wrapLine splits the line at white-spaces, so that every part is at most width characters long.
+//
+// The parts of list items are indented to align with the text of the item.
+// Words longer than width characters (eg., URLs) are never split.
+func wrapLine(line string, width int) []string {
+	if utf8.RuneCountInString(line) <= width {
+		return []string{line}
+	}
+	indent := strings.Repeat(" ", utf8.RuneCountInString(listItem.FindString(line)))
+	prefix := line[:len(line)-len(strings.TrimLeft(line, " "))]
+
+	var res []string
+	current := prefix
+	for _, word := range strings.Fields(line) {
+		switch {
+		case strings.TrimSpace(current) == "":
+			current += word
+		case utf8.RuneCountInString(current)+1+utf8.RuneCountInString(word) <= width:
+			current += " " + word
+		default:
+			res = append(res, current)
+			current = indent + word
+		}
+	}
+
+	return append(res, current)
+}
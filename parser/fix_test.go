@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/leodido/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFix(t *testing.T) {
+	cases := []struct {
+		title  string
+		input  string
+		width  int
+		output string
+	}{
+		{
+			"already-canonical",
+			"fix(ui): description\n\nbody\n\nFixes: #1",
+			0,
+			"fix(ui): description\n\nbody\n\nFixes: #1",
+		},
+		{
+			"uppercase-type",
+			"FIX(UI)!: description",
+			0,
+			"fix(UI)!: description",
+		},
+		{
+			"many-white-spaces-after-colon",
+			"fix:  \t description",
+			0,
+			"fix: description",
+		},
+		{
+			"missing-colon-and-white-space",
+			"fix desc",
+			0,
+			"fix: desc",
+		},
+		{
+			"near-miss-type",
+			"Fxi:description",
+			0,
+			"fix: description",
+		},
+		{
+			"missing-blank-line-before-body",
+			"fix: description\nbody",
+			0,
+			"fix: description\n\nbody",
+		},
+		{
+			"missing-blank-line-before-footer",
+			"fix: description\n\nbody\nFixes: #1\nReviewed-by: Leo",
+			0,
+			"fix: description\n\nbody\n\nFixes: #1\nReviewed-by: Leo",
+		},
+		{
+			"many-blank-lines",
+			"fix: description\n\n\n\nbody\n\n\nFixes: #1\n\n",
+			0,
+			"fix: description\n\nbody\n\nFixes: #1",
+		},
+		{
+			"trailing-white-spaces",
+			"fix: description  \n\nbody \t\n\nFixes: #1 ",
+			0,
+			"fix: description\n\nbody\n\nFixes: #1",
+		},
+		{
+			"breaking-change-token",
+			"feat: description\n\nbreaking change: everything\nBreaking-Change: really",
+			0,
+			"feat: description\n\nBREAKING CHANGE: everything\nBREAKING CHANGE: really",
+		},
+		{
+			"breaking-change-token-with-hash-separator",
+			"feat: description\n\nbreaking-change #12",
+			0,
+			"feat: description\n\nBREAKING CHANGE #12",
+		},
+		{
+			"breaking-change-like-body-line",
+			"feat: description\n\nBreaking change: the one explained below\nmore text",
+			0,
+			"feat: description\n\nBreaking change: the one explained below\nmore text",
+		},
+		{
+			"breaking-change-like-body-line-before-footer",
+			"feat: description\n\nbreaking change: the one explained below\nmore text\nbreaking-change: everything",
+			0,
+			"feat: description\n\nbreaking change: the one explained below\nmore text\n\nBREAKING CHANGE: everything",
+		},
+		{
+			"crlf-line-endings",
+			"fix: x\r\n\r\nbody\r\n",
+			0,
+			"fix: x\r\n\r\nbody",
+		},
+		{
+			"crlf-line-endings-with-footer",
+			"fix:x  \r\nbody\r\n\r\n\r\nRefs #1\r\n",
+			0,
+			"fix: x\r\n\r\nbody\r\n\r\nRefs #1",
+		},
+		{
+			"wrap-body",
+			"fix: description that is not wrapped\n\nsome body text that is long enough to be wrapped\n- a list item that is long too\n\nFixes: #1 that is a long footer",
+			20,
+			"fix: description that is not wrapped\n\nsome body text that\nis long enough to be\nwrapped\n- a list item that\n  is long too\n\nFixes: #1 that is a long footer",
+		},
+		{
+			"wrap-body-without-touching-code-blocks",
+			"fix: description\n\nsome body text that is long enough\n```\ncode that is long enough to wrap  \n```\n    indented code that is long enough",
+			20,
+			"fix: description\n\nsome body text that\nis long enough\n```\ncode that is long enough to wrap  \n```\n    indented code that is long enough",
+		},
+		{
+			"wrap-body-without-splitting-words",
+			"fix: description\n\nsee https://github.com/leodido/go-conventionalcommits",
+			20,
+			"fix: description\n\nsee\nhttps://github.com/leodido/go-conventionalcommits",
+		},
+		{
+			"git-template",
+			"Fix:description  \n\nsome body text that is long enough to be wrapped\nSigned-off-by: Leo <leo@example.com>\n\n# Please enter the commit message for your changes. Lines starting\n# with '#' will be ignored, and an empty message aborts the commit.\n#\n",
+			20,
+			"fix: description\n\nsome body text that\nis long enough to be\nwrapped\n\nSigned-off-by: Leo <leo@example.com>\n\n# Please enter the commit message for your changes. Lines starting\n# with '#' will be ignored, and an empty message aborts the commit.\n#\n",
+		},
+		{
+			"git-template-with-scissors",
+			"fix: description\n\n# Changes to be committed:\n#\tmodified:   fix.go  \n#\n# ------------------------ >8 ------------------------\n# Do not modify or remove the line above.\ndiff --git a/fix.go b/fix.go\n+breaking-change:  a very long line that must not be wrapped  \n\n\n",
+			20,
+			"fix: description\n\n# Changes to be committed:\n#\tmodified:   fix.go  \n#\n# ------------------------ >8 ------------------------\n# Do not modify or remove the line above.\ndiff --git a/fix.go b/fix.go\n+breaking-change:  a very long line that must not be wrapped  \n\n\n",
+		},
+		{
+			"comment-lines-in-body",
+			"fix: description\n\nsome body text that is long enough\n# a comment line that is long enough  \n\n\nmore body",
+			20,
+			"fix: description\n\nsome body text that\nis long enough\n# a comment line that is long enough  \n\nmore body",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.title, func(t *testing.T) {
+			res, err := Fix([]byte(tc.input), tc.width, WithTypes(conventionalcommits.TypesConventional))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.output, string(res))
+
+			// Fixing is idempotent
+			again, err := Fix(res, tc.width, WithTypes(conventionalcommits.TypesConventional))
+			assert.NoError(t, err)
+			assert.Equal(t, string(res), string(again))
+		})
+	}
+}
+
+func TestFixError(t *testing.T) {
+	cases := []struct {
+		title string
+		input string
+		err   string
+	}{
+		{"unknown-type", "cor: description", fmt.Sprintf(ErrType+ColumnPositionTemplate, "o", 1)},
+		{"colon-in-the-middle-of-a-word", "Feature: x", fmt.Sprintf(ErrColon+ColumnPositionTemplate, "u", 4)},
+		{"colon-in-the-middle-of-a-plural-word", "features: x", fmt.Sprintf(ErrColon+ColumnPositionTemplate, "u", 4)},
+		{"git-fixup", "fixup! fix: x", fmt.Sprintf(ErrColon+ColumnPositionTemplate, "u", 3)},
+		{"mixed-line-endings", "fix: x\r\n\r\nbody\n", ErrMixedLineEndings.Error()},
+		{"empty", "", fmt.Sprintf(ErrEmpty+ColumnPositionTemplate, 0)},
+		{"only-newlines", "\n\n", fmt.Sprintf(ErrEmpty+ColumnPositionTemplate, 0)},
+		{"only-white-spaces", " \t\n  \n", fmt.Sprintf(ErrEmpty+ColumnPositionTemplate, 0)},
+		{"only-crlf", "\r\n\r\n", fmt.Sprintf(ErrEmpty+ColumnPositionTemplate, 0)},
+		{"only-git-template", "\n# Please enter the commit message for your changes.\n#\n", fmt.Sprintf(ErrEmpty+ColumnPositionTemplate, 0)},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.title, func(t *testing.T) {
+			res, err := Fix([]byte(tc.input), 0, WithTypes(conventionalcommits.TypesConventional))
+			assert.Nil(t, res)
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestFixSameHeaderWords(t *testing.T) {
+	cases := []struct {
+		title string
+		input string
+		fixed string
+		same  bool
+	}{
+		{"inserted-colon", "fix desc", "fix: desc", true},
+		{"inserted-colon-after-scope", "fix(ui)desc", "fix(ui): desc", true},
+		{"lowercased-type", "FIX(UI)!: description", "fix(UI)!: description", true},
+		{"type-typo", "Fxi:description", "fix: description", true},
+		{"split-word", "Feature: x", "feat: ure: x", false},
+		{"split-plural-word", "features: x", "feat: ures: x", false},
+		{"replaced-type", "fixup! fix: x", "fix!: fix: x", false},
+		{"changed-description", "fix: description", "fix: descriptions", false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.title, func(t *testing.T) {
+			res, err := NewMachine(WithTypes(conventionalcommits.TypesConventional)).Parse([]byte(tc.fixed))
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.same, sameHeaderWords(tc.input, tc.fixed, res.(*conventionalcommits.ConventionalCommit)))
+			}
+		})
+	}
+}
+
+func TestFixLinuxKernelSubsystem(t *testing.T) {
+	res, err := Fix([]byte("ARM:   dts: fix"), 0, WithTypes(conventionalcommits.TypesLinuxKernel))
+	assert.NoError(t, err)
+	assert.Equal(t, "ARM: dts: fix", string(res))
+}
@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package parser
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/leodido/go-conventionalcommits"
+)
+
+// Edit represents a machine-applicable change to the input.
+//
+// It deletes Delete bytes starting at Offset, and inserts Text in their place.
+type Edit struct {
+	Offset int
+	Delete int
+	Text   string
+}
+
+// ApplyFixes applies to the input the edits attached to the given error.
+//
+// The error can be an Error, or an Errors when the parser is in error recovery mode.
+// Edits overlapping previous ones are skipped.
+// The input is not modified: a new slice is returned.
+func ApplyFixes(input []byte, err error) []byte {
+	edits := fixes(err)
+	sort.SliceStable(edits, func(i, j int) bool {
+		return edits[i].Offset < edits[j].Offset
+	})
+
+	var b strings.Builder
+	last := 0
+	for _, e := range edits {
+		if e.Offset < last || e.Offset+e.Delete > len(input) {
+			continue
+		}
+		b.Write(input[last:e.Offset])
+		b.WriteString(e.Text)
+		last = e.Offset + e.Delete
+	}
+	b.Write(input[last:])
+
+	return []byte(b.String())
+}
+
+func fixes(err error) []Edit {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case Errors:
+		var res []Edit
+		for _, x := range e {
+			res = append(res, fixes(x)...)
+		}
+		return res
+	}
+
+	var e *Error
+	if errors.As(err, &e) {
+		return append([]Edit{}, e.Fixes...)
+	}
+
+	return nil
+}
+
+// suggestTypes returns the known types closest to the input one, closest first.
+//
+// It returns nothing when the input type is a known one.
+func suggestTypes(config conventionalcommits.TypeConfig, input string) []string {
+	input = strings.ToLower(input)
+	max := 1
+	if len(input) > 4 {
+		max = 2
+	}
+
+	type candidate struct {
+		value    string
+		distance int
+	}
+	var candidates []candidate
+	for _, t := range config.Types() {
+		d := editDistance(input, t)
+		if d == 0 {
+			return nil
+		}
+		if d <= max {
+			candidates = append(candidates, candidate{t, d})
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+
+	res := make([]string, len(candidates))
+	for i, c := range candidates {
+		res[i] = c.value
+	}
+
+	return res
+}
+
+// editDistance computes the optimal string alignment distance between the input strings.
+//
+// That is the Levenshtein distance counting also the transposition of two adjacent characters as a single edit.
+func editDistance(a, b string) int {
+	x, y := []rune(a), []rune(b)
+	d := make([][]int, len(x)+1)
+	for i := range d {
+		d[i] = make([]int, len(y)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(x); i++ {
+		for j := 1; j <= len(y); j++ {
+			cost := 1
+			if x[i-1] == y[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && x[i-1] == y[j-2] && x[i-2] == y[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return d[len(x)][len(y)]
+}
@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package parser
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/leodido/go-conventionalcommits"
+)
+
+// gitGeneratedPrefixes contains the prefixes of the lines git itself generates into the trailer block.
+var gitGeneratedPrefixes = []string{"Signed-off-by: ", "(cherry picked from commit "}
+
+// gitTrailer represents a footer trailer as git interpret-trailers sees it.
+type gitTrailer struct {
+	token    conventionalcommits.Span
+	value    string
+	valuePos conventionalcommits.Span
+}
+
+// gitLines splits the input into lines, returning their offsets (with the offset of the end of the input as the last one).
+func gitLines(input []byte) []int {
+	offsets := []int{0}
+	for i, c := range input {
+		if c == 10 && i+1 < len(input) {
+			offsets = append(offsets, i+1)
+		}
+	}
+
+	return append(offsets, len(input))
+}
+
+// isGitBlankLine tells whether the line only contains white-spaces.
+func isGitBlankLine(line []byte) bool {
+	return len(bytes.TrimSpace(line)) == 0
+}
+
+// isGitSpace tells whether the character is a white-space, as per git.
+func isGitSpace(c byte) bool {
+	return c == 32 || c == 9 || c == 10 || c == 13
+}
+
+// findGitSeparator returns the position of the separator of the footer trailer in the line, or -1.
+//
+// It mimics git: the token contains alphanumeric characters and dashes, eventually followed by white-spaces.
+func findGitSeparator(line []byte, separators string) int {
+	whitespace := false
+	for i, c := range line {
+		if strings.IndexByte(separators, c) >= 0 {
+			return i
+		}
+		if !whitespace && (c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '-') {
+			continue
+		}
+		if i != 0 && (c == 32 || c == 9) {
+			whitespace = true
+			continue
+		}
+		break
+	}
+
+	return -1
+}
+
+// findGitTrailerBlock returns the offset where the trailer block starts, or the length of the input when there's none.
+//
+// It mimics git interpret-trailers: the trailer block is the last paragraph (never the first one),
+// when its lines are all footer trailers (or their continuation lines),
+// or when it contains a line git generates (eg., "Signed-off-by: ") and at least 25% of its lines are footer trailers.
+// Lines starting with '#' are comments.
+func findGitTrailerBlock(input []byte, separators string) int {
+	offsets := gitLines(input)
+	lines := len(offsets) - 1
+
+	// The first paragraph is the title and can not be trailers
+	endOfTitle := 0
+	for ; endOfTitle < lines; endOfTitle++ {
+		line := input[offsets[endOfTitle]:offsets[endOfTitle+1]]
+		if len(line) > 0 && line[0] == '#' {
+			continue
+		}
+		if isGitBlankLine(line) {
+			break
+		}
+	}
+
+	onlySpaces := true
+	recognizedPrefix := false
+	trailerLines, nonTrailerLines, possibleContinuationLines := 0, 0, 0
+	for l := lines - 1; l >= endOfTitle; l-- {
+		line := input[offsets[l]:offsets[l+1]]
+		if len(line) > 0 && line[0] == '#' {
+			nonTrailerLines += possibleContinuationLines
+			possibleContinuationLines = 0
+			continue
+		}
+		if isGitBlankLine(line) {
+			if onlySpaces {
+				continue
+			}
+			nonTrailerLines += possibleContinuationLines
+			if recognizedPrefix && trailerLines*3 >= nonTrailerLines || trailerLines > 0 && nonTrailerLines == 0 {
+				return offsets[l+1]
+			}
+			return len(input)
+		}
+		onlySpaces = false
+
+		generated := false
+		for _, prefix := range gitGeneratedPrefixes {
+			if bytes.HasPrefix(line, []byte(prefix)) {
+				generated = true
+				break
+			}
+		}
+		switch {
+		case generated:
+			trailerLines++
+			possibleContinuationLines = 0
+			recognizedPrefix = true
+		case findGitSeparator(line, separators) >= 1 && !isGitSpace(line[0]):
+			trailerLines++
+			possibleContinuationLines = 0
+		case isGitSpace(line[0]):
+			possibleContinuationLines++
+		default:
+			nonTrailerLines += 1 + possibleContinuationLines
+			possibleContinuationLines = 0
+		}
+	}
+
+	return len(input)
+}
+
+// parseGitTrailers returns the footer trailers in the trailer block starting at the given offset.
+//
+// It mimics git interpret-trailers --parse: continuation lines get unfolded into the value,
+// while the lines that are not footer trailers (eg., comments) are ignored.
+// Lines that are not footer trailers (comments included) also end the value of the previous footer trailer.
+func parseGitTrailers(input []byte, start int, separators string) []gitTrailer {
+	var res []gitTrailer
+	var last *gitTrailer
+	offsets := gitLines(input[start:])
+	for l := 0; l < len(offsets)-1; l++ {
+		from, to := start+offsets[l], start+offsets[l+1]
+		line := bytes.TrimRight(input[from:to], "\n")
+		if len(line) == 0 || line[0] == '#' {
+			last = nil
+			continue
+		}
+		if isGitSpace(line[0]) {
+			if last != nil {
+				// Continuation line
+				if value := strings.TrimSpace(string(line)); value != "" {
+					if last.value != "" {
+						last.value += " "
+					}
+					last.value += value
+				}
+				last.valuePos.End = from + len(line)
+			}
+			continue
+		}
+		sep := findGitSeparator(line, separators)
+		if sep < 1 {
+			last = nil
+			continue
+		}
+		token := bytes.TrimRight(line[:sep], " \t")
+		valueStart := sep + 1
+		for valueStart < len(line) && isGitSpace(line[valueStart]) {
+			valueStart++
+		}
+		res = append(res, gitTrailer{
+			token:    conventionalcommits.Span{Start: from, End: from + len(token)},
+			value:    strings.TrimSpace(string(line[valueStart:])),
+			valuePos: conventionalcommits.Span{Start: from + valueStart, End: from + len(line)},
+		})
+		last = &res[len(res)-1]
+	}
+
+	return res
+}
+
+// ParseGitTrailers returns the footer trailers that git interpret-trailers --parse finds in the input, with the given separators.
+//
+// It does not need the input to be a conventional commit message (eg., merge commits, reverts),
+// so that it suits the checks only concerning the footer trailers (eg., the DCO one).
+// The keys are in the form the parsers store them (eg., "signed-off-by", "breaking-change").
+func ParseGitTrailers(input []byte, separators string) map[string][]string {
+	if separators == "" {
+		separators = ":"
+	}
+	res := make(map[string][]string)
+	for _, t := range parseGitTrailers(input, findGitTrailerBlock(input, separators), separators) {
+		key := footerKey(string(input[t.token.Start:t.token.End]))
+		res[key] = append(res[key], t.value)
+	}
+
+	return res
+}
@@ -3,9 +3,10 @@ package parser
 import (
 	"bytes"
 	"fmt"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/leodido/go-conventionalcommits"
-	"github.com/sirupsen/logrus"
 )
 
 // ColumnPositionTemplate is the template used to communicate the column where errors occur.
@@ -16,6 +17,10 @@ const (
 	ErrType = "illegal '%s' character in commit message type"
 	// ErrTypeIncomplete represents an error when the type part of the commit message is not complete.
 	ErrTypeIncomplete = "incomplete commit message type after '%s' character"
+	// ErrGitmoji represents an error about illegal characters into the gitmoji prefix of the commit message.
+	ErrGitmoji = "illegal '%s' character in gitmoji"
+	// ErrGitmojiIncomplete represents an error when the gitmoji prefix of the commit message is not complete.
+	ErrGitmojiIncomplete = "incomplete gitmoji after '%s' character"
 	// ErrColon is the error message that communicate that the mandatory colon after the type part of the commit message is missing.
 	ErrColon = "expecting colon (':') character, got '%s' character"
 	// ErrScope represents an error about illegal characters into the the scope part of the commit message.
@@ -30,6 +35,20 @@ const (
 	ErrDescriptionInit = "expecting at least one white-space (' ') character, got '%s' character"
 	// ErrDescription tells the user that after the whitespace is mandatory a description.
 	ErrDescription = "expecting a description text (without newlines) after '%s' character"
+	// ErrDescriptionWhitespace tells the user that in strict mode exactly one whitespace is allowed before the description.
+	ErrDescriptionWhitespace = "expecting exactly one white-space (' ') character, got another '%s' character"
+	// ErrDescriptionTrailingWhitespace tells the user that in strict mode the description can not end with whitespaces.
+	ErrDescriptionTrailingWhitespace = "illegal trailing white-space (' ') character in description"
+	// ErrDescriptionTab tells the user that in strict mode tab characters are not allowed into the description.
+	ErrDescriptionTab = "illegal tab character in description"
+	// ErrHeaderMaxLength tells the user that the header (the first line of the commit message) is too long.
+	ErrHeaderMaxLength = "header exceeds the maximum length of %d characters with '%s' character"
+	// ErrDescriptionMinLength tells the user that the description is too short.
+	ErrDescriptionMinLength = "expecting a description text of at least %d characters, got %d characters"
+	// ErrDescriptionTrailingPeriod tells the user that the description can not end with a period.
+	ErrDescriptionTrailingPeriod = "illegal trailing period ('.') character in description"
+	// ErrDescriptionEncoding tells the user that the description contains an invalid UTF-8 sequence.
+	ErrDescriptionEncoding = "invalid UTF-8 sequence in description"
 	// ErrNewline communicates an illegal newline to the user.
 	ErrNewline = "illegal newline"
 	// ErrMissingBlankLineAtBeginning tells the user that the a blank line is missing after the description or after the body.
@@ -38,75 +57,423 @@ const (
 	ErrTrailer = "illegal '%s' character in trailer"
 	// ErrTrailerIncomplete represent an error when a trailer is not complete.
 	ErrTrailerIncomplete = "incomplete footer trailer after '%s' character"
+	// ErrTrailerTokenNotAllowed represents an error when a footer trailer token is not among the allowed ones.
+	ErrTrailerTokenNotAllowed = "footer trailer token '%s' not allowed"
+	// ErrTrailerTokenDuplicate represents an error when a footer trailer token repeats, while duplicates are forbidden.
+	ErrTrailerTokenDuplicate = "duplicate footer trailer token '%s'"
+	// ErrTrailerTokenMissing represents an error when a required footer trailer is missing.
+	ErrTrailerTokenMissing = "missing required footer trailer token '%s'"
 )
 
 const start int = 1
-const firstFinal int = 125
+const firstFinal int = 651
 
-const enTrailerBeg int = 127
-const enTrailerEnd int = 33
-const enBody int = 34
+const enTrailerBeg int = 654
+const enTrailerEnd int = 84
+const enBody int = 92
+const enGitBody int = 660
+const enTrailerRecovery int = 93
+const enHeaderRecovery int = 94
 const enMain int = 1
-const enConventionalTypesMain int = 35
-const enFalcoTypesMain int = 76
-const enFreeFormTypesMain int = 116
+const enConventionalTypesMain int = 95
+const enFalcoTypesMain int = 194
+const enAngularTypesMain int = 292
+const enElectronTypesMain int = 376
+const enEslintTypesMain int = 476
+const enLinuxKernelTypesMain int = 561
+const enFreeFormTypesMain int = 597
 
 type machine struct {
-	data             []byte
-	cs               int
-	p, pe, eof       int
-	pb               int
-	err              error
-	bestEffort       bool
-	typeConfig       conventionalcommits.TypeConfig
-	logger           *logrus.Logger
-	currentFooterKey string
-	countNewlines    int
-	lastNewline      int
+	data               []byte
+	cs                 int
+	p, pe, eof         int
+	pb                 int
+	err                error
+	bestEffort         bool
+	recovery           bool
+	typeStart          int
+	errors             []error
+	strictWhitespace   bool
+	gitmoji            bool
+	typeAliases        bool
+	headerMaxLength    int
+	descrMinLength     int
+	noTrailingPeriod   bool
+	footerTokenChars   string
+	footerKeys         map[string]bool
+	requiredFooters    []string
+	noDuplicates       bool
+	gitTrailers        bool
+	footerAnyParagraph bool
+	zeroCopy           bool
+	input              string
+	body               bodyBuilder
+	verbatimBody       bool
+	bodyStart          int
+	footerStart        int
+	finalParagraph     int
+	gitSeparators      string
+	typeConfig         conventionalcommits.TypeConfig
+	logger             conventionalcommits.StructuredLogger
+	listener           conventionalcommits.Listener
+	bodyChunk          conventionalcommits.Span
+	footerKeySpan      conventionalcommits.Span
+	currentFooterKey   string
+	countNewlines      int
+	lastNewline        int
 }
 
 func (m *machine) text() []byte {
 	return m.data[m.pb:m.p]
 }
 
-func (m *machine) emitInfo(s string, args ...interface{}) {
-	if m.logger != nil {
-		logEntry := logrus.NewEntry(m.logger)
-		for i := 0; i < len(args); i = i + 2 {
-			logEntry = m.logger.WithField(args[0].(string), args[1])
-		}
-		logEntry.Infoln(s)
+// str returns the input from the given start to the given end as a string.
+//
+// In zero-copy mode it does not allocate, since the string shares the memory of the input copy made at the beginning.
+func (m *machine) str(start, end int) string {
+	if m.zeroCopy {
+		return m.input[start:end]
 	}
+
+	return string(m.data[start:end])
 }
 
-func (m *machine) emitDebug(s string, args ...interface{}) {
-	if m.logger != nil {
-		logEntry := logrus.NewEntry(m.logger)
-		for i := 0; i < len(args); i = i + 2 {
-			logEntry = m.logger.WithField(args[0].(string), args[1])
-		}
-		logEntry.Debugln(s)
+// bodyString returns the body content as a string.
+func (m *machine) bodyString() string {
+	if m.body.contiguous() {
+		return m.str(m.body.start, m.body.end)
 	}
+
+	return string(m.body.buf)
 }
 
-func (m *machine) emitError(s string, args ...interface{}) error {
-	e := fmt.Errorf(s+ColumnPositionTemplate, args...)
+func (m *machine) emitError(p int, s string, args ...interface{}) error {
+	col := m.column(p)
+	e := &Error{
+		message:  fmt.Sprintf(s+ColumnPositionTemplate, append(args, col)...),
+		reason:   fmt.Sprintf(s, args...),
+		template: s,
+		Section:  sections[s],
+		Offset:   p,
+		Column:   col,
+	}
 	if m.logger != nil {
-		m.logger.Errorln(e)
+		m.logger.Error(e.message)
+	}
+	if m.listener != nil {
+		span := conventionalcommits.Span{Start: p, End: p}
+		if p < len(m.data) {
+			_, size := utf8.DecodeRune(m.data[p:])
+			span.End += size
+		}
+		m.listener.OnError(e, span)
 	}
 	return e
 }
 
 func (m *machine) emitErrorWithoutCharacter(messageTemplate string) error {
-	return m.emitError(messageTemplate, m.p)
+	return m.emitError(m.p, messageTemplate)
 }
 
 func (m *machine) emitErrorOnCurrentCharacter(messageTemplate string) error {
-	return m.emitError(messageTemplate, string(m.data[m.p]), m.p)
+	p := m.runeStart()
+	if p < m.p {
+		if r, size := utf8.DecodeRune(m.data[p:]); r != utf8.RuneError && p+size == m.p+1 {
+			// The current character is the last byte of a multi-byte character
+			return m.emitError(p, messageTemplate, string(r))
+		}
+		// The current character breaks a multi-byte character
+		return m.emitError(p, messageTemplate, string(utf8.RuneError))
+	}
+	r, _ := utf8.DecodeRune(m.data[m.p:])
+
+	return m.emitError(m.p, messageTemplate, string(r))
 }
 
 func (m *machine) emitErrorOnPreviousCharacter(messageTemplate string) error {
-	return m.emitError(messageTemplate, string(m.data[m.p-1]), m.p)
+	r, _ := utf8.DecodeLastRune(m.data[:m.p])
+
+	return m.emitError(m.p, messageTemplate, string(r))
+}
+
+// column converts the input position into a column counting runes rather than bytes.
+func (m *machine) column(p int) int {
+	if p > len(m.data) {
+		return utf8.RuneCount(m.data) + p - len(m.data)
+	}
+
+	return utf8.RuneCount(m.data[:p])
+}
+
+// runeStart returns the position of the incomplete multi-byte character preceding the current position, if any.
+//
+// Otherwise, it returns the current position.
+func (m *machine) runeStart() int {
+	for i := m.p - 1; i >= 0 && i >= m.p-utf8.UTFMax+1; i-- {
+		if utf8.RuneStart(m.data[i]) {
+			if m.data[i] >= 0xC0 && !utf8.FullRune(m.data[i:m.p]) {
+				return i
+			}
+			break
+		}
+	}
+
+	return m.p
+}
+
+// checkHeader enforces the limits about the header length and the description content.
+//
+// It assumes the machine has just parsed the description, thus the header ends at the current position.
+// Lengths are counted in runes, while the returned errors point to the first character beyond the limits.
+func (m *machine) checkHeader() error {
+	if m.headerMaxLength > 0 && utf8.RuneCount(m.data[:m.p]) > m.headerMaxLength {
+		i := 0
+		for n := 0; n < m.headerMaxLength; n++ {
+			_, size := utf8.DecodeRune(m.data[i:m.p])
+			i += size
+		}
+		r, _ := utf8.DecodeRune(m.data[i:m.p])
+
+		return m.emitError(i, ErrHeaderMaxLength, m.headerMaxLength, string(r))
+	}
+	if m.noTrailingPeriod && m.data[m.p-1] == 46 {
+		return m.emitError(m.p-1, ErrDescriptionTrailingPeriod)
+	}
+	if m.descrMinLength > 0 {
+		if n := utf8.RuneCount(m.text()); n < m.descrMinLength {
+			return m.emitError(m.p, ErrDescriptionMinLength, m.descrMinLength, n)
+		}
+	}
+
+	return nil
+}
+
+// checkFooter enforces the restrictions about the footer trailer tokens.
+//
+// It assumes the machine has just parsed the value of the current footer trailer.
+// The returned errors point to its token.
+func (m *machine) checkFooter(footers map[string][]string) error {
+	token := string(m.data[m.footerKeySpan.Start:m.footerKeySpan.End])
+	if m.footerKeys != nil && m.currentFooterKey != "breaking-change" && !m.footerKeys[m.currentFooterKey] {
+		return m.emitError(m.footerKeySpan.Start, ErrTrailerTokenNotAllowed, token)
+	}
+	if _, ok := footers[m.currentFooterKey]; ok && m.noDuplicates {
+		return m.emitError(m.footerKeySpan.Start, ErrTrailerTokenDuplicate, token)
+	}
+
+	return nil
+}
+
+// checkRequiredFooters returns the errors about the required footer trailers missing, pointing to the end of the input.
+func (m *machine) checkRequiredFooters(footers map[string][]string) []error {
+	var errs []error
+	for _, key := range m.requiredFooters {
+		if _, ok := footers[footerKey(key)]; !ok {
+			errs = append(errs, m.emitError(len(m.data), ErrTrailerTokenMissing, key))
+		}
+	}
+
+	return errs
+}
+
+// addGitTrailers adds the footer trailers of the trailer block git finds, enforcing the restrictions about their tokens.
+func (m *machine) addGitTrailers(footers map[string][]string, trailers []gitTrailer) {
+	for _, t := range trailers {
+		m.currentFooterKey = footerKey(string(m.data[t.token.Start:t.token.End]))
+		m.footerKeySpan = t.token
+		if err := m.checkFooter(footers); err != nil {
+			if !m.recovery {
+				m.err = err
+				return
+			}
+			m.errors = append(m.errors, err)
+			continue
+		}
+		footers[m.currentFooterKey] = append(footers[m.currentFooterKey], t.value)
+		if m.logger != nil {
+			m.logger.Info("valid commit message footer trailer", m.currentFooterKey, t.value)
+		}
+		if m.listener != nil {
+			if m.currentFooterKey == "breaking-change" {
+				m.listener.OnBreaking(t.token)
+			}
+			m.listener.OnFooter(m.currentFooterKey, t.value, t.token, t.valuePos)
+		}
+	}
+}
+
+// finalParagraph returns the offset where the final paragraph (ie., the one following the last blank line) starts.
+//
+// The trailing newlines do not count.
+func finalParagraph(input []byte) int {
+	end := len(input)
+	for end > 0 && input[end-1] == 10 {
+		end--
+	}
+
+	return bytes.LastIndex(input[:end], []byte("\n\n")) + 2
+}
+
+// breakingChangePrefixes contains the beginnings of the lines containing a breaking change footer trailer.
+var breakingChangePrefixes = [][]byte{[]byte("BREAKING CHANGE: "), []byte("BREAKING-CHANGE: "), []byte("BREAKING-CHANGE #")}
+
+// breakingChangeLine tells whether the current line starts with a breaking change footer trailer.
+//
+// Such footer trailers start the footer in any paragraph, so that a breaking change never silently ends up into the body.
+func (m *machine) breakingChangeLine() bool {
+	start := m.p
+	for start > 0 && m.data[start-1] != 10 {
+		start--
+	}
+	for _, prefix := range breakingChangePrefixes {
+		if bytes.HasPrefix(m.data[start:m.pe], prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// footerKey returns the key of the footer trailer token in the form the parser stores it.
+func footerKey(token string) string {
+	key := strings.ToLower(token)
+	if key == "breaking change" {
+		return "breaking-change"
+	}
+
+	return key
+}
+
+// typeToken returns the boundaries of the (possibly malformed) commit message type.
+func (m *machine) typeToken() (int, int) {
+	start := m.typeStart
+	for start < len(m.data) && m.data[start] == 32 {
+		start++
+	}
+	end := start
+	for end < len(m.data) && bytes.IndexByte([]byte("(!: \t\n"), m.data[end]) < 0 {
+		end++
+	}
+
+	return start, end
+}
+
+// suggestType attaches to the error the known commit message types similar to the one in the input.
+//
+// When there's only one closest type, it also attaches the edit replacing the input type with it.
+func (m *machine) suggestType(err error) error {
+	e := err.(*Error)
+	start, end := m.typeToken()
+	if start == end {
+		return e
+	}
+	token := strings.ToLower(string(m.data[start:end]))
+	e.Suggestions = suggestTypes(m.typeConfig, token)
+	if n := len(e.Suggestions); n == 1 || n > 1 && editDistance(token, e.Suggestions[0]) < editDistance(token, e.Suggestions[1]) {
+		e.Fixes = append(e.Fixes, Edit{Offset: start, Delete: end - start, Text: e.Suggestions[0]})
+	}
+
+	return e
+}
+
+// fixColon attaches to the error the edit inserting the missing colon.
+//
+// When the colon is missing because the commit message type is unknown, it suggests the similar types instead.
+// The colon goes only right after the type, or right after the scope (and the optional exclamation mark following it).
+// Elsewhere (eg., "Feature: x") the word is not a type, so the colon is not the fix.
+// Neither the colon nor the similar types are fixes when an exclamation mark not followed by a colon ends the word,
+// since it is a git autosquash message (eg., "fixup! fix: x") rather than a breaking change.
+func (m *machine) fixColon(err error) error {
+	e := m.suggestType(err).(*Error)
+	if _, end := m.typeToken(); end+1 < len(m.data) && m.data[end] == '!' && m.data[end+1] != ':' {
+		e.Fixes = nil
+
+		return e
+	}
+	if len(e.Suggestions) == 0 && m.colonBelongsAt(e.Offset) && m.data[e.Offset] != 10 {
+		text := ": "
+		if m.data[e.Offset] == 32 {
+			text = ":"
+		}
+		e.Fixes = append(e.Fixes, Edit{Offset: e.Offset, Text: text})
+	}
+
+	return e
+}
+
+// colonBelongsAt tells whether the type token ends at the given offset, eventually followed by the scope and the exclamation mark.
+func (m *machine) colonBelongsAt(offset int) bool {
+	if offset >= len(m.data) {
+		return false
+	}
+	_, end := m.typeToken()
+	if end == offset {
+		return true
+	}
+
+	return end < offset && m.data[end] == '(' && bytes.IndexByte([]byte(")!"), m.data[offset-1]) >= 0
+}
+
+// fixDescriptionInit attaches to the error the edit inserting the missing white-space before the description.
+func (m *machine) fixDescriptionInit(err error) error {
+	e := err.(*Error)
+	if e.Offset < len(m.data) {
+		switch m.data[e.Offset] {
+		case 9:
+			e.Fixes = append(e.Fixes, Edit{Offset: e.Offset, Delete: 1, Text: " "})
+		case 10:
+		default:
+			e.Fixes = append(e.Fixes, Edit{Offset: e.Offset, Text: " "})
+		}
+	}
+
+	return e
+}
+
+// fixBlankLine attaches to the error the edit inserting the missing blank line after the header.
+//
+// When the header is followed by a lone newline, the edit removes it instead.
+func (m *machine) fixBlankLine(err error) error {
+	e := err.(*Error)
+	if e.Offset < len(m.data) {
+		e.Fixes = append(e.Fixes, Edit{Offset: e.Offset, Text: "\n"})
+	} else if e.Offset == len(m.data) && e.Offset > 0 && m.data[e.Offset-1] == 10 {
+		e.Fixes = append(e.Fixes, Edit{Offset: e.Offset - 1, Delete: 1})
+	}
+
+	return e
+}
+
+// notifyBody coalesces the body content into lines, notifying the listener about every complete one.
+//
+// The pending content (ie., the last line) gets notified when flushing.
+func (m *machine) notifyBody(start, end int) {
+	if start != m.bodyChunk.End {
+		m.flushBody()
+		m.bodyChunk.Start = start
+	}
+	for i := start; i < end; i++ {
+		m.bodyChunk.End = i + 1
+		if m.data[i] == 10 {
+			m.flushBody()
+		}
+	}
+}
+
+// flushBody notifies the listener about the pending body content, if any.
+func (m *machine) flushBody() {
+	if m.bodyChunk.End > m.bodyChunk.Start {
+		m.listener.OnBodyChunk(string(m.data[m.bodyChunk.Start:m.bodyChunk.End]), m.bodyChunk)
+	}
+	m.bodyChunk.Start = m.bodyChunk.End
+}
+
+// recover records the current error, so that the machine can continue parsing.
+func (m *machine) recover() {
+	if m.err != nil {
+		m.errors = append(m.errors, m.err)
+		m.err = nil
+	}
 }
 
 // NewMachine creates a new FSM able to parse Conventional Commits.
@@ -132,15 +499,46 @@ func (m *machine) Parse(input []byte) (conventionalcommits.Message, error) {
 	m.p = 0
 	m.pb = 0
 	m.pe = len(input)
-	m.eof = len(input)
+	var trailers []gitTrailer
+	if m.gitTrailers {
+		// Parse only what precedes the trailer block
+		m.pe = findGitTrailerBlock(input, m.gitSeparators)
+		trailers = parseGitTrailers(input, m.pe, m.gitSeparators)
+	}
+	m.eof = m.pe
+	m.input = ""
+	if m.zeroCopy {
+		// The only copy of the input, all the parts of the resulting message are its substrings
+		m.input = string(input)
+	}
+	m.body.reset()
+	m.finalParagraph = finalParagraph(input[:m.pe])
+	m.bodyStart = -1
+	m.footerStart = m.pe
 	m.err = nil
+	m.errors = nil
+	m.typeStart = 0
+	m.bodyChunk = conventionalcommits.Span{}
 	m.currentFooterKey = ""
 	m.countNewlines = 0
 	output := &conventionalCommit{}
 	output.footers = make(map[string][]string)
 	output.typeconfig = m.typeConfig
+	output.typealiases = m.typeAliases
 
 	switch m.typeConfig {
+	case conventionalcommits.TypesLinuxKernel:
+		m.cs = enLinuxKernelTypesMain
+		break
+	case conventionalcommits.TypesESLint:
+		m.cs = enEslintTypesMain
+		break
+	case conventionalcommits.TypesElectron:
+		m.cs = enElectronTypesMain
+		break
+	case conventionalcommits.TypesAngular:
+		m.cs = enAngularTypesMain
+		break
 	case conventionalcommits.TypesFreeForm:
 		m.cs = enFreeFormTypesMain
 		break
@@ -185,30 +583,64 @@ func (m *machine) Parse(input []byte) (conventionalcommits.Message, error) {
 			goto stCase7
 		case 8:
 			goto stCase8
-		case 125:
-			goto stCase125
 		case 9:
 			goto stCase9
-		case 126:
-			goto stCase126
 		case 10:
 			goto stCase10
 		case 11:
 			goto stCase11
 		case 12:
 			goto stCase12
+		case 651:
+			goto stCase651
 		case 13:
 			goto stCase13
+		case 652:
+			goto stCase652
+		case 14:
+			goto stCase14
+		case 15:
+			goto stCase15
+		case 16:
+			goto stCase16
+		case 17:
+			goto stCase17
+		case 18:
+			goto stCase18
+		case 19:
+			goto stCase19
+		case 20:
+			goto stCase20
+		case 21:
+			goto stCase21
+		case 653:
+			goto stCase653
+		case 22:
+			goto stCase22
+		case 23:
+			goto stCase23
+		case 24:
+			goto stCase24
+		case 25:
+			goto stCase25
+		case 26:
+			goto stCase26
+		case 27:
+			goto stCase27
+		case 28:
+			goto stCase28
+		case 29:
+			goto stCase29
+		case 30:
+			goto stCase30
+		case 31:
+			goto stCase31
+		case 32:
+			goto stCase32
 		case 33:
 			goto stCase33
-		case 130:
-			goto stCase130
-		case 131:
-			goto stCase131
 		case 34:
 			goto stCase34
-		case 132:
-			goto stCase132
 		case 35:
 			goto stCase35
 		case 36:
@@ -227,12 +659,8 @@ func (m *machine) Parse(input []byte) (conventionalcommits.Message, error) {
 			goto stCase42
 		case 43:
 			goto stCase43
-		case 133:
-			goto stCase133
 		case 44:
 			goto stCase44
-		case 134:
-			goto stCase134
 		case 45:
 			goto stCase45
 		case 46:
@@ -273,52 +701,14 @@ func (m *machine) Parse(input []byte) (conventionalcommits.Message, error) {
 			goto stCase63
 		case 64:
 			goto stCase64
-		case 65:
-			goto stCase65
-		case 66:
-			goto stCase66
-		case 67:
-			goto stCase67
-		case 68:
-			goto stCase68
-		case 69:
-			goto stCase69
-		case 70:
-			goto stCase70
-		case 71:
-			goto stCase71
-		case 72:
-			goto stCase72
-		case 73:
-			goto stCase73
-		case 74:
-			goto stCase74
-		case 75:
-			goto stCase75
-		case 76:
-			goto stCase76
-		case 77:
-			goto stCase77
-		case 78:
-			goto stCase78
-		case 79:
-			goto stCase79
-		case 80:
-			goto stCase80
-		case 81:
-			goto stCase81
-		case 82:
-			goto stCase82
-		case 83:
-			goto stCase83
 		case 84:
 			goto stCase84
-		case 135:
-			goto stCase135
+		case 657:
+			goto stCase657
+		case 658:
+			goto stCase658
 		case 85:
 			goto stCase85
-		case 136:
-			goto stCase136
 		case 86:
 			goto stCase86
 		case 87:
@@ -333,10 +723,16 @@ func (m *machine) Parse(input []byte) (conventionalcommits.Message, error) {
 			goto stCase91
 		case 92:
 			goto stCase92
+		case 659:
+			goto stCase659
 		case 93:
 			goto stCase93
+		case 662:
+			goto stCase662
 		case 94:
 			goto stCase94
+		case 663:
+			goto stCase663
 		case 95:
 			goto stCase95
 		case 96:
@@ -355,8 +751,12 @@ func (m *machine) Parse(input []byte) (conventionalcommits.Message, error) {
 			goto stCase102
 		case 103:
 			goto stCase103
+		case 664:
+			goto stCase664
 		case 104:
 			goto stCase104
+		case 665:
+			goto stCase665
 		case 105:
 			goto stCase105
 		case 106:
@@ -373,6 +773,8 @@ func (m *machine) Parse(input []byte) (conventionalcommits.Message, error) {
 			goto stCase111
 		case 112:
 			goto stCase112
+		case 666:
+			goto stCase666
 		case 113:
 			goto stCase113
 		case 114:
@@ -389,121 +791,1441 @@ func (m *machine) Parse(input []byte) (conventionalcommits.Message, error) {
 			goto stCase119
 		case 120:
 			goto stCase120
-		case 137:
-			goto stCase137
 		case 121:
 			goto stCase121
-		case 138:
-			goto stCase138
 		case 122:
 			goto stCase122
 		case 123:
 			goto stCase123
 		case 124:
 			goto stCase124
+		case 125:
+			goto stCase125
+		case 126:
+			goto stCase126
 		case 127:
 			goto stCase127
-		case 14:
-			goto stCase14
-		case 15:
-			goto stCase15
 		case 128:
 			goto stCase128
-		case 16:
-			goto stCase16
-		case 17:
-			goto stCase17
 		case 129:
 			goto stCase129
-		case 18:
-			goto stCase18
-		case 19:
-			goto stCase19
-		case 20:
-			goto stCase20
-		case 21:
-			goto stCase21
-		case 22:
-			goto stCase22
-		case 23:
-			goto stCase23
-		case 24:
-			goto stCase24
-		case 25:
-			goto stCase25
-		case 26:
-			goto stCase26
-		case 27:
-			goto stCase27
-		case 28:
-			goto stCase28
-		case 29:
-			goto stCase29
-		case 30:
-			goto stCase30
-		case 31:
-			goto stCase31
-		case 32:
-			goto stCase32
+		case 130:
+			goto stCase130
+		case 131:
+			goto stCase131
+		case 132:
+			goto stCase132
+		case 133:
+			goto stCase133
+		case 134:
+			goto stCase134
+		case 135:
+			goto stCase135
+		case 136:
+			goto stCase136
+		case 137:
+			goto stCase137
+		case 138:
+			goto stCase138
+		case 139:
+			goto stCase139
+		case 140:
+			goto stCase140
+		case 141:
+			goto stCase141
+		case 142:
+			goto stCase142
+		case 143:
+			goto stCase143
+		case 144:
+			goto stCase144
+		case 145:
+			goto stCase145
+		case 146:
+			goto stCase146
+		case 147:
+			goto stCase147
+		case 148:
+			goto stCase148
+		case 149:
+			goto stCase149
+		case 150:
+			goto stCase150
+		case 151:
+			goto stCase151
+		case 152:
+			goto stCase152
+		case 153:
+			goto stCase153
+		case 154:
+			goto stCase154
+		case 155:
+			goto stCase155
+		case 156:
+			goto stCase156
+		case 157:
+			goto stCase157
+		case 158:
+			goto stCase158
+		case 159:
+			goto stCase159
+		case 160:
+			goto stCase160
+		case 161:
+			goto stCase161
+		case 162:
+			goto stCase162
+		case 163:
+			goto stCase163
+		case 164:
+			goto stCase164
+		case 165:
+			goto stCase165
+		case 166:
+			goto stCase166
+		case 167:
+			goto stCase167
+		case 168:
+			goto stCase168
+		case 169:
+			goto stCase169
+		case 170:
+			goto stCase170
+		case 171:
+			goto stCase171
+		case 172:
+			goto stCase172
+		case 173:
+			goto stCase173
+		case 174:
+			goto stCase174
+		case 175:
+			goto stCase175
+		case 176:
+			goto stCase176
+		case 177:
+			goto stCase177
+		case 178:
+			goto stCase178
+		case 179:
+			goto stCase179
+		case 180:
+			goto stCase180
+		case 181:
+			goto stCase181
+		case 182:
+			goto stCase182
+		case 183:
+			goto stCase183
+		case 184:
+			goto stCase184
+		case 185:
+			goto stCase185
+		case 186:
+			goto stCase186
+		case 187:
+			goto stCase187
+		case 188:
+			goto stCase188
+		case 189:
+			goto stCase189
+		case 190:
+			goto stCase190
+		case 191:
+			goto stCase191
+		case 192:
+			goto stCase192
+		case 193:
+			goto stCase193
+		case 194:
+			goto stCase194
+		case 195:
+			goto stCase195
+		case 196:
+			goto stCase196
+		case 197:
+			goto stCase197
+		case 198:
+			goto stCase198
+		case 199:
+			goto stCase199
+		case 200:
+			goto stCase200
+		case 201:
+			goto stCase201
+		case 202:
+			goto stCase202
+		case 667:
+			goto stCase667
+		case 203:
+			goto stCase203
+		case 668:
+			goto stCase668
+		case 204:
+			goto stCase204
+		case 205:
+			goto stCase205
+		case 206:
+			goto stCase206
+		case 207:
+			goto stCase207
+		case 208:
+			goto stCase208
+		case 209:
+			goto stCase209
+		case 210:
+			goto stCase210
+		case 211:
+			goto stCase211
+		case 669:
+			goto stCase669
+		case 212:
+			goto stCase212
+		case 213:
+			goto stCase213
+		case 214:
+			goto stCase214
+		case 215:
+			goto stCase215
+		case 216:
+			goto stCase216
+		case 217:
+			goto stCase217
+		case 218:
+			goto stCase218
+		case 219:
+			goto stCase219
+		case 220:
+			goto stCase220
+		case 221:
+			goto stCase221
+		case 222:
+			goto stCase222
+		case 223:
+			goto stCase223
+		case 224:
+			goto stCase224
+		case 225:
+			goto stCase225
+		case 226:
+			goto stCase226
+		case 227:
+			goto stCase227
+		case 228:
+			goto stCase228
+		case 229:
+			goto stCase229
+		case 230:
+			goto stCase230
+		case 231:
+			goto stCase231
+		case 232:
+			goto stCase232
+		case 233:
+			goto stCase233
+		case 234:
+			goto stCase234
+		case 235:
+			goto stCase235
+		case 236:
+			goto stCase236
+		case 237:
+			goto stCase237
+		case 238:
+			goto stCase238
+		case 239:
+			goto stCase239
+		case 240:
+			goto stCase240
+		case 241:
+			goto stCase241
+		case 242:
+			goto stCase242
+		case 243:
+			goto stCase243
+		case 244:
+			goto stCase244
+		case 245:
+			goto stCase245
+		case 246:
+			goto stCase246
+		case 247:
+			goto stCase247
+		case 248:
+			goto stCase248
+		case 249:
+			goto stCase249
+		case 250:
+			goto stCase250
+		case 251:
+			goto stCase251
+		case 252:
+			goto stCase252
+		case 253:
+			goto stCase253
+		case 254:
+			goto stCase254
+		case 255:
+			goto stCase255
+		case 256:
+			goto stCase256
+		case 257:
+			goto stCase257
+		case 258:
+			goto stCase258
+		case 259:
+			goto stCase259
+		case 260:
+			goto stCase260
+		case 261:
+			goto stCase261
+		case 262:
+			goto stCase262
+		case 263:
+			goto stCase263
+		case 264:
+			goto stCase264
+		case 265:
+			goto stCase265
+		case 266:
+			goto stCase266
+		case 267:
+			goto stCase267
+		case 268:
+			goto stCase268
+		case 269:
+			goto stCase269
+		case 270:
+			goto stCase270
+		case 271:
+			goto stCase271
+		case 272:
+			goto stCase272
+		case 273:
+			goto stCase273
+		case 274:
+			goto stCase274
+		case 275:
+			goto stCase275
+		case 276:
+			goto stCase276
+		case 277:
+			goto stCase277
+		case 278:
+			goto stCase278
+		case 279:
+			goto stCase279
+		case 280:
+			goto stCase280
+		case 281:
+			goto stCase281
+		case 282:
+			goto stCase282
+		case 283:
+			goto stCase283
+		case 284:
+			goto stCase284
+		case 285:
+			goto stCase285
+		case 286:
+			goto stCase286
+		case 287:
+			goto stCase287
+		case 288:
+			goto stCase288
+		case 289:
+			goto stCase289
+		case 290:
+			goto stCase290
+		case 291:
+			goto stCase291
+		case 292:
+			goto stCase292
+		case 293:
+			goto stCase293
+		case 294:
+			goto stCase294
+		case 295:
+			goto stCase295
+		case 296:
+			goto stCase296
+		case 297:
+			goto stCase297
+		case 670:
+			goto stCase670
+		case 298:
+			goto stCase298
+		case 671:
+			goto stCase671
+		case 299:
+			goto stCase299
+		case 300:
+			goto stCase300
+		case 301:
+			goto stCase301
+		case 302:
+			goto stCase302
+		case 303:
+			goto stCase303
+		case 304:
+			goto stCase304
+		case 305:
+			goto stCase305
+		case 306:
+			goto stCase306
+		case 672:
+			goto stCase672
+		case 307:
+			goto stCase307
+		case 308:
+			goto stCase308
+		case 309:
+			goto stCase309
+		case 310:
+			goto stCase310
+		case 311:
+			goto stCase311
+		case 312:
+			goto stCase312
+		case 313:
+			goto stCase313
+		case 314:
+			goto stCase314
+		case 315:
+			goto stCase315
+		case 316:
+			goto stCase316
+		case 317:
+			goto stCase317
+		case 318:
+			goto stCase318
+		case 319:
+			goto stCase319
+		case 320:
+			goto stCase320
+		case 321:
+			goto stCase321
+		case 322:
+			goto stCase322
+		case 323:
+			goto stCase323
+		case 324:
+			goto stCase324
+		case 325:
+			goto stCase325
+		case 326:
+			goto stCase326
+		case 327:
+			goto stCase327
+		case 328:
+			goto stCase328
+		case 329:
+			goto stCase329
+		case 330:
+			goto stCase330
+		case 331:
+			goto stCase331
+		case 332:
+			goto stCase332
+		case 333:
+			goto stCase333
+		case 334:
+			goto stCase334
+		case 335:
+			goto stCase335
+		case 336:
+			goto stCase336
+		case 337:
+			goto stCase337
+		case 338:
+			goto stCase338
+		case 339:
+			goto stCase339
+		case 340:
+			goto stCase340
+		case 341:
+			goto stCase341
+		case 342:
+			goto stCase342
+		case 343:
+			goto stCase343
+		case 344:
+			goto stCase344
+		case 345:
+			goto stCase345
+		case 346:
+			goto stCase346
+		case 347:
+			goto stCase347
+		case 348:
+			goto stCase348
+		case 349:
+			goto stCase349
+		case 350:
+			goto stCase350
+		case 351:
+			goto stCase351
+		case 352:
+			goto stCase352
+		case 353:
+			goto stCase353
+		case 354:
+			goto stCase354
+		case 355:
+			goto stCase355
+		case 356:
+			goto stCase356
+		case 357:
+			goto stCase357
+		case 358:
+			goto stCase358
+		case 359:
+			goto stCase359
+		case 360:
+			goto stCase360
+		case 361:
+			goto stCase361
+		case 362:
+			goto stCase362
+		case 363:
+			goto stCase363
+		case 364:
+			goto stCase364
+		case 365:
+			goto stCase365
+		case 366:
+			goto stCase366
+		case 367:
+			goto stCase367
+		case 368:
+			goto stCase368
+		case 369:
+			goto stCase369
+		case 370:
+			goto stCase370
+		case 371:
+			goto stCase371
+		case 372:
+			goto stCase372
+		case 373:
+			goto stCase373
+		case 374:
+			goto stCase374
+		case 375:
+			goto stCase375
+		case 376:
+			goto stCase376
+		case 377:
+			goto stCase377
+		case 378:
+			goto stCase378
+		case 379:
+			goto stCase379
+		case 380:
+			goto stCase380
+		case 381:
+			goto stCase381
+		case 382:
+			goto stCase382
+		case 383:
+			goto stCase383
+		case 384:
+			goto stCase384
+		case 673:
+			goto stCase673
+		case 385:
+			goto stCase385
+		case 674:
+			goto stCase674
+		case 386:
+			goto stCase386
+		case 387:
+			goto stCase387
+		case 388:
+			goto stCase388
+		case 389:
+			goto stCase389
+		case 390:
+			goto stCase390
+		case 391:
+			goto stCase391
+		case 392:
+			goto stCase392
+		case 393:
+			goto stCase393
+		case 675:
+			goto stCase675
+		case 394:
+			goto stCase394
+		case 395:
+			goto stCase395
+		case 396:
+			goto stCase396
+		case 397:
+			goto stCase397
+		case 398:
+			goto stCase398
+		case 399:
+			goto stCase399
+		case 400:
+			goto stCase400
+		case 401:
+			goto stCase401
+		case 402:
+			goto stCase402
+		case 403:
+			goto stCase403
+		case 404:
+			goto stCase404
+		case 405:
+			goto stCase405
+		case 406:
+			goto stCase406
+		case 407:
+			goto stCase407
+		case 408:
+			goto stCase408
+		case 409:
+			goto stCase409
+		case 410:
+			goto stCase410
+		case 411:
+			goto stCase411
+		case 412:
+			goto stCase412
+		case 413:
+			goto stCase413
+		case 414:
+			goto stCase414
+		case 415:
+			goto stCase415
+		case 416:
+			goto stCase416
+		case 417:
+			goto stCase417
+		case 418:
+			goto stCase418
+		case 419:
+			goto stCase419
+		case 420:
+			goto stCase420
+		case 421:
+			goto stCase421
+		case 422:
+			goto stCase422
+		case 423:
+			goto stCase423
+		case 424:
+			goto stCase424
+		case 425:
+			goto stCase425
+		case 426:
+			goto stCase426
+		case 427:
+			goto stCase427
+		case 428:
+			goto stCase428
+		case 429:
+			goto stCase429
+		case 430:
+			goto stCase430
+		case 431:
+			goto stCase431
+		case 432:
+			goto stCase432
+		case 433:
+			goto stCase433
+		case 434:
+			goto stCase434
+		case 435:
+			goto stCase435
+		case 436:
+			goto stCase436
+		case 437:
+			goto stCase437
+		case 438:
+			goto stCase438
+		case 439:
+			goto stCase439
+		case 440:
+			goto stCase440
+		case 441:
+			goto stCase441
+		case 442:
+			goto stCase442
+		case 443:
+			goto stCase443
+		case 444:
+			goto stCase444
+		case 445:
+			goto stCase445
+		case 446:
+			goto stCase446
+		case 447:
+			goto stCase447
+		case 448:
+			goto stCase448
+		case 449:
+			goto stCase449
+		case 450:
+			goto stCase450
+		case 451:
+			goto stCase451
+		case 452:
+			goto stCase452
+		case 453:
+			goto stCase453
+		case 454:
+			goto stCase454
+		case 455:
+			goto stCase455
+		case 456:
+			goto stCase456
+		case 457:
+			goto stCase457
+		case 458:
+			goto stCase458
+		case 459:
+			goto stCase459
+		case 460:
+			goto stCase460
+		case 461:
+			goto stCase461
+		case 462:
+			goto stCase462
+		case 463:
+			goto stCase463
+		case 464:
+			goto stCase464
+		case 465:
+			goto stCase465
+		case 466:
+			goto stCase466
+		case 467:
+			goto stCase467
+		case 468:
+			goto stCase468
+		case 469:
+			goto stCase469
+		case 470:
+			goto stCase470
+		case 471:
+			goto stCase471
+		case 472:
+			goto stCase472
+		case 473:
+			goto stCase473
+		case 474:
+			goto stCase474
+		case 475:
+			goto stCase475
+		case 476:
+			goto stCase476
+		case 477:
+			goto stCase477
+		case 478:
+			goto stCase478
+		case 479:
+			goto stCase479
+		case 480:
+			goto stCase480
+		case 481:
+			goto stCase481
+		case 482:
+			goto stCase482
+		case 483:
+			goto stCase483
+		case 484:
+			goto stCase484
+		case 676:
+			goto stCase676
+		case 485:
+			goto stCase485
+		case 677:
+			goto stCase677
+		case 486:
+			goto stCase486
+		case 487:
+			goto stCase487
+		case 488:
+			goto stCase488
+		case 489:
+			goto stCase489
+		case 490:
+			goto stCase490
+		case 491:
+			goto stCase491
+		case 492:
+			goto stCase492
+		case 493:
+			goto stCase493
+		case 678:
+			goto stCase678
+		case 494:
+			goto stCase494
+		case 495:
+			goto stCase495
+		case 496:
+			goto stCase496
+		case 497:
+			goto stCase497
+		case 498:
+			goto stCase498
+		case 499:
+			goto stCase499
+		case 500:
+			goto stCase500
+		case 501:
+			goto stCase501
+		case 502:
+			goto stCase502
+		case 503:
+			goto stCase503
+		case 504:
+			goto stCase504
+		case 505:
+			goto stCase505
+		case 506:
+			goto stCase506
+		case 507:
+			goto stCase507
+		case 508:
+			goto stCase508
+		case 509:
+			goto stCase509
+		case 510:
+			goto stCase510
+		case 511:
+			goto stCase511
+		case 512:
+			goto stCase512
+		case 513:
+			goto stCase513
+		case 514:
+			goto stCase514
+		case 515:
+			goto stCase515
+		case 516:
+			goto stCase516
+		case 517:
+			goto stCase517
+		case 518:
+			goto stCase518
+		case 519:
+			goto stCase519
+		case 520:
+			goto stCase520
+		case 521:
+			goto stCase521
+		case 522:
+			goto stCase522
+		case 523:
+			goto stCase523
+		case 524:
+			goto stCase524
+		case 525:
+			goto stCase525
+		case 526:
+			goto stCase526
+		case 527:
+			goto stCase527
+		case 528:
+			goto stCase528
+		case 529:
+			goto stCase529
+		case 530:
+			goto stCase530
+		case 531:
+			goto stCase531
+		case 532:
+			goto stCase532
+		case 533:
+			goto stCase533
+		case 534:
+			goto stCase534
+		case 535:
+			goto stCase535
+		case 536:
+			goto stCase536
+		case 537:
+			goto stCase537
+		case 538:
+			goto stCase538
+		case 539:
+			goto stCase539
+		case 540:
+			goto stCase540
+		case 541:
+			goto stCase541
+		case 542:
+			goto stCase542
+		case 543:
+			goto stCase543
+		case 544:
+			goto stCase544
+		case 545:
+			goto stCase545
+		case 546:
+			goto stCase546
+		case 547:
+			goto stCase547
+		case 548:
+			goto stCase548
+		case 549:
+			goto stCase549
+		case 550:
+			goto stCase550
+		case 551:
+			goto stCase551
+		case 552:
+			goto stCase552
+		case 553:
+			goto stCase553
+		case 554:
+			goto stCase554
+		case 555:
+			goto stCase555
+		case 556:
+			goto stCase556
+		case 557:
+			goto stCase557
+		case 558:
+			goto stCase558
+		case 559:
+			goto stCase559
+		case 560:
+			goto stCase560
+		case 561:
+			goto stCase561
+		case 562:
+			goto stCase562
+		case 563:
+			goto stCase563
+		case 564:
+			goto stCase564
+		case 679:
+			goto stCase679
+		case 565:
+			goto stCase565
+		case 680:
+			goto stCase680
+		case 566:
+			goto stCase566
+		case 567:
+			goto stCase567
+		case 568:
+			goto stCase568
+		case 569:
+			goto stCase569
+		case 570:
+			goto stCase570
+		case 571:
+			goto stCase571
+		case 572:
+			goto stCase572
+		case 573:
+			goto stCase573
+		case 681:
+			goto stCase681
+		case 574:
+			goto stCase574
+		case 575:
+			goto stCase575
+		case 576:
+			goto stCase576
+		case 577:
+			goto stCase577
+		case 578:
+			goto stCase578
+		case 579:
+			goto stCase579
+		case 580:
+			goto stCase580
+		case 581:
+			goto stCase581
+		case 582:
+			goto stCase582
+		case 583:
+			goto stCase583
+		case 584:
+			goto stCase584
+		case 585:
+			goto stCase585
+		case 586:
+			goto stCase586
+		case 587:
+			goto stCase587
+		case 588:
+			goto stCase588
+		case 589:
+			goto stCase589
+		case 590:
+			goto stCase590
+		case 591:
+			goto stCase591
+		case 592:
+			goto stCase592
+		case 593:
+			goto stCase593
+		case 594:
+			goto stCase594
+		case 595:
+			goto stCase595
+		case 596:
+			goto stCase596
+		case 597:
+			goto stCase597
+		case 598:
+			goto stCase598
+		case 599:
+			goto stCase599
+		case 600:
+			goto stCase600
+		case 601:
+			goto stCase601
+		case 682:
+			goto stCase682
+		case 602:
+			goto stCase602
+		case 683:
+			goto stCase683
+		case 603:
+			goto stCase603
+		case 604:
+			goto stCase604
+		case 605:
+			goto stCase605
+		case 606:
+			goto stCase606
+		case 607:
+			goto stCase607
+		case 608:
+			goto stCase608
+		case 609:
+			goto stCase609
+		case 610:
+			goto stCase610
+		case 684:
+			goto stCase684
+		case 611:
+			goto stCase611
+		case 612:
+			goto stCase612
+		case 613:
+			goto stCase613
+		case 614:
+			goto stCase614
+		case 615:
+			goto stCase615
+		case 616:
+			goto stCase616
+		case 617:
+			goto stCase617
+		case 618:
+			goto stCase618
+		case 619:
+			goto stCase619
+		case 620:
+			goto stCase620
+		case 621:
+			goto stCase621
+		case 622:
+			goto stCase622
+		case 623:
+			goto stCase623
+		case 624:
+			goto stCase624
+		case 625:
+			goto stCase625
+		case 626:
+			goto stCase626
+		case 627:
+			goto stCase627
+		case 628:
+			goto stCase628
+		case 629:
+			goto stCase629
+		case 630:
+			goto stCase630
+		case 631:
+			goto stCase631
+		case 632:
+			goto stCase632
+		case 633:
+			goto stCase633
+		case 634:
+			goto stCase634
+		case 635:
+			goto stCase635
+		case 636:
+			goto stCase636
+		case 637:
+			goto stCase637
+		case 638:
+			goto stCase638
+		case 639:
+			goto stCase639
+		case 640:
+			goto stCase640
+		case 641:
+			goto stCase641
+		case 642:
+			goto stCase642
+		case 643:
+			goto stCase643
+		case 644:
+			goto stCase644
+		case 645:
+			goto stCase645
+		case 646:
+			goto stCase646
+		case 647:
+			goto stCase647
+		case 648:
+			goto stCase648
+		case 649:
+			goto stCase649
+		case 650:
+			goto stCase650
+		case 654:
+			goto stCase654
+		case 65:
+			goto stCase65
+		case 66:
+			goto stCase66
+		case 655:
+			goto stCase655
+		case 67:
+			goto stCase67
+		case 68:
+			goto stCase68
+		case 656:
+			goto stCase656
+		case 69:
+			goto stCase69
+		case 70:
+			goto stCase70
+		case 71:
+			goto stCase71
+		case 72:
+			goto stCase72
+		case 73:
+			goto stCase73
+		case 74:
+			goto stCase74
+		case 75:
+			goto stCase75
+		case 76:
+			goto stCase76
+		case 77:
+			goto stCase77
+		case 78:
+			goto stCase78
+		case 79:
+			goto stCase79
+		case 80:
+			goto stCase80
+		case 81:
+			goto stCase81
+		case 82:
+			goto stCase82
+		case 83:
+			goto stCase83
+		case 660:
+			goto stCase660
+		case 661:
+			goto stCase661
 		}
 		goto stOut
 	stCase1:
-		switch (m.data)[(m.p)] {
-		case 70:
-			goto tr1
-		case 102:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 98:
+			switch {
+			case (m.data)[(m.p)] < 66:
+				if 58 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 58 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 66:
+				switch {
+				case (m.data)[(m.p)] > 70:
+					if 72 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 72 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 70:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 98:
+			switch {
+			case (m.data)[(m.p)] < 104:
+				if 102 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 102 {
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 104:
+				switch {
+				case (m.data)[(m.p)] > 226:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+						if m.gitmoji {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 226:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3130:
 			goto tr1
+		case 3298:
+			goto tr2
+		case 3312:
+			goto tr3
+		case 3398:
+			goto tr4
+		case 3430:
+			goto tr4
+		case 3650:
+			goto tr5
+		case 3654:
+			goto tr6
+		case 3656:
+			goto tr7
+		case 3682:
+			goto tr5
+		case 3686:
+			goto tr6
+		case 3688:
+			goto tr7
 		}
 		goto tr0
 	tr0:
 
 		if m.pe > 0 {
 			if m.p != m.pe {
-				m.err = m.emitErrorOnCurrentCharacter(ErrType)
+				m.err = m.suggestType(m.emitErrorOnCurrentCharacter(ErrType))
 			} else {
 				// assert(m.p == m.pe)
-				m.err = m.emitErrorOnPreviousCharacter(ErrTypeIncomplete)
+				m.err = m.suggestType(m.emitErrorOnPreviousCharacter(ErrTypeIncomplete))
+			}
+		}
+
+		if m.recovery {
+			m.recover()
+			if m.p < m.pe {
+				// Skip the rest of the header
+				(m.p)--
+
+				{
+					goto st94
+				}
 			}
 		}
 
 		goto st0
-	tr6:
+	tr8:
+
+		if m.p < m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrGitmoji)
+		} else {
+			// assert(m.p == m.pe)
+			m.err = m.emitErrorOnPreviousCharacter(ErrGitmojiIncomplete)
+		}
+
+		if m.recovery {
+			m.recover()
+			if m.p < m.pe {
+				// Skip the rest of the header
+				(m.p)--
+
+				{
+					goto st94
+				}
+			}
+		}
+
+		goto st0
+	tr21:
 
 		if m.err == nil {
-			m.err = m.emitErrorOnCurrentCharacter(ErrColon)
+			m.err = m.fixColon(m.emitErrorOnCurrentCharacter(ErrColon))
+		}
+
+		if m.recovery {
+			m.recover()
+			if m.p < m.pe {
+				// Skip the rest of the header
+				(m.p)--
+
+				{
+					goto st94
+				}
+			}
 		}
 
 		goto st0
-	tr10:
+	tr25:
 
 		if m.err == nil {
-			m.err = m.emitErrorOnCurrentCharacter(ErrDescriptionInit)
+			m.err = m.fixDescriptionInit(m.emitErrorOnCurrentCharacter(ErrDescriptionInit))
+		}
+
+		if m.recovery {
+			m.recover()
+			if m.p < m.pe {
+				// Skip the rest of the header
+				(m.p)--
+
+				{
+					goto st94
+				}
+			}
 		}
 
 		goto st0
-	tr13:
+	tr27:
 
-		if m.p < m.pe && m.data[m.p] == 10 {
-			m.err = m.emitError(ErrNewline, m.p+1)
-		} else {
-			// assert(m.p == m.pe)
-			m.err = m.emitErrorOnPreviousCharacter(ErrDescription)
+		if m.err == nil {
+			if m.p < m.pe {
+				switch m.data[m.p] {
+				case 9:
+					// assert(m.strictWhitespace)
+					m.err = m.emitErrorWithoutCharacter(ErrDescriptionTab)
+				case 32:
+					// assert(m.strictWhitespace)
+					m.err = m.emitErrorOnCurrentCharacter(ErrDescriptionWhitespace)
+				case 10:
+					m.err = m.emitError(m.p+1, ErrNewline)
+				default:
+					m.err = m.emitError(m.runeStart(), ErrDescriptionEncoding)
+				}
+			} else {
+				// assert(m.p == m.pe)
+				m.err = m.emitErrorOnPreviousCharacter(ErrDescription)
+			}
+		}
+
+		if m.recovery {
+			m.recover()
+			if m.p < m.pe {
+				// Skip the rest of the header
+				(m.p)--
+
+				{
+					goto st94
+				}
+			}
 		}
 
 		goto st0
-	tr14:
+	tr45:
+
+		m.err = m.fixBlankLine(m.emitErrorWithoutCharacter(ErrMissingBlankLineAtBeginning))
+
+		if m.recovery {
+			m.recover()
+			if m.p < m.pe {
+				// Assume the body (or the footer) starts at the current character
+				m.pb = m.p
+				if m.bodyStart < 0 {
+					m.bodyStart = m.pb
+				}
+				(m.p)--
+
+				if m.gitTrailers {
+					{
+						goto st660
+					}
+				}
+				{
+					goto st654
+				}
+			}
+		}
+
+		goto st0
+	tr50:
+
+		if m.p < m.pe && m.data[m.p] == 9 {
+			m.err = m.emitErrorWithoutCharacter(ErrDescriptionTab)
+		} else if m.p < m.pe && m.data[m.p] != 10 {
+			m.err = m.emitError(m.runeStart(), ErrDescriptionEncoding)
+		} else {
+			// assert(m.data[m.p - 1] == 32)
+			i := m.p - 1
+			for ; m.data[i-1] == 32; i-- {
+			}
+			m.err = m.emitError(i, ErrDescriptionTrailingWhitespace)
+		}
 
-		m.err = m.emitErrorWithoutCharacter(ErrMissingBlankLineAtBeginning)
+		if m.recovery {
+			m.recover()
+			if m.p < m.pe {
+				// Skip the rest of the header
+				(m.p)--
+
+				{
+					goto st94
+				}
+			}
+		}
 
 		goto st0
-	tr16:
+	tr60:
 
 		if m.p < m.pe {
 			m.err = m.emitErrorOnCurrentCharacter(ErrScope)
+		} else {
+			// assert(m.p == m.pe)
+			m.err = m.emitErrorOnPreviousCharacter(ErrScopeIncomplete)
+		}
+
+		if m.recovery {
+			m.recover()
+			if m.p < m.pe {
+				// Skip the rest of the header
+				(m.p)--
+
+				{
+					goto st94
+				}
+			}
 		}
 
 		goto st0
-	tr21:
+	tr100:
 
 		if len(output.footers) == 0 {
 			// Backtrack to the last marker
@@ -516,9 +2238,11 @@ func (m *machine) Parse(input []byte) (conventionalcommits.Message, error) {
 			}
 			(m.p) = (m.pb) - 1
 
-			m.emitDebug("try to parse body content", "pos", m.p)
+			if m.logger != nil {
+				m.logger.Debug("try to parse body content", "pos", m.p)
+			}
 			{
-				goto st34
+				goto st92
 			}
 		} else {
 			// A rewind happens when an error while parsing a footer trailer is encountered
@@ -530,50 +2254,132 @@ func (m *machine) Parse(input []byte) (conventionalcommits.Message, error) {
 				// assert(m.p == m.pe)
 				m.err = m.emitErrorOnPreviousCharacter(ErrTrailerIncomplete)
 			}
+			if m.recovery {
+				m.recover()
+				if m.p < m.pe {
+					// Skip the current line and continue looking for footer trailers
+					(m.p)--
+
+					{
+						goto st93
+					}
+				}
+			}
 		}
 
 		goto st0
-	tr44:
+	tr121:
+
+		if m.p < m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrTrailer)
+		} else {
+			// The input ends right after the footer trailer separator
+			m.err = m.emitErrorOnPreviousCharacter(ErrTrailerIncomplete)
+		}
+
+		if m.recovery {
+			m.recover()
+			if m.p < m.pe {
+				// Skip the rest of the current footer trailer
+				(m.p)--
+
+				{
+					goto st93
+				}
+			}
+		}
+
+		goto st0
+	tr133:
 
+		if m.listener != nil {
+			m.notifyBody(m.pb-m.countNewlines, m.p)
+		}
 		// Append newlines
+		m.body.addNewlines(m.data, m.pb, m.countNewlines)
 		for m.countNewlines > 0 {
-			output.body += "\n"
 			m.countNewlines--
-			m.emitInfo("valid commit message body content", "body", "\n")
+			if m.logger != nil {
+				m.logger.Info("valid commit message body content", "body", "\n")
+			}
 		}
 		// Append body content
-		output.body += string(m.text())
-		m.emitInfo("valid commit message body content", "body", string(m.text()))
+		m.body.add(m.data, m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message body content", "body", string(m.text()))
+		}
 
-		m.emitDebug("try to parse a footer trailer token", "pos", m.p)
+		// Mark the next character so that rewinds can not go back before it
+		// Eg., when it is not a possible trailer token start
+		m.pb = m.p + 1
+		if m.bodyStart < 0 {
+			// The first time is right after the header
+			m.bodyStart = m.pb
+		}
+		if m.gitTrailers {
+			// The footer is the trailer block git finds, already cut off from the input
+			{
+				goto st660
+			}
+		}
+		if m.logger != nil {
+			m.logger.Debug("try to parse a footer trailer token", "pos", m.p)
+		}
 		{
-			goto st127
+			goto st654
 		}
 
 		goto st0
-	tr151:
+	tr943:
 
+		if m.listener != nil {
+			m.notifyBody(m.pb-m.countNewlines, m.p)
+		}
 		// Append newlines
+		m.body.addNewlines(m.data, m.pb, m.countNewlines)
 		for m.countNewlines > 0 {
-			output.body += "\n"
 			m.countNewlines--
-			m.emitInfo("valid commit message body content", "body", "\n")
+			if m.logger != nil {
+				m.logger.Info("valid commit message body content", "body", "\n")
+			}
 		}
 		// Append body content
-		output.body += string(m.text())
-		m.emitInfo("valid commit message body content", "body", string(m.text()))
+		m.body.add(m.data, m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message body content", "body", string(m.text()))
+		}
 
 		// Append content to body
 		m.pb++
 		m.p++
-		output.body += string(m.text())
-		m.emitInfo("valid commit message body content", "body", string(m.text()))
+		m.body.add(m.data, m.pb, m.p)
+		if m.listener != nil {
+			m.notifyBody(m.pb, m.p)
+		}
+		if m.logger != nil {
+			m.logger.Info("valid commit message body content", "body", string(m.text()))
+		}
 		// Do not advance over the current char
 		(m.p)--
 
-		m.emitDebug("try to parse a footer trailer token", "pos", m.p)
+		// Mark the next character so that rewinds can not go back before it
+		// Eg., when it is not a possible trailer token start
+		m.pb = m.p + 1
+		if m.bodyStart < 0 {
+			// The first time is right after the header
+			m.bodyStart = m.pb
+		}
+		if m.gitTrailers {
+			// The footer is the trailer block git finds, already cut off from the input
+			{
+				goto st660
+			}
+		}
+		if m.logger != nil {
+			m.logger.Debug("try to parse a footer trailer token", "pos", m.p)
+		}
 		{
-			goto st127
+			goto st654
 		}
 
 		goto st0
@@ -591,2710 +2397,28429 @@ func (m *machine) Parse(input []byte) (conventionalcommits.Message, error) {
 			goto _testEof2
 		}
 	stCase2:
-		switch (m.data)[(m.p)] {
-		case 69:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 48:
+			switch {
+			case (m.data)[(m.p)] > 43:
+				if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 43:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 57:
+			switch {
+			case (m.data)[(m.p)] > 95:
+				if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 95:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3115:
 			goto st3
-		case 73:
-			goto st13
-		case 101:
+		case 3117:
+			goto st3
+		case 3167:
 			goto st3
-		case 105:
-			goto st13
 		}
-		goto tr0
+		switch {
+		case _widec > 3129:
+			if 3169 <= _widec && _widec <= 3194 {
+				goto st3
+			}
+		case _widec >= 3120:
+			goto st3
+		}
+		goto tr8
 	st3:
 		if (m.p)++; (m.p) == (m.pe) {
 			goto _testEof3
 		}
 	stCase3:
-		switch (m.data)[(m.p)] {
-		case 65:
-			goto st4
-		case 97:
-			goto st4
-		}
-		goto tr0
-	st4:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof4
-		}
-	stCase4:
-		switch (m.data)[(m.p)] {
-		case 84:
-			goto st5
-		case 116:
-			goto st5
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 48:
+			switch {
+			case (m.data)[(m.p)] > 43:
+				if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 43:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 57:
+			switch {
+			case (m.data)[(m.p)] < 95:
+				if 58 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 58 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 95:
+				if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3115:
+			goto st3
+		case 3117:
+			goto st3
+		case 3130:
+			goto st4
+		case 3167:
+			goto st3
+		}
+		switch {
+		case _widec > 3129:
+			if 3169 <= _widec && _widec <= 3194 {
+				goto st3
+			}
+		case _widec >= 3120:
+			goto st3
+		}
+		goto tr8
+	st4:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof4
+		}
+	stCase4:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 72:
+			switch {
+			case (m.data)[(m.p)] < 66:
+				if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 66:
+				if 70 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 70 {
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 72:
+			switch {
+			case (m.data)[(m.p)] < 102:
+				if 98 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 98 {
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 102:
+				if 104 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 104 {
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3104:
+			goto tr11
+		case 3398:
+			goto tr12
+		case 3430:
+			goto tr12
+		case 3650:
+			goto tr13
+		case 3654:
+			goto tr14
+		case 3656:
+			goto tr15
+		case 3682:
+			goto tr13
+		case 3686:
+			goto tr14
+		case 3688:
+			goto tr15
 		}
 		goto tr0
-	st5:
+	tr11:
 
-		if (m.p + 1) == m.pe {
-			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
 		}
 
+		goto st5
+	st5:
 		if (m.p)++; (m.p) == (m.pe) {
 			goto _testEof5
 		}
 	stCase5:
-
-		output._type = string(m.text())
-		m.emitInfo("valid commit message type", "type", output._type)
-
-		switch (m.data)[(m.p)] {
-		case 33:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 72:
+			switch {
+			case (m.data)[(m.p)] < 66:
+				if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 66:
+				if 70 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 70 {
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 72:
+			switch {
+			case (m.data)[(m.p)] < 102:
+				if 98 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 98 {
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 102:
+				if 104 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 104 {
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3104:
+			goto st5
+		case 3398:
+			goto tr4
+		case 3430:
+			goto tr4
+		case 3650:
+			goto tr5
+		case 3654:
+			goto tr6
+		case 3656:
+			goto tr7
+		case 3682:
+			goto tr5
+		case 3686:
+			goto tr6
+		case 3688:
 			goto tr7
-		case 40:
-			goto st10
-		case 58:
-			goto st7
 		}
-		goto tr6
-	tr7:
+		goto tr0
+	tr4:
 
-		output.exclamation = true
-		m.emitInfo("commit message communicates a breaking change")
+		m.pb = m.p
 
 		goto st6
-	st6:
+	tr12:
 
-		if (m.p + 1) == m.pe {
-			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
 		}
 
+		m.pb = m.p
+
+		goto st6
+	st6:
 		if (m.p)++; (m.p) == (m.pe) {
 			goto _testEof6
 		}
 	stCase6:
-		if (m.data)[(m.p)] == 58 {
+		switch (m.data)[(m.p)] {
+		case 69:
 			goto st7
+		case 73:
+			goto st40
+		case 101:
+			goto st7
+		case 105:
+			goto st40
 		}
-		goto tr6
+		goto tr0
 	st7:
-
-		if (m.p + 1) == m.pe {
-			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
-		}
-
 		if (m.p)++; (m.p) == (m.pe) {
 			goto _testEof7
 		}
 	stCase7:
-		if (m.data)[(m.p)] == 32 {
+		switch (m.data)[(m.p)] {
+		case 65:
+			goto st8
+		case 97:
 			goto st8
 		}
-		goto tr10
+		goto tr0
 	st8:
 		if (m.p)++; (m.p) == (m.pe) {
 			goto _testEof8
 		}
 	stCase8:
 		switch (m.data)[(m.p)] {
-		case 10:
-			goto tr13
-		case 32:
-			goto st8
+		case 84:
+			goto st9
+		case 116:
+			goto st9
 		}
-		goto tr12
-	tr12:
-
-		m.pb = m.p
+		goto tr0
+	st9:
 
-		goto st125
-	st125:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof125
-		}
-	stCase125:
-		if (m.data)[(m.p)] == 10 {
-			goto tr144
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
 		}
-		goto st125
-	tr144:
-
-		output.descr = string(m.text())
-		m.emitInfo("valid commit message description", "description", output.descr)
 
-		goto st9
-	st9:
 		if (m.p)++; (m.p) == (m.pe) {
 			goto _testEof9
 		}
 	stCase9:
-		if (m.data)[(m.p)] == 10 {
-			goto tr15
-		}
-		goto tr14
-	tr15:
 
-		m.emitDebug("found a blank line", "pos", m.p)
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
 
-		m.emitDebug("try to parse a footer trailer token", "pos", m.p)
-		{
-			goto st127
+		switch (m.data)[(m.p)] {
+		case 33:
+			goto tr22
+		case 40:
+			goto st30
+		case 58:
+			goto st11
 		}
+		goto tr21
+	tr22:
 
-		goto st126
-	st126:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof126
+		output.exclamation = true
+		if m.logger != nil {
+			m.logger.Info("commit message communicates a breaking change")
 		}
-	stCase126:
-		goto st0
+		if m.listener != nil {
+			m.listener.OnBreaking(conventionalcommits.Span{Start: m.p, End: m.p + 1})
+		}
+
+		goto st10
 	st10:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
 		if (m.p)++; (m.p) == (m.pe) {
 			goto _testEof10
 		}
 	stCase10:
-		if (m.data)[(m.p)] == 41 {
-			goto tr18
-		}
-		switch {
-		case (m.data)[(m.p)] > 39:
-			if 42 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
-				goto tr17
-			}
-		case (m.data)[(m.p)] >= 32:
-			goto tr17
+		if (m.data)[(m.p)] == 58 {
+			goto st11
 		}
-		goto tr16
-	tr17:
+		goto tr21
+	st11:
 
-		m.pb = m.p
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
 
-		goto st11
-	st11:
 		if (m.p)++; (m.p) == (m.pe) {
 			goto _testEof11
 		}
 	stCase11:
-		if (m.data)[(m.p)] == 41 {
-			goto tr20
-		}
-		switch {
-		case (m.data)[(m.p)] > 39:
-			if 42 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
-				goto st11
-			}
-		case (m.data)[(m.p)] >= 32:
-			goto st11
+		if (m.data)[(m.p)] == 32 {
+			goto st12
 		}
-		goto tr16
-	tr18:
-
-		m.pb = m.p
-
-		output.scope = string(m.text())
-		m.emitInfo("valid commit message scope", "scope", output.scope)
-
-		goto st12
-	tr20:
-
-		output.scope = string(m.text())
-		m.emitInfo("valid commit message scope", "scope", output.scope)
-
-		goto st12
+		goto tr25
 	st12:
-
-		if (m.p + 1) == m.pe {
-			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
-		}
-
 		if (m.p)++; (m.p) == (m.pe) {
 			goto _testEof12
 		}
 	stCase12:
-		switch (m.data)[(m.p)] {
-		case 33:
-			goto tr7
-		case 58:
-			goto st7
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 11:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 9 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 9 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 31:
+				switch {
+				case (m.data)[(m.p)] < 33:
+					if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-		goto tr6
-	st13:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof13
+		switch _widec {
+		case 3872:
+			goto st21
+		case 4064:
+			goto tr31
+		case 4077:
+			goto tr33
+		case 4080:
+			goto tr34
+		case 4084:
+			goto tr36
+		case 4320:
+			goto tr39
+		case 4333:
+			goto tr41
+		case 4336:
+			goto tr42
+		case 4340:
+			goto tr44
 		}
-	stCase13:
-		switch (m.data)[(m.p)] {
-		case 88:
-			goto st5
-		case 120:
-			goto st5
+		switch {
+		case _widec < 4096:
+			switch {
+			case _widec < 4034:
+				switch {
+				case _widec > 3849:
+					if 3851 <= _widec && _widec <= 3967 {
+						goto tr28
+					}
+				case _widec >= 3840:
+					goto tr28
+				}
+			case _widec > 4063:
+				switch {
+				case _widec > 4079:
+					if 4081 <= _widec && _widec <= 4083 {
+						goto tr35
+					}
+				case _widec >= 4065:
+					goto tr32
+				}
+			default:
+				goto tr30
+			}
+		case _widec > 4104:
+			switch {
+			case _widec < 4290:
+				switch {
+				case _widec > 4127:
+					if 4129 <= _widec && _widec <= 4223 {
+						goto tr37
+					}
+				case _widec >= 4107:
+					goto tr37
+				}
+			case _widec > 4319:
+				switch {
+				case _widec > 4335:
+					if 4337 <= _widec && _widec <= 4339 {
+						goto tr43
+					}
+				case _widec >= 4321:
+					goto tr40
+				}
+			default:
+				goto tr38
+			}
+		default:
+			goto tr37
 		}
-		goto tr0
-	st33:
+		goto tr27
+	tr28:
+
+		m.pb = m.p
+
+		goto st651
+	st651:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof33
+			goto _testEof651
 		}
-	stCase33:
-		if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
-			goto tr42
+	stCase651:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 225:
+			switch {
+			case (m.data)[(m.p)] < 11:
+				if (m.data)[(m.p)] <= 9 {
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 127:
+				switch {
+				case (m.data)[(m.p)] > 223:
+					if 224 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 224 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 194:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 236:
+			switch {
+			case (m.data)[(m.p)] < 240:
+				switch {
+				case (m.data)[(m.p)] > 237:
+					if 238 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 239 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 237:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 240:
+				switch {
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 241:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-		goto st0
-	tr42:
+		switch _widec {
+		case 10:
+			goto tr926
+		case 4064:
+			goto st15
+		case 4077:
+			goto st17
+		case 4080:
+			goto st18
+		case 4084:
+			goto st20
+		}
+		switch {
+		case _widec < 4034:
+			switch {
+			case _widec > 3849:
+				if 3851 <= _widec && _widec <= 3967 {
+					goto st651
+				}
+			case _widec >= 3840:
+				goto st651
+			}
+		case _widec > 4063:
+			switch {
+			case _widec > 4079:
+				if 4081 <= _widec && _widec <= 4083 {
+					goto st19
+				}
+			case _widec >= 4065:
+				goto st16
+			}
+		default:
+			goto st14
+		}
+		goto tr27
+	tr926:
 
-		m.pb = m.p
+		output.descr = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message description", "description", output.descr)
+		}
+		if m.listener != nil {
+			m.listener.OnDescription(output.descr, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
 
-		goto st130
-	st130:
+		if m.err = m.checkHeader(); m.err != nil {
+			if m.recovery {
+				// Record the error and continue, the header is well-formed anyway
+				m.recover()
+			} else {
+				{
+					(m.p)++
+					m.cs = 13
+					goto _out
+				}
+			}
+		}
+
+		goto st13
+	st13:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof130
+			goto _testEof13
 		}
-	stCase130:
+	stCase13:
 		if (m.data)[(m.p)] == 10 {
-			goto tr148
-		}
-		if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
-			goto st130
+			goto tr46
 		}
-		goto st0
-	tr148:
-
-		output.footers[m.currentFooterKey] = append(output.footers[m.currentFooterKey], string(m.text()))
-		m.emitInfo("valid commit message footer trailer", m.currentFooterKey, string(m.text()))
-
-		// Increment number of newlines to use in case we're still in the body
-		m.countNewlines++
-		m.lastNewline = m.p
-		m.emitDebug("found a newline", "pos", m.p)
+		goto tr45
+	tr46:
 
-		m.emitDebug("try to parse a footer trailer token", "pos", m.p)
-		{
-			goto st127
+		if m.logger != nil {
+			m.logger.Debug("found a blank line", "pos", m.p)
 		}
 
-		goto st131
-	tr150:
-
-		// Increment number of newlines to use in case we're still in the body
-		m.countNewlines++
-		m.lastNewline = m.p
-		m.emitDebug("found a newline", "pos", m.p)
-
-		m.emitDebug("try to parse a footer trailer token", "pos", m.p)
+		// Mark the next character so that rewinds can not go back before it
+		// Eg., when it is not a possible trailer token start
+		m.pb = m.p + 1
+		if m.bodyStart < 0 {
+			// The first time is right after the header
+			m.bodyStart = m.pb
+		}
+		if m.gitTrailers {
+			// The footer is the trailer block git finds, already cut off from the input
+			{
+				goto st660
+			}
+		}
+		if m.logger != nil {
+			m.logger.Debug("try to parse a footer trailer token", "pos", m.p)
+		}
 		{
-			goto st127
+			goto st654
 		}
 
-		goto st131
-	st131:
+		goto st652
+	st652:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof131
-		}
-	stCase131:
-		if (m.data)[(m.p)] == 10 {
-			goto tr150
+			goto _testEof652
 		}
+	stCase652:
 		goto st0
-	st34:
+	tr30:
+
+		m.pb = m.p
+
+		goto st14
+	st14:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof34
+			goto _testEof14
 		}
-	stCase34:
+	stCase14:
 		_widec = int16((m.data)[(m.p)])
-		_widec = 256 + (int16((m.data)[(m.p)]) - 0)
-		if m.p+2 < m.pe && m.data[m.p+1] == 10 && m.data[m.p+2] == 10 {
-			_widec += 256
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-		if 256 <= _widec && _widec <= 511 {
-			goto tr45
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st651
 		}
-		goto tr44
-	tr45:
+		goto tr27
+	tr31:
 
 		m.pb = m.p
 
-		goto st132
-	tr152:
-
-		// Append newlines
-		for m.countNewlines > 0 {
-			output.body += "\n"
-			m.countNewlines--
-			m.emitInfo("valid commit message body content", "body", "\n")
+		goto st15
+	st15:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof15
 		}
-		// Append body content
-		output.body += string(m.text())
-		m.emitInfo("valid commit message body content", "body", string(m.text()))
+	stCase15:
+		_widec = int16((m.data)[(m.p)])
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4000 <= _widec && _widec <= 4031 {
+			goto st14
+		}
+		goto tr27
+	tr32:
 
 		m.pb = m.p
 
-		goto st132
-	st132:
+		goto st16
+	st16:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof132
+			goto _testEof16
 		}
-	stCase132:
+	stCase16:
 		_widec = int16((m.data)[(m.p)])
-		_widec = 256 + (int16((m.data)[(m.p)]) - 0)
-		if m.p+2 < m.pe && m.data[m.p+1] == 10 && m.data[m.p+2] == 10 {
-			_widec += 256
-		}
-		if 256 <= _widec && _widec <= 511 {
-			goto tr152
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-		goto tr151
-	stCase35:
-		switch (m.data)[(m.p)] {
-		case 66:
-			goto tr46
-		case 67:
-			goto tr47
-		case 68:
-			goto tr48
-		case 70:
-			goto tr49
-		case 80:
-			goto tr50
-		case 82:
-			goto tr51
-		case 83:
-			goto tr52
-		case 84:
-			goto tr53
-		case 98:
-			goto tr46
-		case 99:
-			goto tr47
-		case 100:
-			goto tr48
-		case 102:
-			goto tr49
-		case 112:
-			goto tr50
-		case 114:
-			goto tr51
-		case 115:
-			goto tr52
-		case 116:
-			goto tr53
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st14
 		}
-		goto tr0
-	tr46:
+		goto tr27
+	tr33:
 
 		m.pb = m.p
 
-		goto st36
-	st36:
+		goto st17
+	st17:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof36
-		}
-	stCase36:
-		switch (m.data)[(m.p)] {
-		case 85:
-			goto st37
-		case 117:
-			goto st37
+			goto _testEof17
 		}
-		goto tr0
-	st37:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof37
+	stCase17:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-	stCase37:
-		switch (m.data)[(m.p)] {
-		case 73:
-			goto st38
-		case 105:
-			goto st38
+		if 3968 <= _widec && _widec <= 3999 {
+			goto st14
 		}
-		goto tr0
-	st38:
+		goto tr27
+	tr34:
+
+		m.pb = m.p
+
+		goto st18
+	st18:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof38
-		}
-	stCase38:
-		switch (m.data)[(m.p)] {
-		case 76:
-			goto st39
-		case 108:
-			goto st39
+			goto _testEof18
 		}
-		goto tr0
-	st39:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof39
+	stCase18:
+		_widec = int16((m.data)[(m.p)])
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-	stCase39:
-		switch (m.data)[(m.p)] {
-		case 68:
-			goto st40
-		case 100:
-			goto st40
+		if 3984 <= _widec && _widec <= 4031 {
+			goto st16
 		}
-		goto tr0
-	st40:
+		goto tr27
+	tr35:
 
-		if (m.p + 1) == m.pe {
-			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
-		}
+		m.pb = m.p
 
+		goto st19
+	st19:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof40
+			goto _testEof19
 		}
-	stCase40:
-
-		output._type = string(m.text())
-		m.emitInfo("valid commit message type", "type", output._type)
-
-		switch (m.data)[(m.p)] {
-		case 33:
-			goto tr58
-		case 40:
-			goto st45
-		case 58:
-			goto st42
+	stCase19:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-		goto tr6
-	tr58:
-
-		output.exclamation = true
-		m.emitInfo("commit message communicates a breaking change")
-
-		goto st41
-	st41:
-
-		if (m.p + 1) == m.pe {
-			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st16
 		}
+		goto tr27
+	tr36:
+
+		m.pb = m.p
 
+		goto st20
+	st20:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof41
+			goto _testEof20
 		}
-	stCase41:
-		if (m.data)[(m.p)] == 58 {
-			goto st42
+	stCase20:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-		goto tr6
-	st42:
-
-		if (m.p + 1) == m.pe {
-			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		if 3968 <= _widec && _widec <= 3983 {
+			goto st16
 		}
-
+		goto tr27
+	st21:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof42
+			goto _testEof21
 		}
-	stCase42:
-		if (m.data)[(m.p)] == 32 {
-			goto st43
+	stCase21:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 9:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-		goto tr10
-	st43:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof43
+		switch _widec {
+		case 3872:
+			goto st21
+		case 4064:
+			goto tr31
+		case 4077:
+			goto tr33
+		case 4080:
+			goto tr34
+		case 4084:
+			goto tr36
 		}
-	stCase43:
-		switch (m.data)[(m.p)] {
-		case 10:
-			goto tr13
-		case 32:
-			goto st43
+		switch {
+		case _widec < 4034:
+			switch {
+			case _widec > 3849:
+				if 3851 <= _widec && _widec <= 3967 {
+					goto tr28
+				}
+			case _widec >= 3840:
+				goto tr28
+			}
+		case _widec > 4063:
+			switch {
+			case _widec > 4079:
+				if 4081 <= _widec && _widec <= 4083 {
+					goto tr35
+				}
+			case _widec >= 4065:
+				goto tr32
+			}
+		default:
+			goto tr30
 		}
-		goto tr62
-	tr62:
+		goto tr27
+	tr37:
 
 		m.pb = m.p
 
-		goto st133
-	st133:
+		goto st653
+	st653:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof133
-		}
-	stCase133:
-		if (m.data)[(m.p)] == 10 {
-			goto tr154
+			goto _testEof653
 		}
-		goto st133
-	tr154:
-
-		output.descr = string(m.text())
-		m.emitInfo("valid commit message description", "description", output.descr)
-
-		goto st44
-	st44:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof44
+	stCase653:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-	stCase44:
-		if (m.data)[(m.p)] == 10 {
-			goto tr63
+		switch _widec {
+		case 10:
+			goto tr926
+		case 4128:
+			goto st22
+		case 4320:
+			goto st24
+		case 4333:
+			goto st26
+		case 4336:
+			goto st27
+		case 4340:
+			goto st29
 		}
-		goto tr14
-	tr63:
-
-		m.emitDebug("found a blank line", "pos", m.p)
-
-		m.emitDebug("try to parse a footer trailer token", "pos", m.p)
-		{
-			goto st127
+		switch {
+		case _widec < 4290:
+			switch {
+			case _widec > 4104:
+				if 4107 <= _widec && _widec <= 4223 {
+					goto st653
+				}
+			case _widec >= 4096:
+				goto st653
+			}
+		case _widec > 4319:
+			switch {
+			case _widec > 4335:
+				if 4337 <= _widec && _widec <= 4339 {
+					goto st28
+				}
+			case _widec >= 4321:
+				goto st25
+			}
+		default:
+			goto st23
 		}
-
-		goto st134
-	st134:
+		goto tr50
+	st22:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof134
+			goto _testEof22
 		}
-	stCase134:
-		goto st0
-	st45:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof45
+	stCase22:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-	stCase45:
-		if (m.data)[(m.p)] == 41 {
-			goto tr65
+		switch _widec {
+		case 4128:
+			goto st22
+		case 4320:
+			goto st24
+		case 4333:
+			goto st26
+		case 4336:
+			goto st27
+		case 4340:
+			goto st29
 		}
 		switch {
-		case (m.data)[(m.p)] > 39:
-			if 42 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
-				goto tr64
+		case _widec < 4290:
+			switch {
+			case _widec > 4104:
+				if 4107 <= _widec && _widec <= 4223 {
+					goto st653
+				}
+			case _widec >= 4096:
+				goto st653
 			}
-		case (m.data)[(m.p)] >= 32:
-			goto tr64
+		case _widec > 4319:
+			switch {
+			case _widec > 4335:
+				if 4337 <= _widec && _widec <= 4339 {
+					goto st28
+				}
+			case _widec >= 4321:
+				goto st25
+			}
+		default:
+			goto st23
 		}
-		goto tr16
-	tr64:
+		goto tr50
+	tr38:
 
 		m.pb = m.p
 
-		goto st46
-	st46:
+		goto st23
+	st23:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof46
-		}
-	stCase46:
-		if (m.data)[(m.p)] == 41 {
-			goto tr67
+			goto _testEof23
 		}
-		switch {
-		case (m.data)[(m.p)] > 39:
-			if 42 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
-				goto st46
+	stCase23:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
 			}
-		case (m.data)[(m.p)] >= 32:
-			goto st46
 		}
-		goto tr16
-	tr65:
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st653
+		}
+		goto tr50
+	tr39:
 
 		m.pb = m.p
 
-		output.scope = string(m.text())
-		m.emitInfo("valid commit message scope", "scope", output.scope)
-
-		goto st47
-	tr67:
-
-		output.scope = string(m.text())
-		m.emitInfo("valid commit message scope", "scope", output.scope)
-
-		goto st47
-	st47:
-
-		if (m.p + 1) == m.pe {
-			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
-		}
-
+		goto st24
+	st24:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof47
+			goto _testEof24
 		}
-	stCase47:
-		switch (m.data)[(m.p)] {
-		case 33:
-			goto tr58
-		case 58:
-			goto st42
+	stCase24:
+		_widec = int16((m.data)[(m.p)])
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4256 <= _widec && _widec <= 4287 {
+			goto st23
 		}
-		goto tr6
-	tr47:
+		goto tr50
+	tr40:
 
 		m.pb = m.p
 
-		goto st48
-	st48:
+		goto st25
+	st25:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof48
-		}
-	stCase48:
-		switch (m.data)[(m.p)] {
-		case 72:
-			goto st49
-		case 73:
-			goto st40
-		case 104:
-			goto st49
-		case 105:
-			goto st40
+			goto _testEof25
 		}
-		goto tr0
-	st49:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof49
+	stCase25:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-	stCase49:
-		switch (m.data)[(m.p)] {
-		case 79:
-			goto st50
-		case 111:
-			goto st50
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st23
 		}
-		goto tr0
-	st50:
+		goto tr50
+	tr41:
+
+		m.pb = m.p
+
+		goto st26
+	st26:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof50
-		}
-	stCase50:
-		switch (m.data)[(m.p)] {
-		case 82:
-			goto st51
-		case 114:
-			goto st51
+			goto _testEof26
 		}
-		goto tr0
-	st51:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof51
+	stCase26:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-	stCase51:
-		switch (m.data)[(m.p)] {
-		case 69:
-			goto st40
-		case 101:
-			goto st40
+		if 4224 <= _widec && _widec <= 4255 {
+			goto st23
 		}
-		goto tr0
-	tr48:
+		goto tr50
+	tr42:
 
 		m.pb = m.p
 
-		goto st52
-	st52:
+		goto st27
+	st27:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof52
+			goto _testEof27
 		}
-	stCase52:
-		switch (m.data)[(m.p)] {
-		case 79:
-			goto st53
-		case 111:
-			goto st53
+	stCase27:
+		_widec = int16((m.data)[(m.p)])
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-		goto tr0
-	st53:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof53
+		if 4240 <= _widec && _widec <= 4287 {
+			goto st25
 		}
-	stCase53:
-		switch (m.data)[(m.p)] {
-		case 67:
-			goto st54
-		case 99:
-			goto st54
-		}
-		goto tr0
-	st54:
+		goto tr50
+	tr43:
+
+		m.pb = m.p
+
+		goto st28
+	st28:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof54
+			goto _testEof28
 		}
-	stCase54:
-		switch (m.data)[(m.p)] {
-		case 83:
-			goto st40
-		case 115:
-			goto st40
+	stCase28:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-		goto tr0
-	tr49:
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st25
+		}
+		goto tr50
+	tr44:
 
 		m.pb = m.p
 
-		goto st55
-	st55:
+		goto st29
+	st29:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof55
-		}
-	stCase55:
-		switch (m.data)[(m.p)] {
-		case 69:
-			goto st56
-		case 73:
-			goto st58
-		case 101:
-			goto st56
-		case 105:
-			goto st58
+			goto _testEof29
 		}
-		goto tr0
-	st56:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof56
+	stCase29:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-	stCase56:
-		switch (m.data)[(m.p)] {
-		case 65:
-			goto st57
-		case 97:
-			goto st57
+		if 4224 <= _widec && _widec <= 4239 {
+			goto st25
 		}
-		goto tr0
-	st57:
+		goto tr50
+	st30:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof57
+			goto _testEof30
 		}
-	stCase57:
+	stCase30:
 		switch (m.data)[(m.p)] {
-		case 84:
-			goto st40
-		case 116:
-			goto st40
+		case 41:
+			goto tr62
+		case 224:
+			goto tr64
+		case 237:
+			goto tr66
+		case 240:
+			goto tr67
+		case 244:
+			goto tr69
 		}
-		goto tr0
-	st58:
+		switch {
+		case (m.data)[(m.p)] < 194:
+			switch {
+			case (m.data)[(m.p)] > 39:
+				if 42 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+					goto tr61
+				}
+			case (m.data)[(m.p)] >= 32:
+				goto tr61
+			}
+		case (m.data)[(m.p)] > 223:
+			switch {
+			case (m.data)[(m.p)] > 239:
+				if 241 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 243 {
+					goto tr68
+				}
+			case (m.data)[(m.p)] >= 225:
+				goto tr65
+			}
+		default:
+			goto tr63
+		}
+		goto tr60
+	tr61:
+
+		m.pb = m.p
+
+		goto st31
+	st31:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof58
+			goto _testEof31
 		}
-	stCase58:
+	stCase31:
 		switch (m.data)[(m.p)] {
-		case 88:
-			goto st40
-		case 120:
-			goto st40
+		case 41:
+			goto tr71
+		case 224:
+			goto st34
+		case 237:
+			goto st36
+		case 240:
+			goto st37
+		case 244:
+			goto st39
 		}
-		goto tr0
-	tr50:
+		switch {
+		case (m.data)[(m.p)] < 194:
+			switch {
+			case (m.data)[(m.p)] > 39:
+				if 42 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+					goto st31
+				}
+			case (m.data)[(m.p)] >= 32:
+				goto st31
+			}
+		case (m.data)[(m.p)] > 223:
+			switch {
+			case (m.data)[(m.p)] > 239:
+				if 241 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 243 {
+					goto st38
+				}
+			case (m.data)[(m.p)] >= 225:
+				goto st35
+			}
+		default:
+			goto st33
+		}
+		goto tr60
+	tr62:
 
 		m.pb = m.p
 
-		goto st59
-	st59:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof59
+		output.scope = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message scope", "scope", output.scope)
 		}
-	stCase59:
-		switch (m.data)[(m.p)] {
-		case 69:
-			goto st60
-		case 101:
-			goto st60
+		if m.listener != nil {
+			m.listener.OnScope(output.scope, conventionalcommits.Span{Start: m.pb, End: m.p})
 		}
-		goto tr0
-	st60:
+
+		goto st32
+	tr71:
+
+		output.scope = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message scope", "scope", output.scope)
+		}
+		if m.listener != nil {
+			m.listener.OnScope(output.scope, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		goto st32
+	st32:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof60
+			goto _testEof32
 		}
-	stCase60:
+	stCase32:
 		switch (m.data)[(m.p)] {
-		case 82:
-			goto st61
-		case 114:
-			goto st61
+		case 33:
+			goto tr22
+		case 58:
+			goto st11
 		}
-		goto tr0
-	st61:
+		goto tr21
+	tr63:
+
+		m.pb = m.p
+
+		goto st33
+	st33:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof61
+			goto _testEof33
 		}
-	stCase61:
-		switch (m.data)[(m.p)] {
-		case 70:
-			goto st40
-		case 102:
-			goto st40
+	stCase33:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st31
 		}
-		goto tr0
-	tr51:
+		goto tr60
+	tr64:
 
 		m.pb = m.p
 
-		goto st62
-	st62:
+		goto st34
+	st34:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof62
+			goto _testEof34
 		}
-	stCase62:
-		switch (m.data)[(m.p)] {
-		case 69:
-			goto st63
-		case 101:
-			goto st63
+	stCase34:
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st33
 		}
-		goto tr0
-	st63:
+		goto tr60
+	tr65:
+
+		m.pb = m.p
+
+		goto st35
+	st35:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof63
+			goto _testEof35
 		}
-	stCase63:
-		switch (m.data)[(m.p)] {
-		case 70:
-			goto st64
-		case 86:
-			goto st69
-		case 102:
-			goto st64
-		case 118:
-			goto st69
+	stCase35:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st33
 		}
-		goto tr0
-	st64:
+		goto tr60
+	tr66:
+
+		m.pb = m.p
+
+		goto st36
+	st36:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof64
+			goto _testEof36
 		}
-	stCase64:
-		switch (m.data)[(m.p)] {
-		case 65:
-			goto st65
-		case 97:
-			goto st65
+	stCase36:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			goto st33
 		}
-		goto tr0
-	st65:
+		goto tr60
+	tr67:
+
+		m.pb = m.p
+
+		goto st37
+	st37:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof65
+			goto _testEof37
 		}
-	stCase65:
-		switch (m.data)[(m.p)] {
-		case 67:
-			goto st66
-		case 99:
-			goto st66
+	stCase37:
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st35
 		}
-		goto tr0
-	st66:
+		goto tr60
+	tr68:
+
+		m.pb = m.p
+
+		goto st38
+	st38:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof66
+			goto _testEof38
 		}
-	stCase66:
-		switch (m.data)[(m.p)] {
-		case 84:
-			goto st67
-		case 116:
-			goto st67
+	stCase38:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st35
 		}
-		goto tr0
-	st67:
+		goto tr60
+	tr69:
+
+		m.pb = m.p
+
+		goto st39
+	st39:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof67
+			goto _testEof39
 		}
-	stCase67:
-		switch (m.data)[(m.p)] {
-		case 79:
-			goto st68
-		case 111:
-			goto st68
+	stCase39:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			goto st35
 		}
-		goto tr0
-	st68:
+		goto tr60
+	st40:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof68
+			goto _testEof40
 		}
-	stCase68:
+	stCase40:
 		switch (m.data)[(m.p)] {
-		case 82:
-			goto st40
-		case 114:
-			goto st40
-		}
-		goto tr0
-	st69:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof69
-		}
-	stCase69:
-		switch (m.data)[(m.p)] {
-		case 69:
-			goto st70
-		case 101:
-			goto st70
+		case 88:
+			goto st9
+		case 120:
+			goto st9
 		}
 		goto tr0
-	st70:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof70
-		}
-	stCase70:
-		switch (m.data)[(m.p)] {
-		case 82:
-			goto st57
-		case 114:
-			goto st57
+	tr5:
+
+		m.pb = m.p
+
+		goto st41
+	tr13:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
 		}
-		goto tr0
-	tr52:
 
 		m.pb = m.p
 
-		goto st71
-	st71:
+		goto st41
+	st41:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof71
+			goto _testEof41
 		}
-	stCase71:
-		switch (m.data)[(m.p)] {
-		case 84:
-			goto st72
-		case 116:
-			goto st72
+	stCase41:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 85:
+			if 117 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 117 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 85:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3669:
+			goto st42
+		case 3701:
+			goto st42
 		}
 		goto tr0
-	st72:
+	st42:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof72
+			goto _testEof42
 		}
-	stCase72:
-		switch (m.data)[(m.p)] {
-		case 89:
-			goto st73
-		case 121:
-			goto st73
+	stCase42:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 71:
+			if 103 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 103 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 71:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3655:
+			goto st43
+		case 3687:
+			goto st43
 		}
 		goto tr0
-	st73:
+	st43:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof73
+			goto _testEof43
 		}
-	stCase73:
-		switch (m.data)[(m.p)] {
-		case 76:
-			goto st51
-		case 108:
-			goto st51
+	stCase43:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 70:
+			if 102 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 102 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 70:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3654:
+			goto st44
+		case 3686:
+			goto st44
 		}
 		goto tr0
-	tr53:
-
-		m.pb = m.p
-
-		goto st74
-	st74:
+	st44:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof74
+			goto _testEof44
 		}
-	stCase74:
-		switch (m.data)[(m.p)] {
-		case 69:
-			goto st75
-		case 101:
-			goto st75
+	stCase44:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 73:
+			if 105 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 105 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 73:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3657:
+			goto st45
+		case 3689:
+			goto st45
 		}
 		goto tr0
-	st75:
+	st45:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof75
+			goto _testEof45
 		}
-	stCase75:
-		switch (m.data)[(m.p)] {
-		case 83:
-			goto st57
-		case 115:
-			goto st57
+	stCase45:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 88:
+			if 120 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 120 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 88:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
 		}
-		goto tr0
-	stCase76:
-		switch (m.data)[(m.p)] {
-		case 66:
-			goto tr89
-		case 67:
-			goto tr90
-		case 68:
-			goto tr91
-		case 70:
-			goto tr92
-		case 78:
-			goto tr93
-		case 80:
-			goto tr94
-		case 82:
-			goto tr95
-		case 84:
-			goto tr96
-		case 85:
-			goto tr97
-		case 98:
-			goto tr89
-		case 99:
-			goto tr90
-		case 100:
-			goto tr91
-		case 102:
-			goto tr92
-		case 110:
-			goto tr93
-		case 112:
-			goto tr94
-		case 114:
-			goto tr95
-		case 116:
-			goto tr96
-		case 117:
-			goto tr97
+		switch _widec {
+		case 3672:
+			goto st9
+		case 3704:
+			goto st9
 		}
 		goto tr0
-	tr89:
+	tr6:
 
 		m.pb = m.p
 
-		goto st77
-	st77:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof77
-		}
-	stCase77:
-		switch (m.data)[(m.p)] {
-		case 85:
-			goto st78
-		case 117:
-			goto st78
+		goto st46
+	tr14:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
 		}
-		goto tr0
-	st78:
+
+		m.pb = m.p
+
+		goto st46
+	st46:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof78
+			goto _testEof46
 		}
-	stCase78:
-		switch (m.data)[(m.p)] {
+	stCase46:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 69:
+			if 101 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 101 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 69:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
 		case 73:
-			goto st79
+			goto st40
 		case 105:
-			goto st79
+			goto st40
+		case 3397:
+			goto st7
+		case 3429:
+			goto st7
+		case 3653:
+			goto st47
+		case 3685:
+			goto st47
 		}
 		goto tr0
-	st79:
+	st47:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof79
+			goto _testEof47
 		}
-	stCase79:
-		switch (m.data)[(m.p)] {
-		case 76:
-			goto st80
-		case 108:
-			goto st80
+	stCase47:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 65:
+			if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 97 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 65:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3393:
+			goto st8
+		case 3425:
+			goto st8
+		case 3649:
+			goto st48
+		case 3681:
+			goto st48
 		}
 		goto tr0
-	st80:
+	st48:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof80
+			goto _testEof48
 		}
-	stCase80:
-		switch (m.data)[(m.p)] {
-		case 68:
-			goto st81
-		case 100:
-			goto st81
+	stCase48:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 84:
+			if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 84:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3412:
+			goto st9
+		case 3444:
+			goto st9
+		case 3668:
+			goto st49
+		case 3700:
+			goto st49
 		}
 		goto tr0
-	st81:
+	st49:
 
 		if (m.p + 1) == m.pe {
 			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
 		}
 
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof81
+			goto _testEof49
 		}
-	stCase81:
+	stCase49:
 
-		output._type = string(m.text())
-		m.emitInfo("valid commit message type", "type", output._type)
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
 
-		switch (m.data)[(m.p)] {
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 85:
+			if 117 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 117 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 85:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
 		case 33:
-			goto tr102
+			goto tr22
 		case 40:
-			goto st86
+			goto st30
 		case 58:
-			goto st83
-		}
-		goto tr6
-	tr102:
-
-		output.exclamation = true
-		m.emitInfo("commit message communicates a breaking change")
-
-		goto st82
-	st82:
-
-		if (m.p + 1) == m.pe {
-			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+			goto st11
+		case 3669:
+			goto st50
+		case 3701:
+			goto st50
 		}
-
+		goto tr21
+	st50:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof82
+			goto _testEof50
 		}
-	stCase82:
-		if (m.data)[(m.p)] == 58 {
-			goto st83
+	stCase50:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 82:
+			if 114 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 114 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 82:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
 		}
-		goto tr6
-	st83:
-
-		if (m.p + 1) == m.pe {
-			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		switch _widec {
+		case 3666:
+			goto st51
+		case 3698:
+			goto st51
 		}
-
+		goto tr0
+	st51:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof83
-		}
-	stCase83:
-		if (m.data)[(m.p)] == 32 {
-			goto st84
+			goto _testEof51
 		}
-		goto tr10
-	st84:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof84
+	stCase51:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 69:
+			if 101 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 101 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 69:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
 		}
-	stCase84:
-		switch (m.data)[(m.p)] {
-		case 10:
-			goto tr13
-		case 32:
-			goto st84
+		switch _widec {
+		case 3653:
+			goto st9
+		case 3685:
+			goto st9
 		}
-		goto tr106
-	tr106:
+		goto tr0
+	tr7:
 
 		m.pb = m.p
 
-		goto st135
-	st135:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof135
-		}
-	stCase135:
-		if (m.data)[(m.p)] == 10 {
-			goto tr156
+		goto st52
+	tr15:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
 		}
-		goto st135
-	tr156:
 
-		output.descr = string(m.text())
-		m.emitInfo("valid commit message description", "description", output.descr)
+		m.pb = m.p
 
-		goto st85
-	st85:
+		goto st52
+	st52:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof85
-		}
-	stCase85:
-		if (m.data)[(m.p)] == 10 {
-			goto tr107
+			goto _testEof52
 		}
-		goto tr14
-	tr107:
-
-		m.emitDebug("found a blank line", "pos", m.p)
-
-		m.emitDebug("try to parse a footer trailer token", "pos", m.p)
-		{
-			goto st127
+	stCase52:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 79:
+			if 111 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 111 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 79:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
 		}
-
-		goto st136
-	st136:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof136
+		switch _widec {
+		case 3663:
+			goto st53
+		case 3695:
+			goto st53
 		}
-	stCase136:
-		goto st0
-	st86:
+		goto tr0
+	st53:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof86
-		}
-	stCase86:
-		if (m.data)[(m.p)] == 41 {
-			goto tr109
+			goto _testEof53
 		}
+	stCase53:
+		_widec = int16((m.data)[(m.p)])
 		switch {
-		case (m.data)[(m.p)] > 39:
-			if 42 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
-				goto tr108
+		case (m.data)[(m.p)] > 84:
+			if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 84:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
 			}
-		case (m.data)[(m.p)] >= 32:
-			goto tr108
 		}
-		goto tr16
-	tr108:
+		switch _widec {
+		case 3668:
+			goto st43
+		case 3700:
+			goto st43
+		}
+		goto tr0
+	tr2:
 
 		m.pb = m.p
 
-		goto st87
-	st87:
+		goto st54
+	st54:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof87
-		}
-	stCase87:
-		if (m.data)[(m.p)] == 41 {
-			goto tr111
+			goto _testEof54
 		}
+	stCase54:
+		_widec = int16((m.data)[(m.p)])
 		switch {
-		case (m.data)[(m.p)] > 39:
-			if 42 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
-				goto st87
+		case (m.data)[(m.p)] < 152:
+			if 140 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 158:
+			if 172 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 175 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
 			}
-		case (m.data)[(m.p)] >= 32:
-			goto st87
 		}
-		goto tr16
-	tr109:
-
-		m.pb = m.p
-
-		output.scope = string(m.text())
-		m.emitInfo("valid commit message scope", "scope", output.scope)
-
-		goto st88
-	tr111:
-
-		output.scope = string(m.text())
-		m.emitInfo("valid commit message scope", "scope", output.scope)
-
-		goto st88
-	st88:
-
-		if (m.p + 1) == m.pe {
-			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		switch {
+		case _widec < 3224:
+			if 3212 <= _widec && _widec <= 3215 {
+				goto st55
+			}
+		case _widec > 3230:
+			if 3244 <= _widec && _widec <= 3247 {
+				goto st55
+			}
+		default:
+			goto st55
 		}
-
+		goto tr8
+	st55:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof88
+			goto _testEof55
 		}
-	stCase88:
-		switch (m.data)[(m.p)] {
-		case 33:
-			goto tr102
-		case 58:
-			goto st83
+	stCase55:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
 		}
-		goto tr6
-	tr90:
-
-		m.pb = m.p
-
-		goto st89
-	st89:
+		if 3200 <= _widec && _widec <= 3263 {
+			goto st56
+		}
+		goto tr8
+	st56:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof89
+			goto _testEof56
 		}
-	stCase89:
-		switch (m.data)[(m.p)] {
-		case 72:
-			goto st90
-		case 73:
-			goto st81
-		case 104:
-			goto st90
-		case 105:
-			goto st81
+	stCase56:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 98:
+			switch {
+			case (m.data)[(m.p)] < 66:
+				if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 66:
+				switch {
+				case (m.data)[(m.p)] > 70:
+					if 72 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 72 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 70:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 98:
+			switch {
+			case (m.data)[(m.p)] < 104:
+				if 102 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 102 {
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 104:
+				switch {
+				case (m.data)[(m.p)] > 226:
+					if 239 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 239 {
+						_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+						if m.gitmoji {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 226:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3104:
+			goto tr11
+		case 3298:
+			goto st57
+		case 3311:
+			goto st62
+		case 3398:
+			goto tr12
+		case 3430:
+			goto tr12
+		case 3650:
+			goto tr13
+		case 3654:
+			goto tr14
+		case 3656:
+			goto tr15
+		case 3682:
+			goto tr13
+		case 3686:
+			goto tr14
+		case 3688:
+			goto tr15
 		}
 		goto tr0
-	st90:
+	st57:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof90
+			goto _testEof57
 		}
-	stCase90:
-		switch (m.data)[(m.p)] {
-		case 79:
-			goto st91
-		case 111:
-			goto st91
+	stCase57:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 128 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
 		}
-		goto tr0
-	st91:
+		if _widec == 3200 {
+			goto st58
+		}
+		goto tr8
+	st58:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof91
+			goto _testEof58
 		}
-	stCase91:
-		switch (m.data)[(m.p)] {
-		case 82:
-			goto st92
-		case 114:
-			goto st92
+	stCase58:
+		_widec = int16((m.data)[(m.p)])
+		if 141 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 141 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
 		}
-		goto tr0
-	st92:
+		if _widec == 3213 {
+			goto st59
+		}
+		goto tr8
+	st59:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof92
+			goto _testEof59
 		}
-	stCase92:
-		switch (m.data)[(m.p)] {
-		case 69:
-			goto st81
-		case 101:
-			goto st81
+	stCase59:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 226:
+			if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 226:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
 		}
-		goto tr0
-	tr91:
+		switch _widec {
+		case 3298:
+			goto st54
+		case 3312:
+			goto st60
+		}
+		goto tr8
+	tr3:
 
 		m.pb = m.p
 
-		goto st93
-	st93:
+		goto st60
+	st60:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof93
+			goto _testEof60
 		}
-	stCase93:
-		switch (m.data)[(m.p)] {
-		case 79:
-			goto st94
-		case 111:
-			goto st94
+	stCase60:
+		_widec = int16((m.data)[(m.p)])
+		if 159 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
 		}
-		goto tr0
-	st94:
+		if _widec == 3231 {
+			goto st61
+		}
+		goto tr8
+	st61:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof94
+			goto _testEof61
 		}
-	stCase94:
-		switch (m.data)[(m.p)] {
-		case 67:
-			goto st95
-		case 99:
-			goto st95
-		}
-		goto tr0
-	st95:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof95
+	stCase61:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 171 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
 		}
-	stCase95:
-		switch (m.data)[(m.p)] {
-		case 83:
-			goto st81
-		case 115:
-			goto st81
+		if 3200 <= _widec && _widec <= 3243 {
+			goto st55
 		}
-		goto tr0
-	tr92:
-
-		m.pb = m.p
-
-		goto st96
-	st96:
+		goto tr8
+	st62:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof96
+			goto _testEof62
 		}
-	stCase96:
-		switch (m.data)[(m.p)] {
-		case 69:
-			goto st97
-		case 73:
-			goto st99
-		case 101:
-			goto st97
-		case 105:
-			goto st99
+	stCase62:
+		_widec = int16((m.data)[(m.p)])
+		if 184 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 184 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
 		}
-		goto tr0
-	st97:
+		if _widec == 3256 {
+			goto st63
+		}
+		goto tr8
+	st63:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof97
+			goto _testEof63
 		}
-	stCase97:
-		switch (m.data)[(m.p)] {
-		case 65:
-			goto st98
-		case 97:
-			goto st98
+	stCase63:
+		_widec = int16((m.data)[(m.p)])
+		if 143 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
 		}
-		goto tr0
-	st98:
+		if _widec == 3215 {
+			goto st64
+		}
+		goto tr8
+	st64:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof98
+			goto _testEof64
 		}
-	stCase98:
-		switch (m.data)[(m.p)] {
-		case 84:
-			goto st81
-		case 116:
-			goto st81
+	stCase64:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 72:
+			switch {
+			case (m.data)[(m.p)] < 66:
+				if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 66:
+				if 70 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 70 {
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 72:
+			switch {
+			case (m.data)[(m.p)] < 102:
+				if 98 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 98 {
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 102:
+				switch {
+				case (m.data)[(m.p)] > 104:
+					if 226 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 226 {
+						_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+						if m.gitmoji {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 104:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3104:
+			goto tr11
+		case 3298:
+			goto st57
+		case 3398:
+			goto tr12
+		case 3430:
+			goto tr12
+		case 3650:
+			goto tr13
+		case 3654:
+			goto tr14
+		case 3656:
+			goto tr15
+		case 3682:
+			goto tr13
+		case 3686:
+			goto tr14
+		case 3688:
+			goto tr15
 		}
 		goto tr0
-	st99:
+	st84:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof99
+			goto _testEof84
 		}
-	stCase99:
+	stCase84:
 		switch (m.data)[(m.p)] {
-		case 88:
-			goto st81
-		case 120:
-			goto st81
+		case 224:
+			goto tr124
+		case 237:
+			goto tr126
+		case 240:
+			goto tr127
+		case 244:
+			goto tr129
 		}
-		goto tr0
-	tr93:
+		switch {
+		case (m.data)[(m.p)] < 194:
+			if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+				goto tr122
+			}
+		case (m.data)[(m.p)] > 223:
+			switch {
+			case (m.data)[(m.p)] > 239:
+				if 241 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 243 {
+					goto tr128
+				}
+			case (m.data)[(m.p)] >= 225:
+				goto tr125
+			}
+		default:
+			goto tr123
+		}
+		goto tr121
+	tr122:
 
 		m.pb = m.p
 
-		goto st100
-	st100:
+		goto st657
+	st657:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof100
+			goto _testEof657
 		}
-	stCase100:
+	stCase657:
 		switch (m.data)[(m.p)] {
-		case 69:
-			goto st101
-		case 101:
-			goto st101
+		case 10:
+			goto tr936
+		case 224:
+			goto st86
+		case 237:
+			goto st88
+		case 240:
+			goto st89
+		case 244:
+			goto st91
 		}
-		goto tr0
-	st101:
+		switch {
+		case (m.data)[(m.p)] < 194:
+			if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+				goto st657
+			}
+		case (m.data)[(m.p)] > 223:
+			switch {
+			case (m.data)[(m.p)] > 239:
+				if 241 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 243 {
+					goto st90
+				}
+			case (m.data)[(m.p)] >= 225:
+				goto st87
+			}
+		default:
+			goto st85
+		}
+		goto tr121
+	tr936:
+
+		if m.err = m.checkFooter(output.footers); m.err != nil {
+			if m.recovery {
+				// Record the error and continue, skipping the footer trailer
+				m.recover()
+			} else {
+				{
+					(m.p)++
+					m.cs = 658
+					goto _out
+				}
+			}
+		} else {
+			if len(output.footers) == 0 {
+				m.footerStart = m.footerKeySpan.Start
+			}
+			value := m.str(m.pb, m.p)
+			output.footers[m.currentFooterKey] = append(output.footers[m.currentFooterKey], value)
+			if m.logger != nil {
+				m.logger.Info("valid commit message footer trailer", m.currentFooterKey, value)
+			}
+			if m.listener != nil {
+				// The body, if any, is complete
+				m.flushBody()
+				if m.currentFooterKey == "breaking-change" {
+					m.listener.OnBreaking(m.footerKeySpan)
+				}
+				m.listener.OnFooter(m.currentFooterKey, value, m.footerKeySpan, conventionalcommits.Span{Start: m.pb, End: m.p})
+			}
+		}
+
+		// Increment number of newlines to use in case we're still in the body
+		m.countNewlines++
+		m.lastNewline = m.p
+		if m.logger != nil {
+			m.logger.Debug("found a newline", "pos", m.p)
+		}
+
+		// Mark the next character so that rewinds can not go back before it
+		// Eg., when it is not a possible trailer token start
+		m.pb = m.p + 1
+		if m.bodyStart < 0 {
+			// The first time is right after the header
+			m.bodyStart = m.pb
+		}
+		if m.gitTrailers {
+			// The footer is the trailer block git finds, already cut off from the input
+			{
+				goto st660
+			}
+		}
+		if m.logger != nil {
+			m.logger.Debug("try to parse a footer trailer token", "pos", m.p)
+		}
+		{
+			goto st654
+		}
+
+		goto st658
+	tr942:
+
+		// Increment number of newlines to use in case we're still in the body
+		m.countNewlines++
+		m.lastNewline = m.p
+		if m.logger != nil {
+			m.logger.Debug("found a newline", "pos", m.p)
+		}
+
+		// Mark the next character so that rewinds can not go back before it
+		// Eg., when it is not a possible trailer token start
+		m.pb = m.p + 1
+		if m.bodyStart < 0 {
+			// The first time is right after the header
+			m.bodyStart = m.pb
+		}
+		if m.gitTrailers {
+			// The footer is the trailer block git finds, already cut off from the input
+			{
+				goto st660
+			}
+		}
+		if m.logger != nil {
+			m.logger.Debug("try to parse a footer trailer token", "pos", m.p)
+		}
+		{
+			goto st654
+		}
+
+		goto st658
+	st658:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof101
+			goto _testEof658
 		}
-	stCase101:
-		switch (m.data)[(m.p)] {
-		case 87:
-			goto st81
-		case 119:
-			goto st81
+	stCase658:
+		if (m.data)[(m.p)] == 10 {
+			goto tr942
 		}
-		goto tr0
-	tr94:
+		goto st0
+	tr123:
 
 		m.pb = m.p
 
-		goto st102
-	st102:
+		goto st85
+	st85:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof102
+			goto _testEof85
 		}
-	stCase102:
-		switch (m.data)[(m.p)] {
-		case 69:
-			goto st103
-		case 101:
-			goto st103
+	stCase85:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st657
 		}
-		goto tr0
-	st103:
+		goto tr121
+	tr124:
+
+		m.pb = m.p
+
+		goto st86
+	st86:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof103
+			goto _testEof86
 		}
-	stCase103:
-		switch (m.data)[(m.p)] {
-		case 82:
-			goto st104
-		case 114:
-			goto st104
+	stCase86:
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st85
 		}
-		goto tr0
-	st104:
+		goto tr121
+	tr125:
+
+		m.pb = m.p
+
+		goto st87
+	st87:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof104
+			goto _testEof87
 		}
-	stCase104:
-		switch (m.data)[(m.p)] {
-		case 70:
-			goto st81
-		case 102:
-			goto st81
+	stCase87:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st85
 		}
-		goto tr0
-	tr95:
+		goto tr121
+	tr126:
 
 		m.pb = m.p
 
-		goto st105
-	st105:
+		goto st88
+	st88:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof105
+			goto _testEof88
 		}
-	stCase105:
-		switch (m.data)[(m.p)] {
-		case 69:
-			goto st106
-		case 85:
-			goto st109
-		case 101:
-			goto st106
-		case 117:
-			goto st109
+	stCase88:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			goto st85
 		}
-		goto tr0
-	st106:
+		goto tr121
+	tr127:
+
+		m.pb = m.p
+
+		goto st89
+	st89:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof106
+			goto _testEof89
 		}
-	stCase106:
-		switch (m.data)[(m.p)] {
-		case 86:
-			goto st107
-		case 118:
-			goto st107
+	stCase89:
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st87
 		}
-		goto tr0
-	st107:
+		goto tr121
+	tr128:
+
+		m.pb = m.p
+
+		goto st90
+	st90:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof107
+			goto _testEof90
 		}
-	stCase107:
-		switch (m.data)[(m.p)] {
-		case 69:
-			goto st108
-		case 101:
-			goto st108
+	stCase90:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st87
 		}
-		goto tr0
-	st108:
+		goto tr121
+	tr129:
+
+		m.pb = m.p
+
+		goto st91
+	st91:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof108
+			goto _testEof91
 		}
-	stCase108:
-		switch (m.data)[(m.p)] {
-		case 82:
-			goto st98
-		case 114:
-			goto st98
+	stCase91:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			goto st87
 		}
-		goto tr0
-	st109:
+		goto tr121
+	st92:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof109
+			goto _testEof92
 		}
-	stCase109:
-		switch (m.data)[(m.p)] {
-		case 76:
-			goto st92
-		case 108:
-			goto st92
+	stCase92:
+		_widec = int16((m.data)[(m.p)])
+		_widec = 2304 + (int16((m.data)[(m.p)]) - 0)
+		if m.p+2 < m.pe && m.data[m.p+1] == 10 && m.data[m.p+2] == 10 {
+			_widec += 256
 		}
-		goto tr0
-	tr96:
+		if 2304 <= _widec && _widec <= 2559 {
+			goto tr134
+		}
+		goto tr133
+	tr134:
 
 		m.pb = m.p
 
-		goto st110
-	st110:
+		goto st659
+	tr944:
+
+		if m.listener != nil {
+			m.notifyBody(m.pb-m.countNewlines, m.p)
+		}
+		// Append newlines
+		m.body.addNewlines(m.data, m.pb, m.countNewlines)
+		for m.countNewlines > 0 {
+			m.countNewlines--
+			if m.logger != nil {
+				m.logger.Info("valid commit message body content", "body", "\n")
+			}
+		}
+		// Append body content
+		m.body.add(m.data, m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message body content", "body", string(m.text()))
+		}
+
+		m.pb = m.p
+
+		goto st659
+	st659:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof110
+			goto _testEof659
 		}
-	stCase110:
-		switch (m.data)[(m.p)] {
-		case 69:
-			goto st111
-		case 101:
-			goto st111
+	stCase659:
+		_widec = int16((m.data)[(m.p)])
+		_widec = 2304 + (int16((m.data)[(m.p)]) - 0)
+		if m.p+2 < m.pe && m.data[m.p+1] == 10 && m.data[m.p+2] == 10 {
+			_widec += 256
 		}
-		goto tr0
-	st111:
+		if 2304 <= _widec && _widec <= 2559 {
+			goto tr944
+		}
+		goto tr943
+	st93:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof111
+			goto _testEof93
 		}
-	stCase111:
-		switch (m.data)[(m.p)] {
+	stCase93:
+		if (m.data)[(m.p)] == 10 {
+			goto tr136
+		}
+		goto st93
+	tr136:
+
+		// Mark the next character so that rewinds can not go back before it
+		// Eg., when it is not a possible trailer token start
+		m.pb = m.p + 1
+		if m.bodyStart < 0 {
+			// The first time is right after the header
+			m.bodyStart = m.pb
+		}
+		if m.gitTrailers {
+			// The footer is the trailer block git finds, already cut off from the input
+			{
+				goto st660
+			}
+		}
+		if m.logger != nil {
+			m.logger.Debug("try to parse a footer trailer token", "pos", m.p)
+		}
+		{
+			goto st654
+		}
+
+		goto st662
+	st662:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof662
+		}
+	stCase662:
+		goto st0
+	st94:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof94
+		}
+	stCase94:
+		if (m.data)[(m.p)] == 10 {
+			goto tr138
+		}
+		goto st94
+	tr138:
+
+		if m.p+1 < m.pe && m.data[m.p+1] == 10 {
+			m.p++
+		}
+
+		// Mark the next character so that rewinds can not go back before it
+		// Eg., when it is not a possible trailer token start
+		m.pb = m.p + 1
+		if m.bodyStart < 0 {
+			// The first time is right after the header
+			m.bodyStart = m.pb
+		}
+		if m.gitTrailers {
+			// The footer is the trailer block git finds, already cut off from the input
+			{
+				goto st660
+			}
+		}
+		if m.logger != nil {
+			m.logger.Debug("try to parse a footer trailer token", "pos", m.p)
+		}
+		{
+			goto st654
+		}
+
+		goto st663
+	st663:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof663
+		}
+	stCase663:
+		goto st0
+	stCase95:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 98:
+			switch {
+			case (m.data)[(m.p)] < 68:
+				switch {
+				case (m.data)[(m.p)] > 58:
+					if 66 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 66 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 58:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 68:
+				switch {
+				case (m.data)[(m.p)] < 72:
+					if 70 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 70 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 72:
+					if 84 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 84 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 98:
+			switch {
+			case (m.data)[(m.p)] < 104:
+				switch {
+				case (m.data)[(m.p)] > 100:
+					if 102 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 102 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 100:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 104:
+				switch {
+				case (m.data)[(m.p)] < 226:
+					if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 226:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+						if m.gitmoji {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr139
+		case 80:
+			goto tr140
+		case 82:
+			goto tr141
 		case 83:
-			goto st98
+			goto tr142
+		case 99:
+			goto tr139
+		case 112:
+			goto tr140
+		case 114:
+			goto tr141
 		case 115:
-			goto st98
+			goto tr142
+		case 3130:
+			goto tr143
+		case 3298:
+			goto tr144
+		case 3312:
+			goto tr145
+		case 3394:
+			goto tr146
+		case 3396:
+			goto tr147
+		case 3398:
+			goto tr148
+		case 3412:
+			goto tr149
+		case 3426:
+			goto tr146
+		case 3428:
+			goto tr147
+		case 3430:
+			goto tr148
+		case 3444:
+			goto tr149
+		case 3650:
+			goto tr150
+		case 3652:
+			goto tr151
+		case 3654:
+			goto tr152
+		case 3656:
+			goto tr153
+		case 3668:
+			goto tr154
+		case 3682:
+			goto tr150
+		case 3684:
+			goto tr151
+		case 3686:
+			goto tr152
+		case 3688:
+			goto tr153
+		case 3700:
+			goto tr154
 		}
 		goto tr0
-	tr97:
+	tr139:
 
 		m.pb = m.p
 
-		goto st112
-	st112:
+		goto st96
+	tr226:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st96
+	st96:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof112
+			goto _testEof96
 		}
-	stCase112:
+	stCase96:
 		switch (m.data)[(m.p)] {
-		case 80:
-			goto st113
-		case 112:
-			goto st113
+		case 72:
+			goto st97
+		case 73:
+			goto st100
+		case 104:
+			goto st97
+		case 105:
+			goto st100
 		}
 		goto tr0
-	st113:
+	st97:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof113
+			goto _testEof97
 		}
-	stCase113:
+	stCase97:
 		switch (m.data)[(m.p)] {
-		case 68:
-			goto st114
-		case 100:
-			goto st114
+		case 79:
+			goto st98
+		case 111:
+			goto st98
 		}
 		goto tr0
-	st114:
+	st98:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof114
+			goto _testEof98
 		}
-	stCase114:
+	stCase98:
 		switch (m.data)[(m.p)] {
-		case 65:
-			goto st115
-		case 97:
-			goto st115
+		case 82:
+			goto st99
+		case 114:
+			goto st99
 		}
 		goto tr0
-	st115:
+	st99:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof115
+			goto _testEof99
 		}
-	stCase115:
+	stCase99:
 		switch (m.data)[(m.p)] {
-		case 84:
-			goto st92
-		case 116:
-			goto st92
-		}
-		goto tr0
-	stCase116:
-		if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
-			goto tr131
+		case 69:
+			goto st100
+		case 101:
+			goto st100
 		}
 		goto tr0
-	tr131:
-
-		m.pb = m.p
-
-		goto st117
-	st117:
+	st100:
 
 		if (m.p + 1) == m.pe {
 			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
 		}
 
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof117
+			goto _testEof100
 		}
-	stCase117:
+	stCase100:
 
-		output._type = string(m.text())
-		m.emitInfo("valid commit message type", "type", output._type)
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
 
 		switch (m.data)[(m.p)] {
 		case 33:
-			goto tr133
+			goto tr159
 		case 40:
-			goto st122
+			goto st121
 		case 58:
-			goto st119
-		}
-		if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
-			goto st117
+			goto st102
 		}
-		goto tr6
-	tr133:
+		goto tr21
+	tr159:
 
 		output.exclamation = true
-		m.emitInfo("commit message communicates a breaking change")
+		if m.logger != nil {
+			m.logger.Info("commit message communicates a breaking change")
+		}
+		if m.listener != nil {
+			m.listener.OnBreaking(conventionalcommits.Span{Start: m.p, End: m.p + 1})
+		}
 
-		goto st118
-	st118:
+		goto st101
+	st101:
 
 		if (m.p + 1) == m.pe {
 			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
 		}
 
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof118
+			goto _testEof101
 		}
-	stCase118:
+	stCase101:
 		if (m.data)[(m.p)] == 58 {
-			goto st119
+			goto st102
 		}
-		goto tr6
-	st119:
+		goto tr21
+	st102:
 
 		if (m.p + 1) == m.pe {
 			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
 		}
 
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof119
+			goto _testEof102
 		}
-	stCase119:
+	stCase102:
 		if (m.data)[(m.p)] == 32 {
-			goto st120
+			goto st103
 		}
-		goto tr10
-	st120:
+		goto tr25
+	st103:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof120
+			goto _testEof103
 		}
-	stCase120:
-		switch (m.data)[(m.p)] {
-		case 10:
-			goto tr13
-		case 32:
-			goto st120
+	stCase103:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 11:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 9 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 9 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 31:
+				switch {
+				case (m.data)[(m.p)] < 33:
+					if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3872:
+			goto st112
+		case 4064:
+			goto tr166
+		case 4077:
+			goto tr168
+		case 4080:
+			goto tr169
+		case 4084:
+			goto tr171
+		case 4320:
+			goto tr174
+		case 4333:
+			goto tr176
+		case 4336:
+			goto tr177
+		case 4340:
+			goto tr179
+		}
+		switch {
+		case _widec < 4096:
+			switch {
+			case _widec < 4034:
+				switch {
+				case _widec > 3849:
+					if 3851 <= _widec && _widec <= 3967 {
+						goto tr163
+					}
+				case _widec >= 3840:
+					goto tr163
+				}
+			case _widec > 4063:
+				switch {
+				case _widec > 4079:
+					if 4081 <= _widec && _widec <= 4083 {
+						goto tr170
+					}
+				case _widec >= 4065:
+					goto tr167
+				}
+			default:
+				goto tr165
+			}
+		case _widec > 4104:
+			switch {
+			case _widec < 4290:
+				switch {
+				case _widec > 4127:
+					if 4129 <= _widec && _widec <= 4223 {
+						goto tr172
+					}
+				case _widec >= 4107:
+					goto tr172
+				}
+			case _widec > 4319:
+				switch {
+				case _widec > 4335:
+					if 4337 <= _widec && _widec <= 4339 {
+						goto tr178
+					}
+				case _widec >= 4321:
+					goto tr175
+				}
+			default:
+				goto tr173
+			}
+		default:
+			goto tr172
 		}
-		goto tr137
-	tr137:
+		goto tr27
+	tr163:
 
 		m.pb = m.p
 
-		goto st137
-	st137:
+		goto st664
+	st664:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof137
+			goto _testEof664
 		}
-	stCase137:
-		if (m.data)[(m.p)] == 10 {
-			goto tr158
+	stCase664:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 225:
+			switch {
+			case (m.data)[(m.p)] < 11:
+				if (m.data)[(m.p)] <= 9 {
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 127:
+				switch {
+				case (m.data)[(m.p)] > 223:
+					if 224 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 224 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 194:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 236:
+			switch {
+			case (m.data)[(m.p)] < 240:
+				switch {
+				case (m.data)[(m.p)] > 237:
+					if 238 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 239 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 237:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 240:
+				switch {
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 241:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 10:
+			goto tr947
+		case 4064:
+			goto st106
+		case 4077:
+			goto st108
+		case 4080:
+			goto st109
+		case 4084:
+			goto st111
+		}
+		switch {
+		case _widec < 4034:
+			switch {
+			case _widec > 3849:
+				if 3851 <= _widec && _widec <= 3967 {
+					goto st664
+				}
+			case _widec >= 3840:
+				goto st664
+			}
+		case _widec > 4063:
+			switch {
+			case _widec > 4079:
+				if 4081 <= _widec && _widec <= 4083 {
+					goto st110
+				}
+			case _widec >= 4065:
+				goto st107
+			}
+		default:
+			goto st105
+		}
+		goto tr27
+	tr947:
+
+		output.descr = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message description", "description", output.descr)
+		}
+		if m.listener != nil {
+			m.listener.OnDescription(output.descr, conventionalcommits.Span{Start: m.pb, End: m.p})
 		}
-		goto st137
-	tr158:
 
-		output.descr = string(m.text())
-		m.emitInfo("valid commit message description", "description", output.descr)
+		if m.err = m.checkHeader(); m.err != nil {
+			if m.recovery {
+				// Record the error and continue, the header is well-formed anyway
+				m.recover()
+			} else {
+				{
+					(m.p)++
+					m.cs = 104
+					goto _out
+				}
+			}
+		}
 
-		goto st121
-	st121:
+		goto st104
+	st104:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof121
+			goto _testEof104
 		}
-	stCase121:
+	stCase104:
 		if (m.data)[(m.p)] == 10 {
-			goto tr138
+			goto tr180
 		}
-		goto tr14
-	tr138:
+		goto tr45
+	tr180:
 
-		m.emitDebug("found a blank line", "pos", m.p)
+		if m.logger != nil {
+			m.logger.Debug("found a blank line", "pos", m.p)
+		}
 
-		m.emitDebug("try to parse a footer trailer token", "pos", m.p)
+		// Mark the next character so that rewinds can not go back before it
+		// Eg., when it is not a possible trailer token start
+		m.pb = m.p + 1
+		if m.bodyStart < 0 {
+			// The first time is right after the header
+			m.bodyStart = m.pb
+		}
+		if m.gitTrailers {
+			// The footer is the trailer block git finds, already cut off from the input
+			{
+				goto st660
+			}
+		}
+		if m.logger != nil {
+			m.logger.Debug("try to parse a footer trailer token", "pos", m.p)
+		}
 		{
-			goto st127
+			goto st654
 		}
 
-		goto st138
-	st138:
+		goto st665
+	st665:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof138
+			goto _testEof665
 		}
-	stCase138:
+	stCase665:
 		goto st0
-	st122:
+	tr165:
+
+		m.pb = m.p
+
+		goto st105
+	st105:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof122
-		}
-	stCase122:
-		if (m.data)[(m.p)] == 41 {
-			goto tr140
+			goto _testEof105
 		}
-		switch {
-		case (m.data)[(m.p)] > 39:
-			if 42 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
-				goto tr139
+	stCase105:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
 			}
-		case (m.data)[(m.p)] >= 32:
-			goto tr139
 		}
-		goto tr16
-	tr139:
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st664
+		}
+		goto tr27
+	tr166:
 
 		m.pb = m.p
 
-		goto st123
-	st123:
+		goto st106
+	st106:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof123
-		}
-	stCase123:
-		if (m.data)[(m.p)] == 41 {
-			goto tr142
+			goto _testEof106
 		}
-		switch {
-		case (m.data)[(m.p)] > 39:
-			if 42 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
-				goto st123
+	stCase106:
+		_widec = int16((m.data)[(m.p)])
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
 			}
-		case (m.data)[(m.p)] >= 32:
-			goto st123
 		}
-		goto tr16
-	tr140:
+		if 4000 <= _widec && _widec <= 4031 {
+			goto st105
+		}
+		goto tr27
+	tr167:
 
 		m.pb = m.p
 
-		output.scope = string(m.text())
-		m.emitInfo("valid commit message scope", "scope", output.scope)
-
-		goto st124
-	tr142:
-
-		output.scope = string(m.text())
-		m.emitInfo("valid commit message scope", "scope", output.scope)
-
-		goto st124
-	st124:
-
-		if (m.p + 1) == m.pe {
-			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
-		}
-
+		goto st107
+	st107:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof124
+			goto _testEof107
 		}
-	stCase124:
-		switch (m.data)[(m.p)] {
-		case 33:
-			goto tr133
-		case 58:
-			goto st119
+	stCase107:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-		goto tr6
-	tr145:
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st105
+		}
+		goto tr27
+	tr168:
 
-		// Increment number of newlines to use in case we're still in the body
-		m.countNewlines++
-		m.lastNewline = m.p
-		m.emitDebug("found a newline", "pos", m.p)
+		m.pb = m.p
 
-		goto st127
-	st127:
+		goto st108
+	st108:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof127
-		}
-	stCase127:
-		switch (m.data)[(m.p)] {
-		case 10:
-			goto tr145
-		case 66:
-			goto tr147
+			goto _testEof108
 		}
-		switch {
-		case (m.data)[(m.p)] < 65:
-			if 48 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 57 {
-				goto tr146
-			}
-		case (m.data)[(m.p)] > 90:
-			if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
-				goto tr146
+	stCase108:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
 			}
-		default:
-			goto tr146
 		}
-		goto tr21
-	tr146:
+		if 3968 <= _widec && _widec <= 3999 {
+			goto st105
+		}
+		goto tr27
+	tr169:
 
 		m.pb = m.p
 
-		goto st14
-	st14:
+		goto st109
+	st109:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof14
-		}
-	stCase14:
-		switch (m.data)[(m.p)] {
-		case 32:
-			goto tr22
-		case 45:
-			goto st16
-		case 58:
-			goto tr25
+			goto _testEof109
 		}
-		switch {
-		case (m.data)[(m.p)] < 65:
-			if 48 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 57 {
-				goto st14
-			}
-		case (m.data)[(m.p)] > 90:
-			if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
-				goto st14
+	stCase109:
+		_widec = int16((m.data)[(m.p)])
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
 			}
-		default:
-			goto st14
 		}
-		goto tr21
-	tr22:
-
-		// todo > alnum[[- ]alnum] string to lower can be more performant?
-		m.currentFooterKey = string(bytes.ToLower(m.text()))
-		if m.currentFooterKey == "breaking change" {
-			m.currentFooterKey = "breaking-change"
+		if 3984 <= _widec && _widec <= 4031 {
+			goto st107
 		}
-		m.emitDebug("possibly valid footer token", "token", m.currentFooterKey, "pos", m.p)
+		goto tr27
+	tr170:
 
-		goto st15
-	st15:
+		m.pb = m.p
+
+		goto st110
+	st110:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof15
+			goto _testEof110
 		}
-	stCase15:
-		if (m.data)[(m.p)] == 35 {
-			goto tr26
+	stCase110:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-		goto tr21
-	tr26:
-
-		m.emitDebug("try to parse a footer trailer value", "pos", m.p)
-		{
-			goto st33
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st107
 		}
+		goto tr27
+	tr171:
 
-		goto st128
-	st128:
+		m.pb = m.p
+
+		goto st111
+	st111:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof128
+			goto _testEof111
 		}
-	stCase128:
-		goto st0
-	st16:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof16
+	stCase111:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-	stCase16:
+		if 3968 <= _widec && _widec <= 3983 {
+			goto st107
+		}
+		goto tr27
+	st112:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof112
+		}
+	stCase112:
+		_widec = int16((m.data)[(m.p)])
 		switch {
-		case (m.data)[(m.p)] < 65:
-			if 48 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 57 {
-				goto st14
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 9:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
 			}
-		case (m.data)[(m.p)] > 90:
-			if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
-				goto st14
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
 			}
 		default:
-			goto st14
-		}
-		goto tr21
-	tr25:
-
-		// todo > alnum[[- ]alnum] string to lower can be more performant?
-		m.currentFooterKey = string(bytes.ToLower(m.text()))
-		if m.currentFooterKey == "breaking change" {
-			m.currentFooterKey = "breaking-change"
-		}
-		m.emitDebug("possibly valid footer token", "token", m.currentFooterKey, "pos", m.p)
-
-		goto st17
-	st17:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof17
-		}
-	stCase17:
-		if (m.data)[(m.p)] == 32 {
-			goto tr27
-		}
-		goto tr21
-	tr27:
-
-		m.emitDebug("try to parse a footer trailer value", "pos", m.p)
-		{
-			goto st33
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-
-		goto st129
-	st129:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof129
+		switch _widec {
+		case 3872:
+			goto st112
+		case 4064:
+			goto tr166
+		case 4077:
+			goto tr168
+		case 4080:
+			goto tr169
+		case 4084:
+			goto tr171
 		}
-	stCase129:
-		if (m.data)[(m.p)] == 32 {
-			goto tr27
+		switch {
+		case _widec < 4034:
+			switch {
+			case _widec > 3849:
+				if 3851 <= _widec && _widec <= 3967 {
+					goto tr163
+				}
+			case _widec >= 3840:
+				goto tr163
+			}
+		case _widec > 4063:
+			switch {
+			case _widec > 4079:
+				if 4081 <= _widec && _widec <= 4083 {
+					goto tr170
+				}
+			case _widec >= 4065:
+				goto tr167
+			}
+		default:
+			goto tr165
 		}
-		goto st0
-	tr147:
+		goto tr27
+	tr172:
 
 		m.pb = m.p
 
-		goto st18
-	st18:
+		goto st666
+	st666:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof18
-		}
-	stCase18:
-		switch (m.data)[(m.p)] {
-		case 32:
-			goto tr22
-		case 45:
-			goto st16
-		case 58:
-			goto tr25
-		case 82:
-			goto st19
+			goto _testEof666
 		}
+	stCase666:
+		_widec = int16((m.data)[(m.p)])
 		switch {
-		case (m.data)[(m.p)] < 65:
-			if 48 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 57 {
-				goto st14
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
 			}
-		case (m.data)[(m.p)] > 90:
-			if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
-				goto st14
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
 			}
 		default:
-			goto st14
-		}
-		goto tr21
-	st19:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof19
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-	stCase19:
-		switch (m.data)[(m.p)] {
-		case 32:
-			goto tr22
-		case 45:
-			goto st16
-		case 58:
-			goto tr25
-		case 69:
-			goto st20
+		switch _widec {
+		case 10:
+			goto tr947
+		case 4128:
+			goto st113
+		case 4320:
+			goto st115
+		case 4333:
+			goto st117
+		case 4336:
+			goto st118
+		case 4340:
+			goto st120
 		}
 		switch {
-		case (m.data)[(m.p)] < 65:
-			if 48 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 57 {
-				goto st14
+		case _widec < 4290:
+			switch {
+			case _widec > 4104:
+				if 4107 <= _widec && _widec <= 4223 {
+					goto st666
+				}
+			case _widec >= 4096:
+				goto st666
 			}
-		case (m.data)[(m.p)] > 90:
-			if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
-				goto st14
+		case _widec > 4319:
+			switch {
+			case _widec > 4335:
+				if 4337 <= _widec && _widec <= 4339 {
+					goto st119
+				}
+			case _widec >= 4321:
+				goto st116
 			}
 		default:
-			goto st14
+			goto st114
 		}
-		goto tr21
-	st20:
+		goto tr50
+	st113:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof20
-		}
-	stCase20:
-		switch (m.data)[(m.p)] {
-		case 32:
-			goto tr22
-		case 45:
-			goto st16
-		case 58:
-			goto tr25
-		case 65:
-			goto st21
+			goto _testEof113
 		}
+	stCase113:
+		_widec = int16((m.data)[(m.p)])
 		switch {
-		case (m.data)[(m.p)] < 66:
-			if 48 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 57 {
-				goto st14
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
 			}
-		case (m.data)[(m.p)] > 90:
-			if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
-				goto st14
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
 			}
 		default:
-			goto st14
-		}
-		goto tr21
-	st21:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof21
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
 		}
-	stCase21:
-		switch (m.data)[(m.p)] {
-		case 32:
-			goto tr22
-		case 45:
-			goto st16
-		case 58:
-			goto tr25
-		case 75:
-			goto st22
+		switch _widec {
+		case 4128:
+			goto st113
+		case 4320:
+			goto st115
+		case 4333:
+			goto st117
+		case 4336:
+			goto st118
+		case 4340:
+			goto st120
 		}
 		switch {
-		case (m.data)[(m.p)] < 65:
-			if 48 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 57 {
-				goto st14
+		case _widec < 4290:
+			switch {
+			case _widec > 4104:
+				if 4107 <= _widec && _widec <= 4223 {
+					goto st666
+				}
+			case _widec >= 4096:
+				goto st666
 			}
-		case (m.data)[(m.p)] > 90:
-			if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
-				goto st14
+		case _widec > 4319:
+			switch {
+			case _widec > 4335:
+				if 4337 <= _widec && _widec <= 4339 {
+					goto st119
+				}
+			case _widec >= 4321:
+				goto st116
 			}
 		default:
-			goto st14
+			goto st114
 		}
-		goto tr21
-	st22:
+		goto tr50
+	tr173:
+
+		m.pb = m.p
+
+		goto st114
+	st114:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof22
-		}
-	stCase22:
-		switch (m.data)[(m.p)] {
-		case 32:
-			goto tr22
-		case 45:
-			goto st16
-		case 58:
-			goto tr25
-		case 73:
-			goto st23
+			goto _testEof114
 		}
-		switch {
-		case (m.data)[(m.p)] < 65:
-			if 48 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 57 {
-				goto st14
-			}
-		case (m.data)[(m.p)] > 90:
-			if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
-				goto st14
+	stCase114:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
 			}
-		default:
-			goto st14
 		}
-		goto tr21
-	st23:
-		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof23
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st666
 		}
-	stCase23:
-		switch (m.data)[(m.p)] {
-		case 32:
-			goto tr22
-		case 45:
-			goto st16
-		case 58:
-			goto tr25
-		case 78:
-			goto st24
+		goto tr50
+	tr174:
+
+		m.pb = m.p
+
+		goto st115
+	st115:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof115
 		}
-		switch {
-		case (m.data)[(m.p)] < 65:
-			if 48 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 57 {
-				goto st14
-			}
-		case (m.data)[(m.p)] > 90:
-			if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
-				goto st14
+	stCase115:
+		_widec = int16((m.data)[(m.p)])
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
 			}
-		default:
-			goto st14
 		}
-		goto tr21
-	st24:
+		if 4256 <= _widec && _widec <= 4287 {
+			goto st114
+		}
+		goto tr50
+	tr175:
+
+		m.pb = m.p
+
+		goto st116
+	st116:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof24
+			goto _testEof116
 		}
-	stCase24:
-		switch (m.data)[(m.p)] {
-		case 32:
-			goto tr22
-		case 45:
-			goto st16
-		case 58:
-			goto tr25
-		case 71:
-			goto st25
+	stCase116:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st114
+		}
+		goto tr50
+	tr176:
+
+		m.pb = m.p
+
+		goto st117
+	st117:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof117
+		}
+	stCase117:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4255 {
+			goto st114
+		}
+		goto tr50
+	tr177:
+
+		m.pb = m.p
+
+		goto st118
+	st118:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof118
+		}
+	stCase118:
+		_widec = int16((m.data)[(m.p)])
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4240 <= _widec && _widec <= 4287 {
+			goto st116
+		}
+		goto tr50
+	tr178:
+
+		m.pb = m.p
+
+		goto st119
+	st119:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof119
+		}
+	stCase119:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st116
+		}
+		goto tr50
+	tr179:
+
+		m.pb = m.p
+
+		goto st120
+	st120:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof120
+		}
+	stCase120:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4239 {
+			goto st116
+		}
+		goto tr50
+	st121:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof121
+		}
+	stCase121:
+		switch (m.data)[(m.p)] {
+		case 41:
+			goto tr194
+		case 224:
+			goto tr196
+		case 237:
+			goto tr198
+		case 240:
+			goto tr199
+		case 244:
+			goto tr201
 		}
 		switch {
-		case (m.data)[(m.p)] < 65:
-			if 48 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 57 {
-				goto st14
+		case (m.data)[(m.p)] < 194:
+			switch {
+			case (m.data)[(m.p)] > 39:
+				if 42 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+					goto tr193
+				}
+			case (m.data)[(m.p)] >= 32:
+				goto tr193
 			}
-		case (m.data)[(m.p)] > 90:
-			if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
-				goto st14
+		case (m.data)[(m.p)] > 223:
+			switch {
+			case (m.data)[(m.p)] > 239:
+				if 241 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 243 {
+					goto tr200
+				}
+			case (m.data)[(m.p)] >= 225:
+				goto tr197
 			}
 		default:
-			goto st14
+			goto tr195
 		}
-		goto tr21
-	st25:
+		goto tr60
+	tr193:
+
+		m.pb = m.p
+
+		goto st122
+	st122:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof25
+			goto _testEof122
 		}
-	stCase25:
+	stCase122:
 		switch (m.data)[(m.p)] {
-		case 32:
-			goto tr35
-		case 45:
-			goto st16
-		case 58:
-			goto tr25
+		case 41:
+			goto tr203
+		case 224:
+			goto st125
+		case 237:
+			goto st127
+		case 240:
+			goto st128
+		case 244:
+			goto st130
 		}
 		switch {
-		case (m.data)[(m.p)] < 65:
-			if 48 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 57 {
-				goto st14
+		case (m.data)[(m.p)] < 194:
+			switch {
+			case (m.data)[(m.p)] > 39:
+				if 42 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+					goto st122
+				}
+			case (m.data)[(m.p)] >= 32:
+				goto st122
 			}
-		case (m.data)[(m.p)] > 90:
-			if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
-				goto st14
+		case (m.data)[(m.p)] > 223:
+			switch {
+			case (m.data)[(m.p)] > 239:
+				if 241 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 243 {
+					goto st129
+				}
+			case (m.data)[(m.p)] >= 225:
+				goto st126
 			}
 		default:
-			goto st14
+			goto st124
 		}
-		goto tr21
-	tr35:
+		goto tr60
+	tr194:
 
-		// todo > alnum[[- ]alnum] string to lower can be more performant?
-		m.currentFooterKey = string(bytes.ToLower(m.text()))
-		if m.currentFooterKey == "breaking change" {
-			m.currentFooterKey = "breaking-change"
+		m.pb = m.p
+
+		output.scope = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message scope", "scope", output.scope)
+		}
+		if m.listener != nil {
+			m.listener.OnScope(output.scope, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		goto st123
+	tr203:
+
+		output.scope = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message scope", "scope", output.scope)
+		}
+		if m.listener != nil {
+			m.listener.OnScope(output.scope, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		goto st123
+	st123:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
 		}
-		m.emitDebug("possibly valid footer token", "token", m.currentFooterKey, "pos", m.p)
 
-		goto st26
-	st26:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof26
+			goto _testEof123
 		}
-	stCase26:
+	stCase123:
 		switch (m.data)[(m.p)] {
-		case 35:
-			goto tr26
-		case 67:
-			goto st27
+		case 33:
+			goto tr159
+		case 58:
+			goto st102
 		}
 		goto tr21
-	st27:
+	tr195:
+
+		m.pb = m.p
+
+		goto st124
+	st124:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof27
+			goto _testEof124
 		}
-	stCase27:
-		if (m.data)[(m.p)] == 72 {
-			goto st28
+	stCase124:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st122
 		}
-		goto tr21
-	st28:
+		goto tr60
+	tr196:
+
+		m.pb = m.p
+
+		goto st125
+	st125:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof28
+			goto _testEof125
 		}
-	stCase28:
-		if (m.data)[(m.p)] == 65 {
-			goto st29
+	stCase125:
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st124
 		}
-		goto tr21
-	st29:
+		goto tr60
+	tr197:
+
+		m.pb = m.p
+
+		goto st126
+	st126:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof29
+			goto _testEof126
 		}
-	stCase29:
-		if (m.data)[(m.p)] == 78 {
-			goto st30
+	stCase126:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st124
 		}
-		goto tr21
-	st30:
+		goto tr60
+	tr198:
+
+		m.pb = m.p
+
+		goto st127
+	st127:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof30
+			goto _testEof127
 		}
-	stCase30:
-		if (m.data)[(m.p)] == 71 {
-			goto st31
+	stCase127:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			goto st124
 		}
-		goto tr21
-	st31:
+		goto tr60
+	tr199:
+
+		m.pb = m.p
+
+		goto st128
+	st128:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof31
+			goto _testEof128
 		}
-	stCase31:
-		if (m.data)[(m.p)] == 69 {
-			goto st32
+	stCase128:
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st126
 		}
-		goto tr21
-	st32:
+		goto tr60
+	tr200:
+
+		m.pb = m.p
+
+		goto st129
+	st129:
 		if (m.p)++; (m.p) == (m.pe) {
-			goto _testEof32
+			goto _testEof129
 		}
-	stCase32:
-		if (m.data)[(m.p)] == 58 {
-			goto tr25
+	stCase129:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st126
 		}
-		goto tr21
-	stOut:
-	_testEof2:
-		m.cs = 2
+		goto tr60
+	tr201:
+
+		m.pb = m.p
+
+		goto st130
+	st130:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof130
+		}
+	stCase130:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			goto st126
+		}
+		goto tr60
+	tr140:
+
+		m.pb = m.p
+
+		goto st131
+	tr227:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st131
+	st131:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof131
+		}
+	stCase131:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st132
+		case 101:
+			goto st132
+		}
+		goto tr0
+	st132:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof132
+		}
+	stCase132:
+		switch (m.data)[(m.p)] {
+		case 82:
+			goto st133
+		case 114:
+			goto st133
+		}
+		goto tr0
+	st133:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof133
+		}
+	stCase133:
+		switch (m.data)[(m.p)] {
+		case 70:
+			goto st100
+		case 102:
+			goto st100
+		}
+		goto tr0
+	tr141:
+
+		m.pb = m.p
+
+		goto st134
+	tr228:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st134
+	st134:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof134
+		}
+	stCase134:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st135
+		case 101:
+			goto st135
+		}
+		goto tr0
+	st135:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof135
+		}
+	stCase135:
+		switch (m.data)[(m.p)] {
+		case 70:
+			goto st136
+		case 86:
+			goto st141
+		case 102:
+			goto st136
+		case 118:
+			goto st141
+		}
+		goto tr0
+	st136:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof136
+		}
+	stCase136:
+		switch (m.data)[(m.p)] {
+		case 65:
+			goto st137
+		case 97:
+			goto st137
+		}
+		goto tr0
+	st137:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof137
+		}
+	stCase137:
+		switch (m.data)[(m.p)] {
+		case 67:
+			goto st138
+		case 99:
+			goto st138
+		}
+		goto tr0
+	st138:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof138
+		}
+	stCase138:
+		switch (m.data)[(m.p)] {
+		case 84:
+			goto st139
+		case 116:
+			goto st139
+		}
+		goto tr0
+	st139:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof139
+		}
+	stCase139:
+		switch (m.data)[(m.p)] {
+		case 79:
+			goto st140
+		case 111:
+			goto st140
+		}
+		goto tr0
+	st140:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof140
+		}
+	stCase140:
+		switch (m.data)[(m.p)] {
+		case 82:
+			goto st100
+		case 114:
+			goto st100
+		}
+		goto tr0
+	st141:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof141
+		}
+	stCase141:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st142
+		case 101:
+			goto st142
+		}
+		goto tr0
+	st142:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof142
+		}
+	stCase142:
+		switch (m.data)[(m.p)] {
+		case 82:
+			goto st143
+		case 114:
+			goto st143
+		}
+		goto tr0
+	st143:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof143
+		}
+	stCase143:
+		switch (m.data)[(m.p)] {
+		case 84:
+			goto st100
+		case 116:
+			goto st100
+		}
+		goto tr0
+	tr142:
+
+		m.pb = m.p
+
+		goto st144
+	tr229:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st144
+	st144:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof144
+		}
+	stCase144:
+		switch (m.data)[(m.p)] {
+		case 84:
+			goto st145
+		case 116:
+			goto st145
+		}
+		goto tr0
+	st145:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof145
+		}
+	stCase145:
+		switch (m.data)[(m.p)] {
+		case 89:
+			goto st146
+		case 121:
+			goto st146
+		}
+		goto tr0
+	st146:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof146
+		}
+	stCase146:
+		switch (m.data)[(m.p)] {
+		case 76:
+			goto st99
+		case 108:
+			goto st99
+		}
+		goto tr0
+	tr143:
+
+		m.pb = m.p
+
+		goto st147
+	st147:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof147
+		}
+	stCase147:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 48:
+			switch {
+			case (m.data)[(m.p)] > 43:
+				if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 43:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 57:
+			switch {
+			case (m.data)[(m.p)] > 95:
+				if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 95:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3115:
+			goto st148
+		case 3117:
+			goto st148
+		case 3167:
+			goto st148
+		}
+		switch {
+		case _widec > 3129:
+			if 3169 <= _widec && _widec <= 3194 {
+				goto st148
+			}
+		case _widec >= 3120:
+			goto st148
+		}
+		goto tr8
+	st148:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof148
+		}
+	stCase148:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 48:
+			switch {
+			case (m.data)[(m.p)] > 43:
+				if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 43:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 57:
+			switch {
+			case (m.data)[(m.p)] < 95:
+				if 58 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 58 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 95:
+				if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3115:
+			goto st148
+		case 3117:
+			goto st148
+		case 3130:
+			goto st149
+		case 3167:
+			goto st148
+		}
+		switch {
+		case _widec > 3129:
+			if 3169 <= _widec && _widec <= 3194 {
+				goto st148
+			}
+		case _widec >= 3120:
+			goto st148
+		}
+		goto tr8
+	st149:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof149
+		}
+	stCase149:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 84:
+			switch {
+			case (m.data)[(m.p)] < 68:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 66 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 66 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 68:
+				switch {
+				case (m.data)[(m.p)] > 70:
+					if 72 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 72 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 70:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 84:
+			switch {
+			case (m.data)[(m.p)] < 102:
+				switch {
+				case (m.data)[(m.p)] > 98:
+					if 100 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 100 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 98:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 102:
+				switch {
+				case (m.data)[(m.p)] > 104:
+					if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 104:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr226
+		case 80:
+			goto tr227
+		case 82:
+			goto tr228
+		case 83:
+			goto tr229
+		case 99:
+			goto tr226
+		case 112:
+			goto tr227
+		case 114:
+			goto tr228
+		case 115:
+			goto tr229
+		case 3104:
+			goto tr230
+		case 3394:
+			goto tr231
+		case 3396:
+			goto tr232
+		case 3398:
+			goto tr233
+		case 3412:
+			goto tr234
+		case 3426:
+			goto tr231
+		case 3428:
+			goto tr232
+		case 3430:
+			goto tr233
+		case 3444:
+			goto tr234
+		case 3650:
+			goto tr235
+		case 3652:
+			goto tr236
+		case 3654:
+			goto tr237
+		case 3656:
+			goto tr238
+		case 3668:
+			goto tr239
+		case 3682:
+			goto tr235
+		case 3684:
+			goto tr236
+		case 3686:
+			goto tr237
+		case 3688:
+			goto tr238
+		case 3700:
+			goto tr239
+		}
+		goto tr0
+	tr230:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		goto st150
+	st150:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof150
+		}
+	stCase150:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 84:
+			switch {
+			case (m.data)[(m.p)] < 68:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 66 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 66 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 68:
+				switch {
+				case (m.data)[(m.p)] > 70:
+					if 72 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 72 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 70:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 84:
+			switch {
+			case (m.data)[(m.p)] < 102:
+				switch {
+				case (m.data)[(m.p)] > 98:
+					if 100 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 100 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 98:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 102:
+				switch {
+				case (m.data)[(m.p)] > 104:
+					if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 104:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr139
+		case 80:
+			goto tr140
+		case 82:
+			goto tr141
+		case 83:
+			goto tr142
+		case 99:
+			goto tr139
+		case 112:
+			goto tr140
+		case 114:
+			goto tr141
+		case 115:
+			goto tr142
+		case 3104:
+			goto st150
+		case 3394:
+			goto tr146
+		case 3396:
+			goto tr147
+		case 3398:
+			goto tr148
+		case 3412:
+			goto tr149
+		case 3426:
+			goto tr146
+		case 3428:
+			goto tr147
+		case 3430:
+			goto tr148
+		case 3444:
+			goto tr149
+		case 3650:
+			goto tr150
+		case 3652:
+			goto tr151
+		case 3654:
+			goto tr152
+		case 3656:
+			goto tr153
+		case 3668:
+			goto tr154
+		case 3682:
+			goto tr150
+		case 3684:
+			goto tr151
+		case 3686:
+			goto tr152
+		case 3688:
+			goto tr153
+		case 3700:
+			goto tr154
+		}
+		goto tr0
+	tr146:
+
+		m.pb = m.p
+
+		goto st151
+	tr231:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st151
+	st151:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof151
+		}
+	stCase151:
+		switch (m.data)[(m.p)] {
+		case 85:
+			goto st152
+		case 117:
+			goto st152
+		}
+		goto tr0
+	st152:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof152
+		}
+	stCase152:
+		switch (m.data)[(m.p)] {
+		case 73:
+			goto st153
+		case 105:
+			goto st153
+		}
+		goto tr0
+	st153:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof153
+		}
+	stCase153:
+		switch (m.data)[(m.p)] {
+		case 76:
+			goto st154
+		case 108:
+			goto st154
+		}
+		goto tr0
+	st154:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof154
+		}
+	stCase154:
+		switch (m.data)[(m.p)] {
+		case 68:
+			goto st100
+		case 100:
+			goto st100
+		}
+		goto tr0
+	tr147:
+
+		m.pb = m.p
+
+		goto st155
+	tr232:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st155
+	st155:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof155
+		}
+	stCase155:
+		switch (m.data)[(m.p)] {
+		case 79:
+			goto st156
+		case 111:
+			goto st156
+		}
+		goto tr0
+	st156:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof156
+		}
+	stCase156:
+		switch (m.data)[(m.p)] {
+		case 67:
+			goto st157
+		case 99:
+			goto st157
+		}
+		goto tr0
+	st157:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof157
+		}
+	stCase157:
+		switch (m.data)[(m.p)] {
+		case 83:
+			goto st100
+		case 115:
+			goto st100
+		}
+		goto tr0
+	tr148:
+
+		m.pb = m.p
+
+		goto st158
+	tr233:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st158
+	st158:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof158
+		}
+	stCase158:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st159
+		case 73:
+			goto st160
+		case 101:
+			goto st159
+		case 105:
+			goto st160
+		}
+		goto tr0
+	st159:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof159
+		}
+	stCase159:
+		switch (m.data)[(m.p)] {
+		case 65:
+			goto st143
+		case 97:
+			goto st143
+		}
+		goto tr0
+	st160:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof160
+		}
+	stCase160:
+		switch (m.data)[(m.p)] {
+		case 88:
+			goto st100
+		case 120:
+			goto st100
+		}
+		goto tr0
+	tr149:
+
+		m.pb = m.p
+
+		goto st161
+	tr234:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st161
+	st161:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof161
+		}
+	stCase161:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st162
+		case 101:
+			goto st162
+		}
+		goto tr0
+	st162:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof162
+		}
+	stCase162:
+		switch (m.data)[(m.p)] {
+		case 83:
+			goto st143
+		case 115:
+			goto st143
+		}
+		goto tr0
+	tr150:
+
+		m.pb = m.p
+
+		goto st163
+	tr235:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st163
+	st163:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof163
+		}
+	stCase163:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 85:
+			if 117 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 117 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 85:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3413:
+			goto st152
+		case 3445:
+			goto st152
+		case 3669:
+			goto st164
+		case 3701:
+			goto st164
+		}
+		goto tr0
+	st164:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof164
+		}
+	stCase164:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 71:
+			if 103 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 103 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 71:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 73:
+			goto st153
+		case 105:
+			goto st153
+		case 3655:
+			goto st165
+		case 3687:
+			goto st165
+		}
+		goto tr0
+	st165:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof165
+		}
+	stCase165:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 70:
+			if 102 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 102 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 70:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3654:
+			goto st166
+		case 3686:
+			goto st166
+		}
+		goto tr0
+	st166:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof166
+		}
+	stCase166:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 73:
+			if 105 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 105 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 73:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3657:
+			goto st167
+		case 3689:
+			goto st167
+		}
+		goto tr0
+	st167:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof167
+		}
+	stCase167:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 88:
+			if 120 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 120 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 88:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3672:
+			goto st100
+		case 3704:
+			goto st100
+		}
+		goto tr0
+	tr151:
+
+		m.pb = m.p
+
+		goto st168
+	tr236:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st168
+	st168:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof168
+		}
+	stCase168:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 79:
+			if 111 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 111 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 79:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3407:
+			goto st156
+		case 3439:
+			goto st156
+		case 3663:
+			goto st169
+		case 3695:
+			goto st169
+		}
+		goto tr0
+	st169:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof169
+		}
+	stCase169:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 67:
+			if 99 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 99 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 67:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3395:
+			goto st157
+		case 3427:
+			goto st157
+		case 3651:
+			goto st170
+		case 3683:
+			goto st170
+		}
+		goto tr0
+	st170:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof170
+		}
+	stCase170:
+
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		switch (m.data)[(m.p)] {
+		case 33:
+			goto tr159
+		case 40:
+			goto st121
+		case 58:
+			goto st102
+		case 83:
+			goto st100
+		case 115:
+			goto st100
+		}
+		goto tr21
+	tr152:
+
+		m.pb = m.p
+
+		goto st171
+	tr237:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st171
+	st171:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof171
+		}
+	stCase171:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 69:
+			if 101 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 101 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 69:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 73:
+			goto st160
+		case 105:
+			goto st160
+		case 3397:
+			goto st159
+		case 3429:
+			goto st159
+		case 3653:
+			goto st172
+		case 3685:
+			goto st172
+		}
+		goto tr0
+	st172:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof172
+		}
+	stCase172:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 65:
+			if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 97 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 65:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3393:
+			goto st143
+		case 3425:
+			goto st143
+		case 3649:
+			goto st173
+		case 3681:
+			goto st173
+		}
+		goto tr0
+	st173:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof173
+		}
+	stCase173:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 84:
+			if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 84:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3412:
+			goto st100
+		case 3444:
+			goto st100
+		case 3668:
+			goto st174
+		case 3700:
+			goto st174
+		}
+		goto tr0
+	st174:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof174
+		}
+	stCase174:
+
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 85:
+			if 117 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 117 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 85:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 33:
+			goto tr159
+		case 40:
+			goto st121
+		case 58:
+			goto st102
+		case 3669:
+			goto st175
+		case 3701:
+			goto st175
+		}
+		goto tr21
+	st175:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof175
+		}
+	stCase175:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 82:
+			if 114 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 114 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 82:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3666:
+			goto st176
+		case 3698:
+			goto st176
+		}
+		goto tr0
+	st176:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof176
+		}
+	stCase176:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 69:
+			if 101 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 101 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 69:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3653:
+			goto st100
+		case 3685:
+			goto st100
+		}
+		goto tr0
+	tr153:
+
+		m.pb = m.p
+
+		goto st177
+	tr238:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st177
+	st177:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof177
+		}
+	stCase177:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 79:
+			if 111 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 111 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 79:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3663:
+			goto st178
+		case 3695:
+			goto st178
+		}
+		goto tr0
+	st178:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof178
+		}
+	stCase178:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 84:
+			if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 84:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3668:
+			goto st165
+		case 3700:
+			goto st165
+		}
+		goto tr0
+	tr154:
+
+		m.pb = m.p
+
+		goto st179
+	tr239:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st179
+	st179:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof179
+		}
+	stCase179:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 69:
+			if 101 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 101 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 69:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3397:
+			goto st162
+		case 3429:
+			goto st162
+		case 3653:
+			goto st180
+		case 3685:
+			goto st180
+		}
+		goto tr0
+	st180:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof180
+		}
+	stCase180:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 83:
+			if 115 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 115 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 83:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3411:
+			goto st143
+		case 3443:
+			goto st143
+		case 3667:
+			goto st181
+		case 3699:
+			goto st181
+		}
+		goto tr0
+	st181:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof181
+		}
+	stCase181:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 84:
+			if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 84:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3412:
+			goto st100
+		case 3444:
+			goto st100
+		case 3668:
+			goto st182
+		case 3700:
+			goto st182
+		}
+		goto tr0
+	st182:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof182
+		}
+	stCase182:
+
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 83:
+			if 115 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 115 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 83:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 33:
+			goto tr159
+		case 40:
+			goto st121
+		case 58:
+			goto st102
+		case 3667:
+			goto st100
+		case 3699:
+			goto st100
+		}
+		goto tr21
+	tr144:
+
+		m.pb = m.p
+
+		goto st183
+	st183:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof183
+		}
+	stCase183:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 152:
+			if 140 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 158:
+			if 172 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 175 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch {
+		case _widec < 3224:
+			if 3212 <= _widec && _widec <= 3215 {
+				goto st184
+			}
+		case _widec > 3230:
+			if 3244 <= _widec && _widec <= 3247 {
+				goto st184
+			}
+		default:
+			goto st184
+		}
+		goto tr8
+	st184:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof184
+		}
+	stCase184:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if 3200 <= _widec && _widec <= 3263 {
+			goto st185
+		}
+		goto tr8
+	st185:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof185
+		}
+	stCase185:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 98:
+			switch {
+			case (m.data)[(m.p)] < 68:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 66 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 66 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 68:
+				switch {
+				case (m.data)[(m.p)] < 72:
+					if 70 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 70 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 72:
+					if 84 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 84 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 98:
+			switch {
+			case (m.data)[(m.p)] < 104:
+				switch {
+				case (m.data)[(m.p)] > 100:
+					if 102 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 102 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 100:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 104:
+				switch {
+				case (m.data)[(m.p)] < 226:
+					if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 226:
+					if 239 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 239 {
+						_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+						if m.gitmoji {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr226
+		case 80:
+			goto tr227
+		case 82:
+			goto tr228
+		case 83:
+			goto tr229
+		case 99:
+			goto tr226
+		case 112:
+			goto tr227
+		case 114:
+			goto tr228
+		case 115:
+			goto tr229
+		case 3104:
+			goto tr230
+		case 3298:
+			goto st186
+		case 3311:
+			goto st191
+		case 3394:
+			goto tr231
+		case 3396:
+			goto tr232
+		case 3398:
+			goto tr233
+		case 3412:
+			goto tr234
+		case 3426:
+			goto tr231
+		case 3428:
+			goto tr232
+		case 3430:
+			goto tr233
+		case 3444:
+			goto tr234
+		case 3650:
+			goto tr235
+		case 3652:
+			goto tr236
+		case 3654:
+			goto tr237
+		case 3656:
+			goto tr238
+		case 3668:
+			goto tr239
+		case 3682:
+			goto tr235
+		case 3684:
+			goto tr236
+		case 3686:
+			goto tr237
+		case 3688:
+			goto tr238
+		case 3700:
+			goto tr239
+		}
+		goto tr0
+	st186:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof186
+		}
+	stCase186:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 128 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3200 {
+			goto st187
+		}
+		goto tr8
+	st187:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof187
+		}
+	stCase187:
+		_widec = int16((m.data)[(m.p)])
+		if 141 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 141 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3213 {
+			goto st188
+		}
+		goto tr8
+	st188:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof188
+		}
+	stCase188:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 226:
+			if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 226:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3298:
+			goto st183
+		case 3312:
+			goto st189
+		}
+		goto tr8
+	tr145:
+
+		m.pb = m.p
+
+		goto st189
+	st189:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof189
+		}
+	stCase189:
+		_widec = int16((m.data)[(m.p)])
+		if 159 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3231 {
+			goto st190
+		}
+		goto tr8
+	st190:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof190
+		}
+	stCase190:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 171 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if 3200 <= _widec && _widec <= 3243 {
+			goto st184
+		}
+		goto tr8
+	st191:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof191
+		}
+	stCase191:
+		_widec = int16((m.data)[(m.p)])
+		if 184 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 184 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3256 {
+			goto st192
+		}
+		goto tr8
+	st192:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof192
+		}
+	stCase192:
+		_widec = int16((m.data)[(m.p)])
+		if 143 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3215 {
+			goto st193
+		}
+		goto tr8
+	st193:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof193
+		}
+	stCase193:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 84:
+			switch {
+			case (m.data)[(m.p)] < 68:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 66 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 66 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 68:
+				switch {
+				case (m.data)[(m.p)] > 70:
+					if 72 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 72 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 70:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 84:
+			switch {
+			case (m.data)[(m.p)] < 102:
+				switch {
+				case (m.data)[(m.p)] > 98:
+					if 100 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 100 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 98:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 102:
+				switch {
+				case (m.data)[(m.p)] < 116:
+					if 104 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 104 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 116:
+					if 226 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 226 {
+						_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+						if m.gitmoji {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr226
+		case 80:
+			goto tr227
+		case 82:
+			goto tr228
+		case 83:
+			goto tr229
+		case 99:
+			goto tr226
+		case 112:
+			goto tr227
+		case 114:
+			goto tr228
+		case 115:
+			goto tr229
+		case 3104:
+			goto tr230
+		case 3298:
+			goto st186
+		case 3394:
+			goto tr231
+		case 3396:
+			goto tr232
+		case 3398:
+			goto tr233
+		case 3412:
+			goto tr234
+		case 3426:
+			goto tr231
+		case 3428:
+			goto tr232
+		case 3430:
+			goto tr233
+		case 3444:
+			goto tr234
+		case 3650:
+			goto tr235
+		case 3652:
+			goto tr236
+		case 3654:
+			goto tr237
+		case 3656:
+			goto tr238
+		case 3668:
+			goto tr239
+		case 3682:
+			goto tr235
+		case 3684:
+			goto tr236
+		case 3686:
+			goto tr237
+		case 3688:
+			goto tr238
+		case 3700:
+			goto tr239
+		}
+		goto tr0
+	stCase194:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 98:
+			switch {
+			case (m.data)[(m.p)] < 68:
+				switch {
+				case (m.data)[(m.p)] > 58:
+					if 66 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 66 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 58:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 68:
+				switch {
+				case (m.data)[(m.p)] < 72:
+					if 70 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 70 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 72:
+					if 84 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 84 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 98:
+			switch {
+			case (m.data)[(m.p)] < 104:
+				switch {
+				case (m.data)[(m.p)] > 100:
+					if 102 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 102 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 100:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 104:
+				switch {
+				case (m.data)[(m.p)] < 226:
+					if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 226:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+						if m.gitmoji {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr275
+		case 78:
+			goto tr276
+		case 80:
+			goto tr277
+		case 82:
+			goto tr278
+		case 85:
+			goto tr279
+		case 99:
+			goto tr275
+		case 110:
+			goto tr276
+		case 112:
+			goto tr277
+		case 114:
+			goto tr278
+		case 117:
+			goto tr279
+		case 3130:
+			goto tr280
+		case 3298:
+			goto tr281
+		case 3312:
+			goto tr282
+		case 3394:
+			goto tr283
+		case 3396:
+			goto tr284
+		case 3398:
+			goto tr285
+		case 3412:
+			goto tr286
+		case 3426:
+			goto tr283
+		case 3428:
+			goto tr284
+		case 3430:
+			goto tr285
+		case 3444:
+			goto tr286
+		case 3650:
+			goto tr287
+		case 3652:
+			goto tr288
+		case 3654:
+			goto tr289
+		case 3656:
+			goto tr290
+		case 3668:
+			goto tr291
+		case 3682:
+			goto tr287
+		case 3684:
+			goto tr288
+		case 3686:
+			goto tr289
+		case 3688:
+			goto tr290
+		case 3700:
+			goto tr291
+		}
+		goto tr0
+	tr275:
+
+		m.pb = m.p
+
+		goto st195
+	tr361:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st195
+	st195:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof195
+		}
+	stCase195:
+		switch (m.data)[(m.p)] {
+		case 72:
+			goto st196
+		case 73:
+			goto st199
+		case 104:
+			goto st196
+		case 105:
+			goto st199
+		}
+		goto tr0
+	st196:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof196
+		}
+	stCase196:
+		switch (m.data)[(m.p)] {
+		case 79:
+			goto st197
+		case 111:
+			goto st197
+		}
+		goto tr0
+	st197:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof197
+		}
+	stCase197:
+		switch (m.data)[(m.p)] {
+		case 82:
+			goto st198
+		case 114:
+			goto st198
+		}
+		goto tr0
+	st198:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof198
+		}
+	stCase198:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st199
+		case 101:
+			goto st199
+		}
+		goto tr0
+	st199:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof199
+		}
+	stCase199:
+
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		switch (m.data)[(m.p)] {
+		case 33:
+			goto tr296
+		case 40:
+			goto st220
+		case 58:
+			goto st201
+		}
+		goto tr21
+	tr296:
+
+		output.exclamation = true
+		if m.logger != nil {
+			m.logger.Info("commit message communicates a breaking change")
+		}
+		if m.listener != nil {
+			m.listener.OnBreaking(conventionalcommits.Span{Start: m.p, End: m.p + 1})
+		}
+
+		goto st200
+	st200:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof200
+		}
+	stCase200:
+		if (m.data)[(m.p)] == 58 {
+			goto st201
+		}
+		goto tr21
+	st201:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof201
+		}
+	stCase201:
+		if (m.data)[(m.p)] == 32 {
+			goto st202
+		}
+		goto tr25
+	st202:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof202
+		}
+	stCase202:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 11:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 9 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 9 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 31:
+				switch {
+				case (m.data)[(m.p)] < 33:
+					if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3872:
+			goto st211
+		case 4064:
+			goto tr303
+		case 4077:
+			goto tr305
+		case 4080:
+			goto tr306
+		case 4084:
+			goto tr308
+		case 4320:
+			goto tr311
+		case 4333:
+			goto tr313
+		case 4336:
+			goto tr314
+		case 4340:
+			goto tr316
+		}
+		switch {
+		case _widec < 4096:
+			switch {
+			case _widec < 4034:
+				switch {
+				case _widec > 3849:
+					if 3851 <= _widec && _widec <= 3967 {
+						goto tr300
+					}
+				case _widec >= 3840:
+					goto tr300
+				}
+			case _widec > 4063:
+				switch {
+				case _widec > 4079:
+					if 4081 <= _widec && _widec <= 4083 {
+						goto tr307
+					}
+				case _widec >= 4065:
+					goto tr304
+				}
+			default:
+				goto tr302
+			}
+		case _widec > 4104:
+			switch {
+			case _widec < 4290:
+				switch {
+				case _widec > 4127:
+					if 4129 <= _widec && _widec <= 4223 {
+						goto tr309
+					}
+				case _widec >= 4107:
+					goto tr309
+				}
+			case _widec > 4319:
+				switch {
+				case _widec > 4335:
+					if 4337 <= _widec && _widec <= 4339 {
+						goto tr315
+					}
+				case _widec >= 4321:
+					goto tr312
+				}
+			default:
+				goto tr310
+			}
+		default:
+			goto tr309
+		}
+		goto tr27
+	tr300:
+
+		m.pb = m.p
+
+		goto st667
+	st667:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof667
+		}
+	stCase667:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 225:
+			switch {
+			case (m.data)[(m.p)] < 11:
+				if (m.data)[(m.p)] <= 9 {
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 127:
+				switch {
+				case (m.data)[(m.p)] > 223:
+					if 224 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 224 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 194:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 236:
+			switch {
+			case (m.data)[(m.p)] < 240:
+				switch {
+				case (m.data)[(m.p)] > 237:
+					if 238 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 239 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 237:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 240:
+				switch {
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 241:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 10:
+			goto tr953
+		case 4064:
+			goto st205
+		case 4077:
+			goto st207
+		case 4080:
+			goto st208
+		case 4084:
+			goto st210
+		}
+		switch {
+		case _widec < 4034:
+			switch {
+			case _widec > 3849:
+				if 3851 <= _widec && _widec <= 3967 {
+					goto st667
+				}
+			case _widec >= 3840:
+				goto st667
+			}
+		case _widec > 4063:
+			switch {
+			case _widec > 4079:
+				if 4081 <= _widec && _widec <= 4083 {
+					goto st209
+				}
+			case _widec >= 4065:
+				goto st206
+			}
+		default:
+			goto st204
+		}
+		goto tr27
+	tr953:
+
+		output.descr = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message description", "description", output.descr)
+		}
+		if m.listener != nil {
+			m.listener.OnDescription(output.descr, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		if m.err = m.checkHeader(); m.err != nil {
+			if m.recovery {
+				// Record the error and continue, the header is well-formed anyway
+				m.recover()
+			} else {
+				{
+					(m.p)++
+					m.cs = 203
+					goto _out
+				}
+			}
+		}
+
+		goto st203
+	st203:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof203
+		}
+	stCase203:
+		if (m.data)[(m.p)] == 10 {
+			goto tr317
+		}
+		goto tr45
+	tr317:
+
+		if m.logger != nil {
+			m.logger.Debug("found a blank line", "pos", m.p)
+		}
+
+		// Mark the next character so that rewinds can not go back before it
+		// Eg., when it is not a possible trailer token start
+		m.pb = m.p + 1
+		if m.bodyStart < 0 {
+			// The first time is right after the header
+			m.bodyStart = m.pb
+		}
+		if m.gitTrailers {
+			// The footer is the trailer block git finds, already cut off from the input
+			{
+				goto st660
+			}
+		}
+		if m.logger != nil {
+			m.logger.Debug("try to parse a footer trailer token", "pos", m.p)
+		}
+		{
+			goto st654
+		}
+
+		goto st668
+	st668:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof668
+		}
+	stCase668:
+		goto st0
+	tr302:
+
+		m.pb = m.p
+
+		goto st204
+	st204:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof204
+		}
+	stCase204:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st667
+		}
+		goto tr27
+	tr303:
+
+		m.pb = m.p
+
+		goto st205
+	st205:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof205
+		}
+	stCase205:
+		_widec = int16((m.data)[(m.p)])
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4000 <= _widec && _widec <= 4031 {
+			goto st204
+		}
+		goto tr27
+	tr304:
+
+		m.pb = m.p
+
+		goto st206
+	st206:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof206
+		}
+	stCase206:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st204
+		}
+		goto tr27
+	tr305:
+
+		m.pb = m.p
+
+		goto st207
+	st207:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof207
+		}
+	stCase207:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 3999 {
+			goto st204
+		}
+		goto tr27
+	tr306:
+
+		m.pb = m.p
+
+		goto st208
+	st208:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof208
+		}
+	stCase208:
+		_widec = int16((m.data)[(m.p)])
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3984 <= _widec && _widec <= 4031 {
+			goto st206
+		}
+		goto tr27
+	tr307:
+
+		m.pb = m.p
+
+		goto st209
+	st209:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof209
+		}
+	stCase209:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st206
+		}
+		goto tr27
+	tr308:
+
+		m.pb = m.p
+
+		goto st210
+	st210:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof210
+		}
+	stCase210:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 3983 {
+			goto st206
+		}
+		goto tr27
+	st211:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof211
+		}
+	stCase211:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 9:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3872:
+			goto st211
+		case 4064:
+			goto tr303
+		case 4077:
+			goto tr305
+		case 4080:
+			goto tr306
+		case 4084:
+			goto tr308
+		}
+		switch {
+		case _widec < 4034:
+			switch {
+			case _widec > 3849:
+				if 3851 <= _widec && _widec <= 3967 {
+					goto tr300
+				}
+			case _widec >= 3840:
+				goto tr300
+			}
+		case _widec > 4063:
+			switch {
+			case _widec > 4079:
+				if 4081 <= _widec && _widec <= 4083 {
+					goto tr307
+				}
+			case _widec >= 4065:
+				goto tr304
+			}
+		default:
+			goto tr302
+		}
+		goto tr27
+	tr309:
+
+		m.pb = m.p
+
+		goto st669
+	st669:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof669
+		}
+	stCase669:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 10:
+			goto tr953
+		case 4128:
+			goto st212
+		case 4320:
+			goto st214
+		case 4333:
+			goto st216
+		case 4336:
+			goto st217
+		case 4340:
+			goto st219
+		}
+		switch {
+		case _widec < 4290:
+			switch {
+			case _widec > 4104:
+				if 4107 <= _widec && _widec <= 4223 {
+					goto st669
+				}
+			case _widec >= 4096:
+				goto st669
+			}
+		case _widec > 4319:
+			switch {
+			case _widec > 4335:
+				if 4337 <= _widec && _widec <= 4339 {
+					goto st218
+				}
+			case _widec >= 4321:
+				goto st215
+			}
+		default:
+			goto st213
+		}
+		goto tr50
+	st212:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof212
+		}
+	stCase212:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 4128:
+			goto st212
+		case 4320:
+			goto st214
+		case 4333:
+			goto st216
+		case 4336:
+			goto st217
+		case 4340:
+			goto st219
+		}
+		switch {
+		case _widec < 4290:
+			switch {
+			case _widec > 4104:
+				if 4107 <= _widec && _widec <= 4223 {
+					goto st669
+				}
+			case _widec >= 4096:
+				goto st669
+			}
+		case _widec > 4319:
+			switch {
+			case _widec > 4335:
+				if 4337 <= _widec && _widec <= 4339 {
+					goto st218
+				}
+			case _widec >= 4321:
+				goto st215
+			}
+		default:
+			goto st213
+		}
+		goto tr50
+	tr310:
+
+		m.pb = m.p
+
+		goto st213
+	st213:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof213
+		}
+	stCase213:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st669
+		}
+		goto tr50
+	tr311:
+
+		m.pb = m.p
+
+		goto st214
+	st214:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof214
+		}
+	stCase214:
+		_widec = int16((m.data)[(m.p)])
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4256 <= _widec && _widec <= 4287 {
+			goto st213
+		}
+		goto tr50
+	tr312:
+
+		m.pb = m.p
+
+		goto st215
+	st215:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof215
+		}
+	stCase215:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st213
+		}
+		goto tr50
+	tr313:
+
+		m.pb = m.p
+
+		goto st216
+	st216:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof216
+		}
+	stCase216:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4255 {
+			goto st213
+		}
+		goto tr50
+	tr314:
+
+		m.pb = m.p
+
+		goto st217
+	st217:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof217
+		}
+	stCase217:
+		_widec = int16((m.data)[(m.p)])
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4240 <= _widec && _widec <= 4287 {
+			goto st215
+		}
+		goto tr50
+	tr315:
+
+		m.pb = m.p
+
+		goto st218
+	st218:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof218
+		}
+	stCase218:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st215
+		}
+		goto tr50
+	tr316:
+
+		m.pb = m.p
+
+		goto st219
+	st219:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof219
+		}
+	stCase219:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4239 {
+			goto st215
+		}
+		goto tr50
+	st220:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof220
+		}
+	stCase220:
+		switch (m.data)[(m.p)] {
+		case 41:
+			goto tr331
+		case 224:
+			goto tr333
+		case 237:
+			goto tr335
+		case 240:
+			goto tr336
+		case 244:
+			goto tr338
+		}
+		switch {
+		case (m.data)[(m.p)] < 194:
+			switch {
+			case (m.data)[(m.p)] > 39:
+				if 42 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+					goto tr330
+				}
+			case (m.data)[(m.p)] >= 32:
+				goto tr330
+			}
+		case (m.data)[(m.p)] > 223:
+			switch {
+			case (m.data)[(m.p)] > 239:
+				if 241 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 243 {
+					goto tr337
+				}
+			case (m.data)[(m.p)] >= 225:
+				goto tr334
+			}
+		default:
+			goto tr332
+		}
+		goto tr60
+	tr330:
+
+		m.pb = m.p
+
+		goto st221
+	st221:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof221
+		}
+	stCase221:
+		switch (m.data)[(m.p)] {
+		case 41:
+			goto tr340
+		case 224:
+			goto st224
+		case 237:
+			goto st226
+		case 240:
+			goto st227
+		case 244:
+			goto st229
+		}
+		switch {
+		case (m.data)[(m.p)] < 194:
+			switch {
+			case (m.data)[(m.p)] > 39:
+				if 42 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+					goto st221
+				}
+			case (m.data)[(m.p)] >= 32:
+				goto st221
+			}
+		case (m.data)[(m.p)] > 223:
+			switch {
+			case (m.data)[(m.p)] > 239:
+				if 241 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 243 {
+					goto st228
+				}
+			case (m.data)[(m.p)] >= 225:
+				goto st225
+			}
+		default:
+			goto st223
+		}
+		goto tr60
+	tr331:
+
+		m.pb = m.p
+
+		output.scope = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message scope", "scope", output.scope)
+		}
+		if m.listener != nil {
+			m.listener.OnScope(output.scope, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		goto st222
+	tr340:
+
+		output.scope = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message scope", "scope", output.scope)
+		}
+		if m.listener != nil {
+			m.listener.OnScope(output.scope, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		goto st222
+	st222:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof222
+		}
+	stCase222:
+		switch (m.data)[(m.p)] {
+		case 33:
+			goto tr296
+		case 58:
+			goto st201
+		}
+		goto tr21
+	tr332:
+
+		m.pb = m.p
+
+		goto st223
+	st223:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof223
+		}
+	stCase223:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st221
+		}
+		goto tr60
+	tr333:
+
+		m.pb = m.p
+
+		goto st224
+	st224:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof224
+		}
+	stCase224:
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st223
+		}
+		goto tr60
+	tr334:
+
+		m.pb = m.p
+
+		goto st225
+	st225:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof225
+		}
+	stCase225:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st223
+		}
+		goto tr60
+	tr335:
+
+		m.pb = m.p
+
+		goto st226
+	st226:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof226
+		}
+	stCase226:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			goto st223
+		}
+		goto tr60
+	tr336:
+
+		m.pb = m.p
+
+		goto st227
+	st227:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof227
+		}
+	stCase227:
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st225
+		}
+		goto tr60
+	tr337:
+
+		m.pb = m.p
+
+		goto st228
+	st228:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof228
+		}
+	stCase228:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st225
+		}
+		goto tr60
+	tr338:
+
+		m.pb = m.p
+
+		goto st229
+	st229:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof229
+		}
+	stCase229:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			goto st225
+		}
+		goto tr60
+	tr276:
+
+		m.pb = m.p
+
+		goto st230
+	tr362:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st230
+	st230:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof230
+		}
+	stCase230:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st231
+		case 101:
+			goto st231
+		}
+		goto tr0
+	st231:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof231
+		}
+	stCase231:
+		switch (m.data)[(m.p)] {
+		case 87:
+			goto st199
+		case 119:
+			goto st199
+		}
+		goto tr0
+	tr277:
+
+		m.pb = m.p
+
+		goto st232
+	tr363:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st232
+	st232:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof232
+		}
+	stCase232:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st233
+		case 101:
+			goto st233
+		}
+		goto tr0
+	st233:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof233
+		}
+	stCase233:
+		switch (m.data)[(m.p)] {
+		case 82:
+			goto st234
+		case 114:
+			goto st234
+		}
+		goto tr0
+	st234:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof234
+		}
+	stCase234:
+		switch (m.data)[(m.p)] {
+		case 70:
+			goto st199
+		case 102:
+			goto st199
+		}
+		goto tr0
+	tr278:
+
+		m.pb = m.p
+
+		goto st235
+	tr364:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st235
+	st235:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof235
+		}
+	stCase235:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st236
+		case 85:
+			goto st240
+		case 101:
+			goto st236
+		case 117:
+			goto st240
+		}
+		goto tr0
+	st236:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof236
+		}
+	stCase236:
+		switch (m.data)[(m.p)] {
+		case 86:
+			goto st237
+		case 118:
+			goto st237
+		}
+		goto tr0
+	st237:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof237
+		}
+	stCase237:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st238
+		case 101:
+			goto st238
+		}
+		goto tr0
+	st238:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof238
+		}
+	stCase238:
+		switch (m.data)[(m.p)] {
+		case 82:
+			goto st239
+		case 114:
+			goto st239
+		}
+		goto tr0
+	st239:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof239
+		}
+	stCase239:
+		switch (m.data)[(m.p)] {
+		case 84:
+			goto st199
+		case 116:
+			goto st199
+		}
+		goto tr0
+	st240:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof240
+		}
+	stCase240:
+		switch (m.data)[(m.p)] {
+		case 76:
+			goto st198
+		case 108:
+			goto st198
+		}
+		goto tr0
+	tr279:
+
+		m.pb = m.p
+
+		goto st241
+	tr365:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st241
+	st241:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof241
+		}
+	stCase241:
+		switch (m.data)[(m.p)] {
+		case 80:
+			goto st242
+		case 112:
+			goto st242
+		}
+		goto tr0
+	st242:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof242
+		}
+	stCase242:
+		switch (m.data)[(m.p)] {
+		case 68:
+			goto st243
+		case 100:
+			goto st243
+		}
+		goto tr0
+	st243:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof243
+		}
+	stCase243:
+		switch (m.data)[(m.p)] {
+		case 65:
+			goto st244
+		case 97:
+			goto st244
+		}
+		goto tr0
+	st244:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof244
+		}
+	stCase244:
+		switch (m.data)[(m.p)] {
+		case 84:
+			goto st198
+		case 116:
+			goto st198
+		}
+		goto tr0
+	tr280:
+
+		m.pb = m.p
+
+		goto st245
+	st245:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof245
+		}
+	stCase245:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 48:
+			switch {
+			case (m.data)[(m.p)] > 43:
+				if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 43:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 57:
+			switch {
+			case (m.data)[(m.p)] > 95:
+				if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 95:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3115:
+			goto st246
+		case 3117:
+			goto st246
+		case 3167:
+			goto st246
+		}
+		switch {
+		case _widec > 3129:
+			if 3169 <= _widec && _widec <= 3194 {
+				goto st246
+			}
+		case _widec >= 3120:
+			goto st246
+		}
+		goto tr8
+	st246:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof246
+		}
+	stCase246:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 48:
+			switch {
+			case (m.data)[(m.p)] > 43:
+				if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 43:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 57:
+			switch {
+			case (m.data)[(m.p)] < 95:
+				if 58 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 58 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 95:
+				if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3115:
+			goto st246
+		case 3117:
+			goto st246
+		case 3130:
+			goto st247
+		case 3167:
+			goto st246
+		}
+		switch {
+		case _widec > 3129:
+			if 3169 <= _widec && _widec <= 3194 {
+				goto st246
+			}
+		case _widec >= 3120:
+			goto st246
+		}
+		goto tr8
+	st247:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof247
+		}
+	stCase247:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 84:
+			switch {
+			case (m.data)[(m.p)] < 68:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 66 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 66 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 68:
+				switch {
+				case (m.data)[(m.p)] > 70:
+					if 72 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 72 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 70:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 84:
+			switch {
+			case (m.data)[(m.p)] < 102:
+				switch {
+				case (m.data)[(m.p)] > 98:
+					if 100 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 100 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 98:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 102:
+				switch {
+				case (m.data)[(m.p)] > 104:
+					if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 104:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr361
+		case 78:
+			goto tr362
+		case 80:
+			goto tr363
+		case 82:
+			goto tr364
+		case 85:
+			goto tr365
+		case 99:
+			goto tr361
+		case 110:
+			goto tr362
+		case 112:
+			goto tr363
+		case 114:
+			goto tr364
+		case 117:
+			goto tr365
+		case 3104:
+			goto tr366
+		case 3394:
+			goto tr367
+		case 3396:
+			goto tr368
+		case 3398:
+			goto tr369
+		case 3412:
+			goto tr370
+		case 3426:
+			goto tr367
+		case 3428:
+			goto tr368
+		case 3430:
+			goto tr369
+		case 3444:
+			goto tr370
+		case 3650:
+			goto tr371
+		case 3652:
+			goto tr372
+		case 3654:
+			goto tr373
+		case 3656:
+			goto tr374
+		case 3668:
+			goto tr375
+		case 3682:
+			goto tr371
+		case 3684:
+			goto tr372
+		case 3686:
+			goto tr373
+		case 3688:
+			goto tr374
+		case 3700:
+			goto tr375
+		}
+		goto tr0
+	tr366:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		goto st248
+	st248:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof248
+		}
+	stCase248:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 84:
+			switch {
+			case (m.data)[(m.p)] < 68:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 66 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 66 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 68:
+				switch {
+				case (m.data)[(m.p)] > 70:
+					if 72 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 72 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 70:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 84:
+			switch {
+			case (m.data)[(m.p)] < 102:
+				switch {
+				case (m.data)[(m.p)] > 98:
+					if 100 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 100 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 98:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 102:
+				switch {
+				case (m.data)[(m.p)] > 104:
+					if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 104:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr275
+		case 78:
+			goto tr276
+		case 80:
+			goto tr277
+		case 82:
+			goto tr278
+		case 85:
+			goto tr279
+		case 99:
+			goto tr275
+		case 110:
+			goto tr276
+		case 112:
+			goto tr277
+		case 114:
+			goto tr278
+		case 117:
+			goto tr279
+		case 3104:
+			goto st248
+		case 3394:
+			goto tr283
+		case 3396:
+			goto tr284
+		case 3398:
+			goto tr285
+		case 3412:
+			goto tr286
+		case 3426:
+			goto tr283
+		case 3428:
+			goto tr284
+		case 3430:
+			goto tr285
+		case 3444:
+			goto tr286
+		case 3650:
+			goto tr287
+		case 3652:
+			goto tr288
+		case 3654:
+			goto tr289
+		case 3656:
+			goto tr290
+		case 3668:
+			goto tr291
+		case 3682:
+			goto tr287
+		case 3684:
+			goto tr288
+		case 3686:
+			goto tr289
+		case 3688:
+			goto tr290
+		case 3700:
+			goto tr291
+		}
+		goto tr0
+	tr283:
+
+		m.pb = m.p
+
+		goto st249
+	tr367:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st249
+	st249:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof249
+		}
+	stCase249:
+		switch (m.data)[(m.p)] {
+		case 85:
+			goto st250
+		case 117:
+			goto st250
+		}
+		goto tr0
+	st250:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof250
+		}
+	stCase250:
+		switch (m.data)[(m.p)] {
+		case 73:
+			goto st251
+		case 105:
+			goto st251
+		}
+		goto tr0
+	st251:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof251
+		}
+	stCase251:
+		switch (m.data)[(m.p)] {
+		case 76:
+			goto st252
+		case 108:
+			goto st252
+		}
+		goto tr0
+	st252:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof252
+		}
+	stCase252:
+		switch (m.data)[(m.p)] {
+		case 68:
+			goto st199
+		case 100:
+			goto st199
+		}
+		goto tr0
+	tr284:
+
+		m.pb = m.p
+
+		goto st253
+	tr368:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st253
+	st253:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof253
+		}
+	stCase253:
+		switch (m.data)[(m.p)] {
+		case 79:
+			goto st254
+		case 111:
+			goto st254
+		}
+		goto tr0
+	st254:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof254
+		}
+	stCase254:
+		switch (m.data)[(m.p)] {
+		case 67:
+			goto st255
+		case 99:
+			goto st255
+		}
+		goto tr0
+	st255:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof255
+		}
+	stCase255:
+		switch (m.data)[(m.p)] {
+		case 83:
+			goto st199
+		case 115:
+			goto st199
+		}
+		goto tr0
+	tr285:
+
+		m.pb = m.p
+
+		goto st256
+	tr369:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st256
+	st256:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof256
+		}
+	stCase256:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st257
+		case 73:
+			goto st258
+		case 101:
+			goto st257
+		case 105:
+			goto st258
+		}
+		goto tr0
+	st257:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof257
+		}
+	stCase257:
+		switch (m.data)[(m.p)] {
+		case 65:
+			goto st239
+		case 97:
+			goto st239
+		}
+		goto tr0
+	st258:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof258
+		}
+	stCase258:
+		switch (m.data)[(m.p)] {
+		case 88:
+			goto st199
+		case 120:
+			goto st199
+		}
+		goto tr0
+	tr286:
+
+		m.pb = m.p
+
+		goto st259
+	tr370:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st259
+	st259:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof259
+		}
+	stCase259:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st260
+		case 101:
+			goto st260
+		}
+		goto tr0
+	st260:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof260
+		}
+	stCase260:
+		switch (m.data)[(m.p)] {
+		case 83:
+			goto st239
+		case 115:
+			goto st239
+		}
+		goto tr0
+	tr287:
+
+		m.pb = m.p
+
+		goto st261
+	tr371:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st261
+	st261:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof261
+		}
+	stCase261:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 85:
+			if 117 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 117 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 85:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3413:
+			goto st250
+		case 3445:
+			goto st250
+		case 3669:
+			goto st262
+		case 3701:
+			goto st262
+		}
+		goto tr0
+	st262:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof262
+		}
+	stCase262:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 71:
+			if 103 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 103 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 71:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 73:
+			goto st251
+		case 105:
+			goto st251
+		case 3655:
+			goto st263
+		case 3687:
+			goto st263
+		}
+		goto tr0
+	st263:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof263
+		}
+	stCase263:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 70:
+			if 102 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 102 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 70:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3654:
+			goto st264
+		case 3686:
+			goto st264
+		}
+		goto tr0
+	st264:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof264
+		}
+	stCase264:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 73:
+			if 105 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 105 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 73:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3657:
+			goto st265
+		case 3689:
+			goto st265
+		}
+		goto tr0
+	st265:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof265
+		}
+	stCase265:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 88:
+			if 120 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 120 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 88:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3672:
+			goto st199
+		case 3704:
+			goto st199
+		}
+		goto tr0
+	tr288:
+
+		m.pb = m.p
+
+		goto st266
+	tr372:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st266
+	st266:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof266
+		}
+	stCase266:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 79:
+			if 111 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 111 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 79:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3407:
+			goto st254
+		case 3439:
+			goto st254
+		case 3663:
+			goto st267
+		case 3695:
+			goto st267
+		}
+		goto tr0
+	st267:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof267
+		}
+	stCase267:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 67:
+			if 99 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 99 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 67:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3395:
+			goto st255
+		case 3427:
+			goto st255
+		case 3651:
+			goto st268
+		case 3683:
+			goto st268
+		}
+		goto tr0
+	st268:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof268
+		}
+	stCase268:
+
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		switch (m.data)[(m.p)] {
+		case 33:
+			goto tr296
+		case 40:
+			goto st220
+		case 58:
+			goto st201
+		case 83:
+			goto st199
+		case 115:
+			goto st199
+		}
+		goto tr21
+	tr289:
+
+		m.pb = m.p
+
+		goto st269
+	tr373:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st269
+	st269:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof269
+		}
+	stCase269:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 69:
+			if 101 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 101 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 69:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 73:
+			goto st258
+		case 105:
+			goto st258
+		case 3397:
+			goto st257
+		case 3429:
+			goto st257
+		case 3653:
+			goto st270
+		case 3685:
+			goto st270
+		}
+		goto tr0
+	st270:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof270
+		}
+	stCase270:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 65:
+			if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 97 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 65:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3393:
+			goto st239
+		case 3425:
+			goto st239
+		case 3649:
+			goto st271
+		case 3681:
+			goto st271
+		}
+		goto tr0
+	st271:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof271
+		}
+	stCase271:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 84:
+			if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 84:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3412:
+			goto st199
+		case 3444:
+			goto st199
+		case 3668:
+			goto st272
+		case 3700:
+			goto st272
+		}
+		goto tr0
+	st272:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof272
+		}
+	stCase272:
+
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 85:
+			if 117 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 117 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 85:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 33:
+			goto tr296
+		case 40:
+			goto st220
+		case 58:
+			goto st201
+		case 3669:
+			goto st273
+		case 3701:
+			goto st273
+		}
+		goto tr21
+	st273:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof273
+		}
+	stCase273:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 82:
+			if 114 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 114 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 82:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3666:
+			goto st274
+		case 3698:
+			goto st274
+		}
+		goto tr0
+	st274:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof274
+		}
+	stCase274:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 69:
+			if 101 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 101 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 69:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3653:
+			goto st199
+		case 3685:
+			goto st199
+		}
+		goto tr0
+	tr290:
+
+		m.pb = m.p
+
+		goto st275
+	tr374:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st275
+	st275:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof275
+		}
+	stCase275:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 79:
+			if 111 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 111 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 79:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3663:
+			goto st276
+		case 3695:
+			goto st276
+		}
+		goto tr0
+	st276:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof276
+		}
+	stCase276:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 84:
+			if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 84:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3668:
+			goto st263
+		case 3700:
+			goto st263
+		}
+		goto tr0
+	tr291:
+
+		m.pb = m.p
+
+		goto st277
+	tr375:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st277
+	st277:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof277
+		}
+	stCase277:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 69:
+			if 101 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 101 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 69:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3397:
+			goto st260
+		case 3429:
+			goto st260
+		case 3653:
+			goto st278
+		case 3685:
+			goto st278
+		}
+		goto tr0
+	st278:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof278
+		}
+	stCase278:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 83:
+			if 115 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 115 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 83:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3411:
+			goto st239
+		case 3443:
+			goto st239
+		case 3667:
+			goto st279
+		case 3699:
+			goto st279
+		}
+		goto tr0
+	st279:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof279
+		}
+	stCase279:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 84:
+			if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 84:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3412:
+			goto st199
+		case 3444:
+			goto st199
+		case 3668:
+			goto st280
+		case 3700:
+			goto st280
+		}
+		goto tr0
+	st280:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof280
+		}
+	stCase280:
+
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 83:
+			if 115 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 115 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 83:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 33:
+			goto tr296
+		case 40:
+			goto st220
+		case 58:
+			goto st201
+		case 3667:
+			goto st199
+		case 3699:
+			goto st199
+		}
+		goto tr21
+	tr281:
+
+		m.pb = m.p
+
+		goto st281
+	st281:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof281
+		}
+	stCase281:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 152:
+			if 140 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 158:
+			if 172 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 175 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch {
+		case _widec < 3224:
+			if 3212 <= _widec && _widec <= 3215 {
+				goto st282
+			}
+		case _widec > 3230:
+			if 3244 <= _widec && _widec <= 3247 {
+				goto st282
+			}
+		default:
+			goto st282
+		}
+		goto tr8
+	st282:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof282
+		}
+	stCase282:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if 3200 <= _widec && _widec <= 3263 {
+			goto st283
+		}
+		goto tr8
+	st283:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof283
+		}
+	stCase283:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 98:
+			switch {
+			case (m.data)[(m.p)] < 68:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 66 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 66 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 68:
+				switch {
+				case (m.data)[(m.p)] < 72:
+					if 70 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 70 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 72:
+					if 84 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 84 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 98:
+			switch {
+			case (m.data)[(m.p)] < 104:
+				switch {
+				case (m.data)[(m.p)] > 100:
+					if 102 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 102 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 100:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 104:
+				switch {
+				case (m.data)[(m.p)] < 226:
+					if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 226:
+					if 239 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 239 {
+						_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+						if m.gitmoji {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr361
+		case 78:
+			goto tr362
+		case 80:
+			goto tr363
+		case 82:
+			goto tr364
+		case 85:
+			goto tr365
+		case 99:
+			goto tr361
+		case 110:
+			goto tr362
+		case 112:
+			goto tr363
+		case 114:
+			goto tr364
+		case 117:
+			goto tr365
+		case 3104:
+			goto tr366
+		case 3298:
+			goto st284
+		case 3311:
+			goto st289
+		case 3394:
+			goto tr367
+		case 3396:
+			goto tr368
+		case 3398:
+			goto tr369
+		case 3412:
+			goto tr370
+		case 3426:
+			goto tr367
+		case 3428:
+			goto tr368
+		case 3430:
+			goto tr369
+		case 3444:
+			goto tr370
+		case 3650:
+			goto tr371
+		case 3652:
+			goto tr372
+		case 3654:
+			goto tr373
+		case 3656:
+			goto tr374
+		case 3668:
+			goto tr375
+		case 3682:
+			goto tr371
+		case 3684:
+			goto tr372
+		case 3686:
+			goto tr373
+		case 3688:
+			goto tr374
+		case 3700:
+			goto tr375
+		}
+		goto tr0
+	st284:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof284
+		}
+	stCase284:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 128 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3200 {
+			goto st285
+		}
+		goto tr8
+	st285:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof285
+		}
+	stCase285:
+		_widec = int16((m.data)[(m.p)])
+		if 141 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 141 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3213 {
+			goto st286
+		}
+		goto tr8
+	st286:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof286
+		}
+	stCase286:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 226:
+			if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 226:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3298:
+			goto st281
+		case 3312:
+			goto st287
+		}
+		goto tr8
+	tr282:
+
+		m.pb = m.p
+
+		goto st287
+	st287:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof287
+		}
+	stCase287:
+		_widec = int16((m.data)[(m.p)])
+		if 159 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3231 {
+			goto st288
+		}
+		goto tr8
+	st288:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof288
+		}
+	stCase288:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 171 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if 3200 <= _widec && _widec <= 3243 {
+			goto st282
+		}
+		goto tr8
+	st289:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof289
+		}
+	stCase289:
+		_widec = int16((m.data)[(m.p)])
+		if 184 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 184 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3256 {
+			goto st290
+		}
+		goto tr8
+	st290:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof290
+		}
+	stCase290:
+		_widec = int16((m.data)[(m.p)])
+		if 143 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3215 {
+			goto st291
+		}
+		goto tr8
+	st291:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof291
+		}
+	stCase291:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 84:
+			switch {
+			case (m.data)[(m.p)] < 68:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 66 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 66 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 68:
+				switch {
+				case (m.data)[(m.p)] > 70:
+					if 72 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 72 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 70:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 84:
+			switch {
+			case (m.data)[(m.p)] < 102:
+				switch {
+				case (m.data)[(m.p)] > 98:
+					if 100 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 100 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 98:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 102:
+				switch {
+				case (m.data)[(m.p)] < 116:
+					if 104 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 104 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 116:
+					if 226 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 226 {
+						_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+						if m.gitmoji {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr361
+		case 78:
+			goto tr362
+		case 80:
+			goto tr363
+		case 82:
+			goto tr364
+		case 85:
+			goto tr365
+		case 99:
+			goto tr361
+		case 110:
+			goto tr362
+		case 112:
+			goto tr363
+		case 114:
+			goto tr364
+		case 117:
+			goto tr365
+		case 3104:
+			goto tr366
+		case 3298:
+			goto st284
+		case 3394:
+			goto tr367
+		case 3396:
+			goto tr368
+		case 3398:
+			goto tr369
+		case 3412:
+			goto tr370
+		case 3426:
+			goto tr367
+		case 3428:
+			goto tr368
+		case 3430:
+			goto tr369
+		case 3444:
+			goto tr370
+		case 3650:
+			goto tr371
+		case 3652:
+			goto tr372
+		case 3654:
+			goto tr373
+		case 3656:
+			goto tr374
+		case 3668:
+			goto tr375
+		case 3682:
+			goto tr371
+		case 3684:
+			goto tr372
+		case 3686:
+			goto tr373
+		case 3688:
+			goto tr374
+		case 3700:
+			goto tr375
+		}
+		goto tr0
+	stCase292:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 98:
+			switch {
+			case (m.data)[(m.p)] < 68:
+				switch {
+				case (m.data)[(m.p)] > 58:
+					if 66 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 66 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 58:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 68:
+				switch {
+				case (m.data)[(m.p)] < 72:
+					if 70 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 70 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 72:
+					if 84 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 84 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 98:
+			switch {
+			case (m.data)[(m.p)] < 104:
+				switch {
+				case (m.data)[(m.p)] > 100:
+					if 102 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 102 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 100:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 104:
+				switch {
+				case (m.data)[(m.p)] < 226:
+					if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 226:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+						if m.gitmoji {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr411
+		case 80:
+			goto tr412
+		case 82:
+			goto tr413
+		case 99:
+			goto tr411
+		case 112:
+			goto tr412
+		case 114:
+			goto tr413
+		case 3130:
+			goto tr414
+		case 3298:
+			goto tr415
+		case 3312:
+			goto tr416
+		case 3394:
+			goto tr417
+		case 3396:
+			goto tr418
+		case 3398:
+			goto tr419
+		case 3412:
+			goto tr420
+		case 3426:
+			goto tr417
+		case 3428:
+			goto tr418
+		case 3430:
+			goto tr419
+		case 3444:
+			goto tr420
+		case 3650:
+			goto tr421
+		case 3652:
+			goto tr422
+		case 3654:
+			goto tr423
+		case 3656:
+			goto tr424
+		case 3668:
+			goto tr425
+		case 3682:
+			goto tr421
+		case 3684:
+			goto tr422
+		case 3686:
+			goto tr423
+		case 3688:
+			goto tr424
+		case 3700:
+			goto tr425
+		}
+		goto tr0
+	tr411:
+
+		m.pb = m.p
+
+		goto st293
+	tr474:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st293
+	st293:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof293
+		}
+	stCase293:
+		switch (m.data)[(m.p)] {
+		case 73:
+			goto st294
+		case 105:
+			goto st294
+		}
+		goto tr0
+	st294:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof294
+		}
+	stCase294:
+
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		switch (m.data)[(m.p)] {
+		case 33:
+			goto tr427
+		case 40:
+			goto st315
+		case 58:
+			goto st296
+		}
+		goto tr21
+	tr427:
+
+		output.exclamation = true
+		if m.logger != nil {
+			m.logger.Info("commit message communicates a breaking change")
+		}
+		if m.listener != nil {
+			m.listener.OnBreaking(conventionalcommits.Span{Start: m.p, End: m.p + 1})
+		}
+
+		goto st295
+	st295:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof295
+		}
+	stCase295:
+		if (m.data)[(m.p)] == 58 {
+			goto st296
+		}
+		goto tr21
+	st296:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof296
+		}
+	stCase296:
+		if (m.data)[(m.p)] == 32 {
+			goto st297
+		}
+		goto tr25
+	st297:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof297
+		}
+	stCase297:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 11:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 9 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 9 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 31:
+				switch {
+				case (m.data)[(m.p)] < 33:
+					if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3872:
+			goto st306
+		case 4064:
+			goto tr434
+		case 4077:
+			goto tr436
+		case 4080:
+			goto tr437
+		case 4084:
+			goto tr439
+		case 4320:
+			goto tr442
+		case 4333:
+			goto tr444
+		case 4336:
+			goto tr445
+		case 4340:
+			goto tr447
+		}
+		switch {
+		case _widec < 4096:
+			switch {
+			case _widec < 4034:
+				switch {
+				case _widec > 3849:
+					if 3851 <= _widec && _widec <= 3967 {
+						goto tr431
+					}
+				case _widec >= 3840:
+					goto tr431
+				}
+			case _widec > 4063:
+				switch {
+				case _widec > 4079:
+					if 4081 <= _widec && _widec <= 4083 {
+						goto tr438
+					}
+				case _widec >= 4065:
+					goto tr435
+				}
+			default:
+				goto tr433
+			}
+		case _widec > 4104:
+			switch {
+			case _widec < 4290:
+				switch {
+				case _widec > 4127:
+					if 4129 <= _widec && _widec <= 4223 {
+						goto tr440
+					}
+				case _widec >= 4107:
+					goto tr440
+				}
+			case _widec > 4319:
+				switch {
+				case _widec > 4335:
+					if 4337 <= _widec && _widec <= 4339 {
+						goto tr446
+					}
+				case _widec >= 4321:
+					goto tr443
+				}
+			default:
+				goto tr441
+			}
+		default:
+			goto tr440
+		}
+		goto tr27
+	tr431:
+
+		m.pb = m.p
+
+		goto st670
+	st670:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof670
+		}
+	stCase670:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 225:
+			switch {
+			case (m.data)[(m.p)] < 11:
+				if (m.data)[(m.p)] <= 9 {
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 127:
+				switch {
+				case (m.data)[(m.p)] > 223:
+					if 224 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 224 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 194:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 236:
+			switch {
+			case (m.data)[(m.p)] < 240:
+				switch {
+				case (m.data)[(m.p)] > 237:
+					if 238 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 239 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 237:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 240:
+				switch {
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 241:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 10:
+			goto tr959
+		case 4064:
+			goto st300
+		case 4077:
+			goto st302
+		case 4080:
+			goto st303
+		case 4084:
+			goto st305
+		}
+		switch {
+		case _widec < 4034:
+			switch {
+			case _widec > 3849:
+				if 3851 <= _widec && _widec <= 3967 {
+					goto st670
+				}
+			case _widec >= 3840:
+				goto st670
+			}
+		case _widec > 4063:
+			switch {
+			case _widec > 4079:
+				if 4081 <= _widec && _widec <= 4083 {
+					goto st304
+				}
+			case _widec >= 4065:
+				goto st301
+			}
+		default:
+			goto st299
+		}
+		goto tr27
+	tr959:
+
+		output.descr = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message description", "description", output.descr)
+		}
+		if m.listener != nil {
+			m.listener.OnDescription(output.descr, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		if m.err = m.checkHeader(); m.err != nil {
+			if m.recovery {
+				// Record the error and continue, the header is well-formed anyway
+				m.recover()
+			} else {
+				{
+					(m.p)++
+					m.cs = 298
+					goto _out
+				}
+			}
+		}
+
+		goto st298
+	st298:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof298
+		}
+	stCase298:
+		if (m.data)[(m.p)] == 10 {
+			goto tr448
+		}
+		goto tr45
+	tr448:
+
+		if m.logger != nil {
+			m.logger.Debug("found a blank line", "pos", m.p)
+		}
+
+		// Mark the next character so that rewinds can not go back before it
+		// Eg., when it is not a possible trailer token start
+		m.pb = m.p + 1
+		if m.bodyStart < 0 {
+			// The first time is right after the header
+			m.bodyStart = m.pb
+		}
+		if m.gitTrailers {
+			// The footer is the trailer block git finds, already cut off from the input
+			{
+				goto st660
+			}
+		}
+		if m.logger != nil {
+			m.logger.Debug("try to parse a footer trailer token", "pos", m.p)
+		}
+		{
+			goto st654
+		}
+
+		goto st671
+	st671:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof671
+		}
+	stCase671:
+		goto st0
+	tr433:
+
+		m.pb = m.p
+
+		goto st299
+	st299:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof299
+		}
+	stCase299:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st670
+		}
+		goto tr27
+	tr434:
+
+		m.pb = m.p
+
+		goto st300
+	st300:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof300
+		}
+	stCase300:
+		_widec = int16((m.data)[(m.p)])
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4000 <= _widec && _widec <= 4031 {
+			goto st299
+		}
+		goto tr27
+	tr435:
+
+		m.pb = m.p
+
+		goto st301
+	st301:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof301
+		}
+	stCase301:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st299
+		}
+		goto tr27
+	tr436:
+
+		m.pb = m.p
+
+		goto st302
+	st302:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof302
+		}
+	stCase302:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 3999 {
+			goto st299
+		}
+		goto tr27
+	tr437:
+
+		m.pb = m.p
+
+		goto st303
+	st303:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof303
+		}
+	stCase303:
+		_widec = int16((m.data)[(m.p)])
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3984 <= _widec && _widec <= 4031 {
+			goto st301
+		}
+		goto tr27
+	tr438:
+
+		m.pb = m.p
+
+		goto st304
+	st304:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof304
+		}
+	stCase304:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st301
+		}
+		goto tr27
+	tr439:
+
+		m.pb = m.p
+
+		goto st305
+	st305:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof305
+		}
+	stCase305:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 3983 {
+			goto st301
+		}
+		goto tr27
+	st306:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof306
+		}
+	stCase306:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 9:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3872:
+			goto st306
+		case 4064:
+			goto tr434
+		case 4077:
+			goto tr436
+		case 4080:
+			goto tr437
+		case 4084:
+			goto tr439
+		}
+		switch {
+		case _widec < 4034:
+			switch {
+			case _widec > 3849:
+				if 3851 <= _widec && _widec <= 3967 {
+					goto tr431
+				}
+			case _widec >= 3840:
+				goto tr431
+			}
+		case _widec > 4063:
+			switch {
+			case _widec > 4079:
+				if 4081 <= _widec && _widec <= 4083 {
+					goto tr438
+				}
+			case _widec >= 4065:
+				goto tr435
+			}
+		default:
+			goto tr433
+		}
+		goto tr27
+	tr440:
+
+		m.pb = m.p
+
+		goto st672
+	st672:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof672
+		}
+	stCase672:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 10:
+			goto tr959
+		case 4128:
+			goto st307
+		case 4320:
+			goto st309
+		case 4333:
+			goto st311
+		case 4336:
+			goto st312
+		case 4340:
+			goto st314
+		}
+		switch {
+		case _widec < 4290:
+			switch {
+			case _widec > 4104:
+				if 4107 <= _widec && _widec <= 4223 {
+					goto st672
+				}
+			case _widec >= 4096:
+				goto st672
+			}
+		case _widec > 4319:
+			switch {
+			case _widec > 4335:
+				if 4337 <= _widec && _widec <= 4339 {
+					goto st313
+				}
+			case _widec >= 4321:
+				goto st310
+			}
+		default:
+			goto st308
+		}
+		goto tr50
+	st307:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof307
+		}
+	stCase307:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 4128:
+			goto st307
+		case 4320:
+			goto st309
+		case 4333:
+			goto st311
+		case 4336:
+			goto st312
+		case 4340:
+			goto st314
+		}
+		switch {
+		case _widec < 4290:
+			switch {
+			case _widec > 4104:
+				if 4107 <= _widec && _widec <= 4223 {
+					goto st672
+				}
+			case _widec >= 4096:
+				goto st672
+			}
+		case _widec > 4319:
+			switch {
+			case _widec > 4335:
+				if 4337 <= _widec && _widec <= 4339 {
+					goto st313
+				}
+			case _widec >= 4321:
+				goto st310
+			}
+		default:
+			goto st308
+		}
+		goto tr50
+	tr441:
+
+		m.pb = m.p
+
+		goto st308
+	st308:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof308
+		}
+	stCase308:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st672
+		}
+		goto tr50
+	tr442:
+
+		m.pb = m.p
+
+		goto st309
+	st309:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof309
+		}
+	stCase309:
+		_widec = int16((m.data)[(m.p)])
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4256 <= _widec && _widec <= 4287 {
+			goto st308
+		}
+		goto tr50
+	tr443:
+
+		m.pb = m.p
+
+		goto st310
+	st310:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof310
+		}
+	stCase310:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st308
+		}
+		goto tr50
+	tr444:
+
+		m.pb = m.p
+
+		goto st311
+	st311:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof311
+		}
+	stCase311:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4255 {
+			goto st308
+		}
+		goto tr50
+	tr445:
+
+		m.pb = m.p
+
+		goto st312
+	st312:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof312
+		}
+	stCase312:
+		_widec = int16((m.data)[(m.p)])
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4240 <= _widec && _widec <= 4287 {
+			goto st310
+		}
+		goto tr50
+	tr446:
+
+		m.pb = m.p
+
+		goto st313
+	st313:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof313
+		}
+	stCase313:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st310
+		}
+		goto tr50
+	tr447:
+
+		m.pb = m.p
+
+		goto st314
+	st314:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof314
+		}
+	stCase314:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4239 {
+			goto st310
+		}
+		goto tr50
+	st315:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof315
+		}
+	stCase315:
+		switch {
+		case (m.data)[(m.p)] > 57:
+			if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
+				goto tr461
+			}
+		case (m.data)[(m.p)] >= 48:
+			goto tr461
+		}
+		goto tr60
+	tr461:
+
+		m.pb = m.p
+
+		goto st316
+	st316:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof316
+		}
+	stCase316:
+		switch (m.data)[(m.p)] {
+		case 41:
+			goto tr462
+		case 45:
+			goto st316
+		}
+		switch {
+		case (m.data)[(m.p)] > 57:
+			if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
+				goto st316
+			}
+		case (m.data)[(m.p)] >= 48:
+			goto st316
+		}
+		goto tr60
+	tr462:
+
+		output.scope = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message scope", "scope", output.scope)
+		}
+		if m.listener != nil {
+			m.listener.OnScope(output.scope, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		goto st317
+	st317:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof317
+		}
+	stCase317:
+		switch (m.data)[(m.p)] {
+		case 33:
+			goto tr427
+		case 58:
+			goto st296
+		}
+		goto tr21
+	tr412:
+
+		m.pb = m.p
+
+		goto st318
+	tr475:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st318
+	st318:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof318
+		}
+	stCase318:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st319
+		case 101:
+			goto st319
+		}
+		goto tr0
+	st319:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof319
+		}
+	stCase319:
+		switch (m.data)[(m.p)] {
+		case 82:
+			goto st320
+		case 114:
+			goto st320
+		}
+		goto tr0
+	st320:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof320
+		}
+	stCase320:
+		switch (m.data)[(m.p)] {
+		case 70:
+			goto st294
+		case 102:
+			goto st294
+		}
+		goto tr0
+	tr413:
+
+		m.pb = m.p
+
+		goto st321
+	tr476:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st321
+	st321:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof321
+		}
+	stCase321:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st322
+		case 101:
+			goto st322
+		}
+		goto tr0
+	st322:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof322
+		}
+	stCase322:
+		switch (m.data)[(m.p)] {
+		case 70:
+			goto st323
+		case 102:
+			goto st323
+		}
+		goto tr0
+	st323:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof323
+		}
+	stCase323:
+		switch (m.data)[(m.p)] {
+		case 65:
+			goto st324
+		case 97:
+			goto st324
+		}
+		goto tr0
+	st324:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof324
+		}
+	stCase324:
+		switch (m.data)[(m.p)] {
+		case 67:
+			goto st325
+		case 99:
+			goto st325
+		}
+		goto tr0
+	st325:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof325
+		}
+	stCase325:
+		switch (m.data)[(m.p)] {
+		case 84:
+			goto st326
+		case 116:
+			goto st326
+		}
+		goto tr0
+	st326:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof326
+		}
+	stCase326:
+		switch (m.data)[(m.p)] {
+		case 79:
+			goto st327
+		case 111:
+			goto st327
+		}
+		goto tr0
+	st327:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof327
+		}
+	stCase327:
+		switch (m.data)[(m.p)] {
+		case 82:
+			goto st294
+		case 114:
+			goto st294
+		}
+		goto tr0
+	tr414:
+
+		m.pb = m.p
+
+		goto st328
+	st328:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof328
+		}
+	stCase328:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 48:
+			switch {
+			case (m.data)[(m.p)] > 43:
+				if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 43:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 57:
+			switch {
+			case (m.data)[(m.p)] > 95:
+				if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 95:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3115:
+			goto st329
+		case 3117:
+			goto st329
+		case 3167:
+			goto st329
+		}
+		switch {
+		case _widec > 3129:
+			if 3169 <= _widec && _widec <= 3194 {
+				goto st329
+			}
+		case _widec >= 3120:
+			goto st329
+		}
+		goto tr8
+	st329:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof329
+		}
+	stCase329:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 48:
+			switch {
+			case (m.data)[(m.p)] > 43:
+				if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 43:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 57:
+			switch {
+			case (m.data)[(m.p)] < 95:
+				if 58 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 58 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 95:
+				if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3115:
+			goto st329
+		case 3117:
+			goto st329
+		case 3130:
+			goto st330
+		case 3167:
+			goto st329
+		}
+		switch {
+		case _widec > 3129:
+			if 3169 <= _widec && _widec <= 3194 {
+				goto st329
+			}
+		case _widec >= 3120:
+			goto st329
+		}
+		goto tr8
+	st330:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof330
+		}
+	stCase330:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 84:
+			switch {
+			case (m.data)[(m.p)] < 68:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 66 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 66 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 68:
+				switch {
+				case (m.data)[(m.p)] > 70:
+					if 72 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 72 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 70:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 84:
+			switch {
+			case (m.data)[(m.p)] < 102:
+				switch {
+				case (m.data)[(m.p)] > 98:
+					if 100 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 100 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 98:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 102:
+				switch {
+				case (m.data)[(m.p)] > 104:
+					if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 104:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr474
+		case 80:
+			goto tr475
+		case 82:
+			goto tr476
+		case 99:
+			goto tr474
+		case 112:
+			goto tr475
+		case 114:
+			goto tr476
+		case 3104:
+			goto tr477
+		case 3394:
+			goto tr478
+		case 3396:
+			goto tr479
+		case 3398:
+			goto tr480
+		case 3412:
+			goto tr481
+		case 3426:
+			goto tr478
+		case 3428:
+			goto tr479
+		case 3430:
+			goto tr480
+		case 3444:
+			goto tr481
+		case 3650:
+			goto tr482
+		case 3652:
+			goto tr483
+		case 3654:
+			goto tr484
+		case 3656:
+			goto tr485
+		case 3668:
+			goto tr486
+		case 3682:
+			goto tr482
+		case 3684:
+			goto tr483
+		case 3686:
+			goto tr484
+		case 3688:
+			goto tr485
+		case 3700:
+			goto tr486
+		}
+		goto tr0
+	tr477:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		goto st331
+	st331:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof331
+		}
+	stCase331:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 84:
+			switch {
+			case (m.data)[(m.p)] < 68:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 66 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 66 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 68:
+				switch {
+				case (m.data)[(m.p)] > 70:
+					if 72 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 72 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 70:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 84:
+			switch {
+			case (m.data)[(m.p)] < 102:
+				switch {
+				case (m.data)[(m.p)] > 98:
+					if 100 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 100 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 98:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 102:
+				switch {
+				case (m.data)[(m.p)] > 104:
+					if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 104:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr411
+		case 80:
+			goto tr412
+		case 82:
+			goto tr413
+		case 99:
+			goto tr411
+		case 112:
+			goto tr412
+		case 114:
+			goto tr413
+		case 3104:
+			goto st331
+		case 3394:
+			goto tr417
+		case 3396:
+			goto tr418
+		case 3398:
+			goto tr419
+		case 3412:
+			goto tr420
+		case 3426:
+			goto tr417
+		case 3428:
+			goto tr418
+		case 3430:
+			goto tr419
+		case 3444:
+			goto tr420
+		case 3650:
+			goto tr421
+		case 3652:
+			goto tr422
+		case 3654:
+			goto tr423
+		case 3656:
+			goto tr424
+		case 3668:
+			goto tr425
+		case 3682:
+			goto tr421
+		case 3684:
+			goto tr422
+		case 3686:
+			goto tr423
+		case 3688:
+			goto tr424
+		case 3700:
+			goto tr425
+		}
+		goto tr0
+	tr417:
+
+		m.pb = m.p
+
+		goto st332
+	tr478:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st332
+	st332:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof332
+		}
+	stCase332:
+		switch (m.data)[(m.p)] {
+		case 85:
+			goto st333
+		case 117:
+			goto st333
+		}
+		goto tr0
+	st333:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof333
+		}
+	stCase333:
+		switch (m.data)[(m.p)] {
+		case 73:
+			goto st334
+		case 105:
+			goto st334
+		}
+		goto tr0
+	st334:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof334
+		}
+	stCase334:
+		switch (m.data)[(m.p)] {
+		case 76:
+			goto st335
+		case 108:
+			goto st335
+		}
+		goto tr0
+	st335:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof335
+		}
+	stCase335:
+		switch (m.data)[(m.p)] {
+		case 68:
+			goto st294
+		case 100:
+			goto st294
+		}
+		goto tr0
+	tr418:
+
+		m.pb = m.p
+
+		goto st336
+	tr479:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st336
+	st336:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof336
+		}
+	stCase336:
+		switch (m.data)[(m.p)] {
+		case 79:
+			goto st337
+		case 111:
+			goto st337
+		}
+		goto tr0
+	st337:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof337
+		}
+	stCase337:
+		switch (m.data)[(m.p)] {
+		case 67:
+			goto st338
+		case 99:
+			goto st338
+		}
+		goto tr0
+	st338:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof338
+		}
+	stCase338:
+		switch (m.data)[(m.p)] {
+		case 83:
+			goto st294
+		case 115:
+			goto st294
+		}
+		goto tr0
+	tr419:
+
+		m.pb = m.p
+
+		goto st339
+	tr480:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st339
+	st339:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof339
+		}
+	stCase339:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st340
+		case 73:
+			goto st342
+		case 101:
+			goto st340
+		case 105:
+			goto st342
+		}
+		goto tr0
+	st340:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof340
+		}
+	stCase340:
+		switch (m.data)[(m.p)] {
+		case 65:
+			goto st341
+		case 97:
+			goto st341
+		}
+		goto tr0
+	st341:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof341
+		}
+	stCase341:
+		switch (m.data)[(m.p)] {
+		case 84:
+			goto st294
+		case 116:
+			goto st294
+		}
+		goto tr0
+	st342:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof342
+		}
+	stCase342:
+		switch (m.data)[(m.p)] {
+		case 88:
+			goto st294
+		case 120:
+			goto st294
+		}
+		goto tr0
+	tr420:
+
+		m.pb = m.p
+
+		goto st343
+	tr481:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st343
+	st343:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof343
+		}
+	stCase343:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st344
+		case 101:
+			goto st344
+		}
+		goto tr0
+	st344:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof344
+		}
+	stCase344:
+		switch (m.data)[(m.p)] {
+		case 83:
+			goto st341
+		case 115:
+			goto st341
+		}
+		goto tr0
+	tr421:
+
+		m.pb = m.p
+
+		goto st345
+	tr482:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st345
+	st345:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof345
+		}
+	stCase345:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 85:
+			if 117 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 117 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 85:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3413:
+			goto st333
+		case 3445:
+			goto st333
+		case 3669:
+			goto st346
+		case 3701:
+			goto st346
+		}
+		goto tr0
+	st346:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof346
+		}
+	stCase346:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 71:
+			if 103 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 103 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 71:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 73:
+			goto st334
+		case 105:
+			goto st334
+		case 3655:
+			goto st347
+		case 3687:
+			goto st347
+		}
+		goto tr0
+	st347:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof347
+		}
+	stCase347:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 70:
+			if 102 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 102 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 70:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3654:
+			goto st348
+		case 3686:
+			goto st348
+		}
+		goto tr0
+	st348:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof348
+		}
+	stCase348:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 73:
+			if 105 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 105 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 73:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3657:
+			goto st349
+		case 3689:
+			goto st349
+		}
+		goto tr0
+	st349:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof349
+		}
+	stCase349:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 88:
+			if 120 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 120 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 88:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3672:
+			goto st294
+		case 3704:
+			goto st294
+		}
+		goto tr0
+	tr422:
+
+		m.pb = m.p
+
+		goto st350
+	tr483:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st350
+	st350:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof350
+		}
+	stCase350:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 79:
+			if 111 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 111 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 79:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3407:
+			goto st337
+		case 3439:
+			goto st337
+		case 3663:
+			goto st351
+		case 3695:
+			goto st351
+		}
+		goto tr0
+	st351:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof351
+		}
+	stCase351:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 67:
+			if 99 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 99 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 67:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3395:
+			goto st338
+		case 3427:
+			goto st338
+		case 3651:
+			goto st352
+		case 3683:
+			goto st352
+		}
+		goto tr0
+	st352:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof352
+		}
+	stCase352:
+
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		switch (m.data)[(m.p)] {
+		case 33:
+			goto tr427
+		case 40:
+			goto st315
+		case 58:
+			goto st296
+		case 83:
+			goto st294
+		case 115:
+			goto st294
+		}
+		goto tr21
+	tr423:
+
+		m.pb = m.p
+
+		goto st353
+	tr484:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st353
+	st353:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof353
+		}
+	stCase353:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 69:
+			if 101 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 101 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 69:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 73:
+			goto st342
+		case 105:
+			goto st342
+		case 3397:
+			goto st340
+		case 3429:
+			goto st340
+		case 3653:
+			goto st354
+		case 3685:
+			goto st354
+		}
+		goto tr0
+	st354:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof354
+		}
+	stCase354:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 65:
+			if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 97 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 65:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3393:
+			goto st341
+		case 3425:
+			goto st341
+		case 3649:
+			goto st355
+		case 3681:
+			goto st355
+		}
+		goto tr0
+	st355:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof355
+		}
+	stCase355:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 84:
+			if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 84:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3412:
+			goto st294
+		case 3444:
+			goto st294
+		case 3668:
+			goto st356
+		case 3700:
+			goto st356
+		}
+		goto tr0
+	st356:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof356
+		}
+	stCase356:
+
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 85:
+			if 117 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 117 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 85:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 33:
+			goto tr427
+		case 40:
+			goto st315
+		case 58:
+			goto st296
+		case 3669:
+			goto st357
+		case 3701:
+			goto st357
+		}
+		goto tr21
+	st357:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof357
+		}
+	stCase357:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 82:
+			if 114 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 114 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 82:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3666:
+			goto st358
+		case 3698:
+			goto st358
+		}
+		goto tr0
+	st358:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof358
+		}
+	stCase358:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 69:
+			if 101 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 101 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 69:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3653:
+			goto st294
+		case 3685:
+			goto st294
+		}
+		goto tr0
+	tr424:
+
+		m.pb = m.p
+
+		goto st359
+	tr485:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st359
+	st359:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof359
+		}
+	stCase359:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 79:
+			if 111 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 111 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 79:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3663:
+			goto st360
+		case 3695:
+			goto st360
+		}
+		goto tr0
+	st360:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof360
+		}
+	stCase360:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 84:
+			if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 84:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3668:
+			goto st347
+		case 3700:
+			goto st347
+		}
+		goto tr0
+	tr425:
+
+		m.pb = m.p
+
+		goto st361
+	tr486:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st361
+	st361:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof361
+		}
+	stCase361:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 69:
+			if 101 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 101 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 69:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3397:
+			goto st344
+		case 3429:
+			goto st344
+		case 3653:
+			goto st362
+		case 3685:
+			goto st362
+		}
+		goto tr0
+	st362:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof362
+		}
+	stCase362:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 83:
+			if 115 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 115 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 83:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3411:
+			goto st341
+		case 3443:
+			goto st341
+		case 3667:
+			goto st363
+		case 3699:
+			goto st363
+		}
+		goto tr0
+	st363:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof363
+		}
+	stCase363:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 84:
+			if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 84:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3412:
+			goto st294
+		case 3444:
+			goto st294
+		case 3668:
+			goto st364
+		case 3700:
+			goto st364
+		}
+		goto tr0
+	st364:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof364
+		}
+	stCase364:
+
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 83:
+			if 115 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 115 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 83:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 33:
+			goto tr427
+		case 40:
+			goto st315
+		case 58:
+			goto st296
+		case 3667:
+			goto st294
+		case 3699:
+			goto st294
+		}
+		goto tr21
+	tr415:
+
+		m.pb = m.p
+
+		goto st365
+	st365:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof365
+		}
+	stCase365:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 152:
+			if 140 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 158:
+			if 172 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 175 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch {
+		case _widec < 3224:
+			if 3212 <= _widec && _widec <= 3215 {
+				goto st366
+			}
+		case _widec > 3230:
+			if 3244 <= _widec && _widec <= 3247 {
+				goto st366
+			}
+		default:
+			goto st366
+		}
+		goto tr8
+	st366:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof366
+		}
+	stCase366:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if 3200 <= _widec && _widec <= 3263 {
+			goto st367
+		}
+		goto tr8
+	st367:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof367
+		}
+	stCase367:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 98:
+			switch {
+			case (m.data)[(m.p)] < 68:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 66 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 66 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 68:
+				switch {
+				case (m.data)[(m.p)] < 72:
+					if 70 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 70 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 72:
+					if 84 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 84 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 98:
+			switch {
+			case (m.data)[(m.p)] < 104:
+				switch {
+				case (m.data)[(m.p)] > 100:
+					if 102 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 102 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 100:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 104:
+				switch {
+				case (m.data)[(m.p)] < 226:
+					if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 226:
+					if 239 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 239 {
+						_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+						if m.gitmoji {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr474
+		case 80:
+			goto tr475
+		case 82:
+			goto tr476
+		case 99:
+			goto tr474
+		case 112:
+			goto tr475
+		case 114:
+			goto tr476
+		case 3104:
+			goto tr477
+		case 3298:
+			goto st368
+		case 3311:
+			goto st373
+		case 3394:
+			goto tr478
+		case 3396:
+			goto tr479
+		case 3398:
+			goto tr480
+		case 3412:
+			goto tr481
+		case 3426:
+			goto tr478
+		case 3428:
+			goto tr479
+		case 3430:
+			goto tr480
+		case 3444:
+			goto tr481
+		case 3650:
+			goto tr482
+		case 3652:
+			goto tr483
+		case 3654:
+			goto tr484
+		case 3656:
+			goto tr485
+		case 3668:
+			goto tr486
+		case 3682:
+			goto tr482
+		case 3684:
+			goto tr483
+		case 3686:
+			goto tr484
+		case 3688:
+			goto tr485
+		case 3700:
+			goto tr486
+		}
+		goto tr0
+	st368:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof368
+		}
+	stCase368:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 128 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3200 {
+			goto st369
+		}
+		goto tr8
+	st369:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof369
+		}
+	stCase369:
+		_widec = int16((m.data)[(m.p)])
+		if 141 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 141 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3213 {
+			goto st370
+		}
+		goto tr8
+	st370:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof370
+		}
+	stCase370:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 226:
+			if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 226:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3298:
+			goto st365
+		case 3312:
+			goto st371
+		}
+		goto tr8
+	tr416:
+
+		m.pb = m.p
+
+		goto st371
+	st371:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof371
+		}
+	stCase371:
+		_widec = int16((m.data)[(m.p)])
+		if 159 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3231 {
+			goto st372
+		}
+		goto tr8
+	st372:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof372
+		}
+	stCase372:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 171 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if 3200 <= _widec && _widec <= 3243 {
+			goto st366
+		}
+		goto tr8
+	st373:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof373
+		}
+	stCase373:
+		_widec = int16((m.data)[(m.p)])
+		if 184 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 184 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3256 {
+			goto st374
+		}
+		goto tr8
+	st374:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof374
+		}
+	stCase374:
+		_widec = int16((m.data)[(m.p)])
+		if 143 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3215 {
+			goto st375
+		}
+		goto tr8
+	st375:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof375
+		}
+	stCase375:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 84:
+			switch {
+			case (m.data)[(m.p)] < 68:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 66 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 66 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 68:
+				switch {
+				case (m.data)[(m.p)] > 70:
+					if 72 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 72 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 70:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 84:
+			switch {
+			case (m.data)[(m.p)] < 102:
+				switch {
+				case (m.data)[(m.p)] > 98:
+					if 100 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 100 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 98:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 102:
+				switch {
+				case (m.data)[(m.p)] < 116:
+					if 104 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 104 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 116:
+					if 226 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 226 {
+						_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+						if m.gitmoji {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr474
+		case 80:
+			goto tr475
+		case 82:
+			goto tr476
+		case 99:
+			goto tr474
+		case 112:
+			goto tr475
+		case 114:
+			goto tr476
+		case 3104:
+			goto tr477
+		case 3298:
+			goto st368
+		case 3394:
+			goto tr478
+		case 3396:
+			goto tr479
+		case 3398:
+			goto tr480
+		case 3412:
+			goto tr481
+		case 3426:
+			goto tr478
+		case 3428:
+			goto tr479
+		case 3430:
+			goto tr480
+		case 3444:
+			goto tr481
+		case 3650:
+			goto tr482
+		case 3652:
+			goto tr483
+		case 3654:
+			goto tr484
+		case 3656:
+			goto tr485
+		case 3668:
+			goto tr486
+		case 3682:
+			goto tr482
+		case 3684:
+			goto tr483
+		case 3686:
+			goto tr484
+		case 3688:
+			goto tr485
+		case 3700:
+			goto tr486
+		}
+		goto tr0
+	stCase376:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 98:
+			switch {
+			case (m.data)[(m.p)] < 68:
+				switch {
+				case (m.data)[(m.p)] > 58:
+					if 66 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 66 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 58:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 68:
+				switch {
+				case (m.data)[(m.p)] < 72:
+					if 70 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 70 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 72:
+					if 84 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 84 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 98:
+			switch {
+			case (m.data)[(m.p)] < 104:
+				switch {
+				case (m.data)[(m.p)] > 100:
+					if 102 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 102 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 100:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 104:
+				switch {
+				case (m.data)[(m.p)] < 226:
+					if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 226:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+						if m.gitmoji {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr523
+		case 80:
+			goto tr524
+		case 82:
+			goto tr525
+		case 83:
+			goto tr526
+		case 86:
+			goto tr527
+		case 99:
+			goto tr523
+		case 112:
+			goto tr524
+		case 114:
+			goto tr525
+		case 115:
+			goto tr526
+		case 118:
+			goto tr527
+		case 3130:
+			goto tr528
+		case 3298:
+			goto tr529
+		case 3312:
+			goto tr530
+		case 3394:
+			goto tr531
+		case 3396:
+			goto tr532
+		case 3398:
+			goto tr533
+		case 3412:
+			goto tr534
+		case 3426:
+			goto tr531
+		case 3428:
+			goto tr532
+		case 3430:
+			goto tr533
+		case 3444:
+			goto tr534
+		case 3650:
+			goto tr535
+		case 3652:
+			goto tr536
+		case 3654:
+			goto tr537
+		case 3656:
+			goto tr538
+		case 3668:
+			goto tr539
+		case 3682:
+			goto tr535
+		case 3684:
+			goto tr536
+		case 3686:
+			goto tr537
+		case 3688:
+			goto tr538
+		case 3700:
+			goto tr539
+		}
+		goto tr0
+	tr523:
+
+		m.pb = m.p
+
+		goto st377
+	tr610:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st377
+	st377:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof377
+		}
+	stCase377:
+		switch (m.data)[(m.p)] {
+		case 72:
+			goto st378
+		case 73:
+			goto st381
+		case 104:
+			goto st378
+		case 105:
+			goto st381
+		}
+		goto tr0
+	st378:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof378
+		}
+	stCase378:
+		switch (m.data)[(m.p)] {
+		case 79:
+			goto st379
+		case 111:
+			goto st379
+		}
+		goto tr0
+	st379:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof379
+		}
+	stCase379:
+		switch (m.data)[(m.p)] {
+		case 82:
+			goto st380
+		case 114:
+			goto st380
+		}
+		goto tr0
+	st380:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof380
+		}
+	stCase380:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st381
+		case 101:
+			goto st381
+		}
+		goto tr0
+	st381:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof381
+		}
+	stCase381:
+
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		switch (m.data)[(m.p)] {
+		case 33:
+			goto tr544
+		case 40:
+			goto st402
+		case 58:
+			goto st383
+		}
+		goto tr21
+	tr544:
+
+		output.exclamation = true
+		if m.logger != nil {
+			m.logger.Info("commit message communicates a breaking change")
+		}
+		if m.listener != nil {
+			m.listener.OnBreaking(conventionalcommits.Span{Start: m.p, End: m.p + 1})
+		}
+
+		goto st382
+	st382:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof382
+		}
+	stCase382:
+		if (m.data)[(m.p)] == 58 {
+			goto st383
+		}
+		goto tr21
+	st383:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof383
+		}
+	stCase383:
+		if (m.data)[(m.p)] == 32 {
+			goto st384
+		}
+		goto tr25
+	st384:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof384
+		}
+	stCase384:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 11:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 9 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 9 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 31:
+				switch {
+				case (m.data)[(m.p)] < 33:
+					if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3872:
+			goto st393
+		case 4064:
+			goto tr551
+		case 4077:
+			goto tr553
+		case 4080:
+			goto tr554
+		case 4084:
+			goto tr556
+		case 4320:
+			goto tr559
+		case 4333:
+			goto tr561
+		case 4336:
+			goto tr562
+		case 4340:
+			goto tr564
+		}
+		switch {
+		case _widec < 4096:
+			switch {
+			case _widec < 4034:
+				switch {
+				case _widec > 3849:
+					if 3851 <= _widec && _widec <= 3967 {
+						goto tr548
+					}
+				case _widec >= 3840:
+					goto tr548
+				}
+			case _widec > 4063:
+				switch {
+				case _widec > 4079:
+					if 4081 <= _widec && _widec <= 4083 {
+						goto tr555
+					}
+				case _widec >= 4065:
+					goto tr552
+				}
+			default:
+				goto tr550
+			}
+		case _widec > 4104:
+			switch {
+			case _widec < 4290:
+				switch {
+				case _widec > 4127:
+					if 4129 <= _widec && _widec <= 4223 {
+						goto tr557
+					}
+				case _widec >= 4107:
+					goto tr557
+				}
+			case _widec > 4319:
+				switch {
+				case _widec > 4335:
+					if 4337 <= _widec && _widec <= 4339 {
+						goto tr563
+					}
+				case _widec >= 4321:
+					goto tr560
+				}
+			default:
+				goto tr558
+			}
+		default:
+			goto tr557
+		}
+		goto tr27
+	tr548:
+
+		m.pb = m.p
+
+		goto st673
+	st673:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof673
+		}
+	stCase673:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 225:
+			switch {
+			case (m.data)[(m.p)] < 11:
+				if (m.data)[(m.p)] <= 9 {
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 127:
+				switch {
+				case (m.data)[(m.p)] > 223:
+					if 224 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 224 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 194:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 236:
+			switch {
+			case (m.data)[(m.p)] < 240:
+				switch {
+				case (m.data)[(m.p)] > 237:
+					if 238 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 239 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 237:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 240:
+				switch {
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 241:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 10:
+			goto tr965
+		case 4064:
+			goto st387
+		case 4077:
+			goto st389
+		case 4080:
+			goto st390
+		case 4084:
+			goto st392
+		}
+		switch {
+		case _widec < 4034:
+			switch {
+			case _widec > 3849:
+				if 3851 <= _widec && _widec <= 3967 {
+					goto st673
+				}
+			case _widec >= 3840:
+				goto st673
+			}
+		case _widec > 4063:
+			switch {
+			case _widec > 4079:
+				if 4081 <= _widec && _widec <= 4083 {
+					goto st391
+				}
+			case _widec >= 4065:
+				goto st388
+			}
+		default:
+			goto st386
+		}
+		goto tr27
+	tr965:
+
+		output.descr = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message description", "description", output.descr)
+		}
+		if m.listener != nil {
+			m.listener.OnDescription(output.descr, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		if m.err = m.checkHeader(); m.err != nil {
+			if m.recovery {
+				// Record the error and continue, the header is well-formed anyway
+				m.recover()
+			} else {
+				{
+					(m.p)++
+					m.cs = 385
+					goto _out
+				}
+			}
+		}
+
+		goto st385
+	st385:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof385
+		}
+	stCase385:
+		if (m.data)[(m.p)] == 10 {
+			goto tr565
+		}
+		goto tr45
+	tr565:
+
+		if m.logger != nil {
+			m.logger.Debug("found a blank line", "pos", m.p)
+		}
+
+		// Mark the next character so that rewinds can not go back before it
+		// Eg., when it is not a possible trailer token start
+		m.pb = m.p + 1
+		if m.bodyStart < 0 {
+			// The first time is right after the header
+			m.bodyStart = m.pb
+		}
+		if m.gitTrailers {
+			// The footer is the trailer block git finds, already cut off from the input
+			{
+				goto st660
+			}
+		}
+		if m.logger != nil {
+			m.logger.Debug("try to parse a footer trailer token", "pos", m.p)
+		}
+		{
+			goto st654
+		}
+
+		goto st674
+	st674:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof674
+		}
+	stCase674:
+		goto st0
+	tr550:
+
+		m.pb = m.p
+
+		goto st386
+	st386:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof386
+		}
+	stCase386:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st673
+		}
+		goto tr27
+	tr551:
+
+		m.pb = m.p
+
+		goto st387
+	st387:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof387
+		}
+	stCase387:
+		_widec = int16((m.data)[(m.p)])
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4000 <= _widec && _widec <= 4031 {
+			goto st386
+		}
+		goto tr27
+	tr552:
+
+		m.pb = m.p
+
+		goto st388
+	st388:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof388
+		}
+	stCase388:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st386
+		}
+		goto tr27
+	tr553:
+
+		m.pb = m.p
+
+		goto st389
+	st389:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof389
+		}
+	stCase389:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 3999 {
+			goto st386
+		}
+		goto tr27
+	tr554:
+
+		m.pb = m.p
+
+		goto st390
+	st390:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof390
+		}
+	stCase390:
+		_widec = int16((m.data)[(m.p)])
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3984 <= _widec && _widec <= 4031 {
+			goto st388
+		}
+		goto tr27
+	tr555:
+
+		m.pb = m.p
+
+		goto st391
+	st391:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof391
+		}
+	stCase391:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st388
+		}
+		goto tr27
+	tr556:
+
+		m.pb = m.p
+
+		goto st392
+	st392:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof392
+		}
+	stCase392:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 3983 {
+			goto st388
+		}
+		goto tr27
+	st393:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof393
+		}
+	stCase393:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 9:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3872:
+			goto st393
+		case 4064:
+			goto tr551
+		case 4077:
+			goto tr553
+		case 4080:
+			goto tr554
+		case 4084:
+			goto tr556
+		}
+		switch {
+		case _widec < 4034:
+			switch {
+			case _widec > 3849:
+				if 3851 <= _widec && _widec <= 3967 {
+					goto tr548
+				}
+			case _widec >= 3840:
+				goto tr548
+			}
+		case _widec > 4063:
+			switch {
+			case _widec > 4079:
+				if 4081 <= _widec && _widec <= 4083 {
+					goto tr555
+				}
+			case _widec >= 4065:
+				goto tr552
+			}
+		default:
+			goto tr550
+		}
+		goto tr27
+	tr557:
+
+		m.pb = m.p
+
+		goto st675
+	st675:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof675
+		}
+	stCase675:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 10:
+			goto tr965
+		case 4128:
+			goto st394
+		case 4320:
+			goto st396
+		case 4333:
+			goto st398
+		case 4336:
+			goto st399
+		case 4340:
+			goto st401
+		}
+		switch {
+		case _widec < 4290:
+			switch {
+			case _widec > 4104:
+				if 4107 <= _widec && _widec <= 4223 {
+					goto st675
+				}
+			case _widec >= 4096:
+				goto st675
+			}
+		case _widec > 4319:
+			switch {
+			case _widec > 4335:
+				if 4337 <= _widec && _widec <= 4339 {
+					goto st400
+				}
+			case _widec >= 4321:
+				goto st397
+			}
+		default:
+			goto st395
+		}
+		goto tr50
+	st394:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof394
+		}
+	stCase394:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 4128:
+			goto st394
+		case 4320:
+			goto st396
+		case 4333:
+			goto st398
+		case 4336:
+			goto st399
+		case 4340:
+			goto st401
+		}
+		switch {
+		case _widec < 4290:
+			switch {
+			case _widec > 4104:
+				if 4107 <= _widec && _widec <= 4223 {
+					goto st675
+				}
+			case _widec >= 4096:
+				goto st675
+			}
+		case _widec > 4319:
+			switch {
+			case _widec > 4335:
+				if 4337 <= _widec && _widec <= 4339 {
+					goto st400
+				}
+			case _widec >= 4321:
+				goto st397
+			}
+		default:
+			goto st395
+		}
+		goto tr50
+	tr558:
+
+		m.pb = m.p
+
+		goto st395
+	st395:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof395
+		}
+	stCase395:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st675
+		}
+		goto tr50
+	tr559:
+
+		m.pb = m.p
+
+		goto st396
+	st396:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof396
+		}
+	stCase396:
+		_widec = int16((m.data)[(m.p)])
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4256 <= _widec && _widec <= 4287 {
+			goto st395
+		}
+		goto tr50
+	tr560:
+
+		m.pb = m.p
+
+		goto st397
+	st397:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof397
+		}
+	stCase397:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st395
+		}
+		goto tr50
+	tr561:
+
+		m.pb = m.p
+
+		goto st398
+	st398:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof398
+		}
+	stCase398:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4255 {
+			goto st395
+		}
+		goto tr50
+	tr562:
+
+		m.pb = m.p
+
+		goto st399
+	st399:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof399
+		}
+	stCase399:
+		_widec = int16((m.data)[(m.p)])
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4240 <= _widec && _widec <= 4287 {
+			goto st397
+		}
+		goto tr50
+	tr563:
+
+		m.pb = m.p
+
+		goto st400
+	st400:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof400
+		}
+	stCase400:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st397
+		}
+		goto tr50
+	tr564:
+
+		m.pb = m.p
+
+		goto st401
+	st401:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof401
+		}
+	stCase401:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4239 {
+			goto st397
+		}
+		goto tr50
+	st402:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof402
+		}
+	stCase402:
+		switch (m.data)[(m.p)] {
+		case 41:
+			goto tr579
+		case 224:
+			goto tr581
+		case 237:
+			goto tr583
+		case 240:
+			goto tr584
+		case 244:
+			goto tr586
+		}
+		switch {
+		case (m.data)[(m.p)] < 194:
+			switch {
+			case (m.data)[(m.p)] > 39:
+				if 42 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+					goto tr578
+				}
+			case (m.data)[(m.p)] >= 32:
+				goto tr578
+			}
+		case (m.data)[(m.p)] > 223:
+			switch {
+			case (m.data)[(m.p)] > 239:
+				if 241 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 243 {
+					goto tr585
+				}
+			case (m.data)[(m.p)] >= 225:
+				goto tr582
+			}
+		default:
+			goto tr580
+		}
+		goto tr60
+	tr578:
+
+		m.pb = m.p
+
+		goto st403
+	st403:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof403
+		}
+	stCase403:
+		switch (m.data)[(m.p)] {
+		case 41:
+			goto tr588
+		case 224:
+			goto st406
+		case 237:
+			goto st408
+		case 240:
+			goto st409
+		case 244:
+			goto st411
+		}
+		switch {
+		case (m.data)[(m.p)] < 194:
+			switch {
+			case (m.data)[(m.p)] > 39:
+				if 42 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+					goto st403
+				}
+			case (m.data)[(m.p)] >= 32:
+				goto st403
+			}
+		case (m.data)[(m.p)] > 223:
+			switch {
+			case (m.data)[(m.p)] > 239:
+				if 241 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 243 {
+					goto st410
+				}
+			case (m.data)[(m.p)] >= 225:
+				goto st407
+			}
+		default:
+			goto st405
+		}
+		goto tr60
+	tr579:
+
+		m.pb = m.p
+
+		output.scope = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message scope", "scope", output.scope)
+		}
+		if m.listener != nil {
+			m.listener.OnScope(output.scope, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		goto st404
+	tr588:
+
+		output.scope = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message scope", "scope", output.scope)
+		}
+		if m.listener != nil {
+			m.listener.OnScope(output.scope, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		goto st404
+	st404:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof404
+		}
+	stCase404:
+		switch (m.data)[(m.p)] {
+		case 33:
+			goto tr544
+		case 58:
+			goto st383
+		}
+		goto tr21
+	tr580:
+
+		m.pb = m.p
+
+		goto st405
+	st405:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof405
+		}
+	stCase405:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st403
+		}
+		goto tr60
+	tr581:
+
+		m.pb = m.p
+
+		goto st406
+	st406:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof406
+		}
+	stCase406:
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st405
+		}
+		goto tr60
+	tr582:
+
+		m.pb = m.p
+
+		goto st407
+	st407:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof407
+		}
+	stCase407:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st405
+		}
+		goto tr60
+	tr583:
+
+		m.pb = m.p
+
+		goto st408
+	st408:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof408
+		}
+	stCase408:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			goto st405
+		}
+		goto tr60
+	tr584:
+
+		m.pb = m.p
+
+		goto st409
+	st409:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof409
+		}
+	stCase409:
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st407
+		}
+		goto tr60
+	tr585:
+
+		m.pb = m.p
+
+		goto st410
+	st410:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof410
+		}
+	stCase410:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st407
+		}
+		goto tr60
+	tr586:
+
+		m.pb = m.p
+
+		goto st411
+	st411:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof411
+		}
+	stCase411:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			goto st407
+		}
+		goto tr60
+	tr524:
+
+		m.pb = m.p
+
+		goto st412
+	tr611:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st412
+	st412:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof412
+		}
+	stCase412:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st413
+		case 101:
+			goto st413
+		}
+		goto tr0
+	st413:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof413
+		}
+	stCase413:
+		switch (m.data)[(m.p)] {
+		case 82:
+			goto st414
+		case 114:
+			goto st414
+		}
+		goto tr0
+	st414:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof414
+		}
+	stCase414:
+		switch (m.data)[(m.p)] {
+		case 70:
+			goto st381
+		case 102:
+			goto st381
+		}
+		goto tr0
+	tr525:
+
+		m.pb = m.p
+
+		goto st415
+	tr612:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st415
+	st415:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof415
+		}
+	stCase415:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st416
+		case 101:
+			goto st416
+		}
+		goto tr0
+	st416:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof416
+		}
+	stCase416:
+		switch (m.data)[(m.p)] {
+		case 70:
+			goto st417
+		case 102:
+			goto st417
+		}
+		goto tr0
+	st417:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof417
+		}
+	stCase417:
+		switch (m.data)[(m.p)] {
+		case 65:
+			goto st418
+		case 97:
+			goto st418
+		}
+		goto tr0
+	st418:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof418
+		}
+	stCase418:
+		switch (m.data)[(m.p)] {
+		case 67:
+			goto st419
+		case 99:
+			goto st419
+		}
+		goto tr0
+	st419:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof419
+		}
+	stCase419:
+		switch (m.data)[(m.p)] {
+		case 84:
+			goto st420
+		case 116:
+			goto st420
+		}
+		goto tr0
+	st420:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof420
+		}
+	stCase420:
+		switch (m.data)[(m.p)] {
+		case 79:
+			goto st421
+		case 111:
+			goto st421
+		}
+		goto tr0
+	st421:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof421
+		}
+	stCase421:
+		switch (m.data)[(m.p)] {
+		case 82:
+			goto st381
+		case 114:
+			goto st381
+		}
+		goto tr0
+	tr526:
+
+		m.pb = m.p
+
+		goto st422
+	tr613:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st422
+	st422:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof422
+		}
+	stCase422:
+		switch (m.data)[(m.p)] {
+		case 84:
+			goto st423
+		case 116:
+			goto st423
+		}
+		goto tr0
+	st423:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof423
+		}
+	stCase423:
+		switch (m.data)[(m.p)] {
+		case 89:
+			goto st424
+		case 121:
+			goto st424
+		}
+		goto tr0
+	st424:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof424
+		}
+	stCase424:
+		switch (m.data)[(m.p)] {
+		case 76:
+			goto st380
+		case 108:
+			goto st380
+		}
+		goto tr0
+	tr527:
+
+		m.pb = m.p
+
+		goto st425
+	tr614:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st425
+	st425:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof425
+		}
+	stCase425:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st426
+		case 101:
+			goto st426
+		}
+		goto tr0
+	st426:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof426
+		}
+	stCase426:
+		switch (m.data)[(m.p)] {
+		case 78:
+			goto st427
+		case 110:
+			goto st427
+		}
+		goto tr0
+	st427:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof427
+		}
+	stCase427:
+		switch (m.data)[(m.p)] {
+		case 68:
+			goto st420
+		case 100:
+			goto st420
+		}
+		goto tr0
+	tr528:
+
+		m.pb = m.p
+
+		goto st428
+	st428:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof428
+		}
+	stCase428:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 48:
+			switch {
+			case (m.data)[(m.p)] > 43:
+				if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 43:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 57:
+			switch {
+			case (m.data)[(m.p)] > 95:
+				if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 95:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3115:
+			goto st429
+		case 3117:
+			goto st429
+		case 3167:
+			goto st429
+		}
+		switch {
+		case _widec > 3129:
+			if 3169 <= _widec && _widec <= 3194 {
+				goto st429
+			}
+		case _widec >= 3120:
+			goto st429
+		}
+		goto tr8
+	st429:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof429
+		}
+	stCase429:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 48:
+			switch {
+			case (m.data)[(m.p)] > 43:
+				if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 43:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 57:
+			switch {
+			case (m.data)[(m.p)] < 95:
+				if 58 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 58 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 95:
+				if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3115:
+			goto st429
+		case 3117:
+			goto st429
+		case 3130:
+			goto st430
+		case 3167:
+			goto st429
+		}
+		switch {
+		case _widec > 3129:
+			if 3169 <= _widec && _widec <= 3194 {
+				goto st429
+			}
+		case _widec >= 3120:
+			goto st429
+		}
+		goto tr8
+	st430:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof430
+		}
+	stCase430:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 84:
+			switch {
+			case (m.data)[(m.p)] < 68:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 66 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 66 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 68:
+				switch {
+				case (m.data)[(m.p)] > 70:
+					if 72 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 72 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 70:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 84:
+			switch {
+			case (m.data)[(m.p)] < 102:
+				switch {
+				case (m.data)[(m.p)] > 98:
+					if 100 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 100 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 98:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 102:
+				switch {
+				case (m.data)[(m.p)] > 104:
+					if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 104:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr610
+		case 80:
+			goto tr611
+		case 82:
+			goto tr612
+		case 83:
+			goto tr613
+		case 86:
+			goto tr614
+		case 99:
+			goto tr610
+		case 112:
+			goto tr611
+		case 114:
+			goto tr612
+		case 115:
+			goto tr613
+		case 118:
+			goto tr614
+		case 3104:
+			goto tr615
+		case 3394:
+			goto tr616
+		case 3396:
+			goto tr617
+		case 3398:
+			goto tr618
+		case 3412:
+			goto tr619
+		case 3426:
+			goto tr616
+		case 3428:
+			goto tr617
+		case 3430:
+			goto tr618
+		case 3444:
+			goto tr619
+		case 3650:
+			goto tr620
+		case 3652:
+			goto tr621
+		case 3654:
+			goto tr622
+		case 3656:
+			goto tr623
+		case 3668:
+			goto tr624
+		case 3682:
+			goto tr620
+		case 3684:
+			goto tr621
+		case 3686:
+			goto tr622
+		case 3688:
+			goto tr623
+		case 3700:
+			goto tr624
+		}
+		goto tr0
+	tr615:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		goto st431
+	st431:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof431
+		}
+	stCase431:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 84:
+			switch {
+			case (m.data)[(m.p)] < 68:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 66 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 66 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 68:
+				switch {
+				case (m.data)[(m.p)] > 70:
+					if 72 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 72 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 70:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 84:
+			switch {
+			case (m.data)[(m.p)] < 102:
+				switch {
+				case (m.data)[(m.p)] > 98:
+					if 100 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 100 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 98:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 102:
+				switch {
+				case (m.data)[(m.p)] > 104:
+					if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 104:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr523
+		case 80:
+			goto tr524
+		case 82:
+			goto tr525
+		case 83:
+			goto tr526
+		case 86:
+			goto tr527
+		case 99:
+			goto tr523
+		case 112:
+			goto tr524
+		case 114:
+			goto tr525
+		case 115:
+			goto tr526
+		case 118:
+			goto tr527
+		case 3104:
+			goto st431
+		case 3394:
+			goto tr531
+		case 3396:
+			goto tr532
+		case 3398:
+			goto tr533
+		case 3412:
+			goto tr534
+		case 3426:
+			goto tr531
+		case 3428:
+			goto tr532
+		case 3430:
+			goto tr533
+		case 3444:
+			goto tr534
+		case 3650:
+			goto tr535
+		case 3652:
+			goto tr536
+		case 3654:
+			goto tr537
+		case 3656:
+			goto tr538
+		case 3668:
+			goto tr539
+		case 3682:
+			goto tr535
+		case 3684:
+			goto tr536
+		case 3686:
+			goto tr537
+		case 3688:
+			goto tr538
+		case 3700:
+			goto tr539
+		}
+		goto tr0
+	tr531:
+
+		m.pb = m.p
+
+		goto st432
+	tr616:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st432
+	st432:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof432
+		}
+	stCase432:
+		switch (m.data)[(m.p)] {
+		case 85:
+			goto st433
+		case 117:
+			goto st433
+		}
+		goto tr0
+	st433:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof433
+		}
+	stCase433:
+		switch (m.data)[(m.p)] {
+		case 73:
+			goto st434
+		case 105:
+			goto st434
+		}
+		goto tr0
+	st434:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof434
+		}
+	stCase434:
+		switch (m.data)[(m.p)] {
+		case 76:
+			goto st435
+		case 108:
+			goto st435
+		}
+		goto tr0
+	st435:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof435
+		}
+	stCase435:
+		switch (m.data)[(m.p)] {
+		case 68:
+			goto st381
+		case 100:
+			goto st381
+		}
+		goto tr0
+	tr532:
+
+		m.pb = m.p
+
+		goto st436
+	tr617:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st436
+	st436:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof436
+		}
+	stCase436:
+		switch (m.data)[(m.p)] {
+		case 79:
+			goto st437
+		case 111:
+			goto st437
+		}
+		goto tr0
+	st437:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof437
+		}
+	stCase437:
+		switch (m.data)[(m.p)] {
+		case 67:
+			goto st438
+		case 99:
+			goto st438
+		}
+		goto tr0
+	st438:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof438
+		}
+	stCase438:
+		switch (m.data)[(m.p)] {
+		case 83:
+			goto st381
+		case 115:
+			goto st381
+		}
+		goto tr0
+	tr533:
+
+		m.pb = m.p
+
+		goto st439
+	tr618:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st439
+	st439:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof439
+		}
+	stCase439:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st440
+		case 73:
+			goto st442
+		case 101:
+			goto st440
+		case 105:
+			goto st442
+		}
+		goto tr0
+	st440:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof440
+		}
+	stCase440:
+		switch (m.data)[(m.p)] {
+		case 65:
+			goto st441
+		case 97:
+			goto st441
+		}
+		goto tr0
+	st441:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof441
+		}
+	stCase441:
+		switch (m.data)[(m.p)] {
+		case 84:
+			goto st381
+		case 116:
+			goto st381
+		}
+		goto tr0
+	st442:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof442
+		}
+	stCase442:
+		switch (m.data)[(m.p)] {
+		case 88:
+			goto st381
+		case 120:
+			goto st381
+		}
+		goto tr0
+	tr534:
+
+		m.pb = m.p
+
+		goto st443
+	tr619:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st443
+	st443:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof443
+		}
+	stCase443:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st444
+		case 101:
+			goto st444
+		}
+		goto tr0
+	st444:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof444
+		}
+	stCase444:
+		switch (m.data)[(m.p)] {
+		case 83:
+			goto st441
+		case 115:
+			goto st441
+		}
+		goto tr0
+	tr535:
+
+		m.pb = m.p
+
+		goto st445
+	tr620:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st445
+	st445:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof445
+		}
+	stCase445:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 85:
+			if 117 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 117 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 85:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3413:
+			goto st433
+		case 3445:
+			goto st433
+		case 3669:
+			goto st446
+		case 3701:
+			goto st446
+		}
+		goto tr0
+	st446:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof446
+		}
+	stCase446:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 71:
+			if 103 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 103 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 71:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 73:
+			goto st434
+		case 105:
+			goto st434
+		case 3655:
+			goto st447
+		case 3687:
+			goto st447
+		}
+		goto tr0
+	st447:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof447
+		}
+	stCase447:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 70:
+			if 102 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 102 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 70:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3654:
+			goto st448
+		case 3686:
+			goto st448
+		}
+		goto tr0
+	st448:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof448
+		}
+	stCase448:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 73:
+			if 105 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 105 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 73:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3657:
+			goto st449
+		case 3689:
+			goto st449
+		}
+		goto tr0
+	st449:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof449
+		}
+	stCase449:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 88:
+			if 120 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 120 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 88:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3672:
+			goto st381
+		case 3704:
+			goto st381
+		}
+		goto tr0
+	tr536:
+
+		m.pb = m.p
+
+		goto st450
+	tr621:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st450
+	st450:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof450
+		}
+	stCase450:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 79:
+			if 111 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 111 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 79:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3407:
+			goto st437
+		case 3439:
+			goto st437
+		case 3663:
+			goto st451
+		case 3695:
+			goto st451
+		}
+		goto tr0
+	st451:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof451
+		}
+	stCase451:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 67:
+			if 99 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 99 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 67:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3395:
+			goto st438
+		case 3427:
+			goto st438
+		case 3651:
+			goto st452
+		case 3683:
+			goto st452
+		}
+		goto tr0
+	st452:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof452
+		}
+	stCase452:
+
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		switch (m.data)[(m.p)] {
+		case 33:
+			goto tr544
+		case 40:
+			goto st402
+		case 58:
+			goto st383
+		case 83:
+			goto st381
+		case 115:
+			goto st381
+		}
+		goto tr21
+	tr537:
+
+		m.pb = m.p
+
+		goto st453
+	tr622:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st453
+	st453:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof453
+		}
+	stCase453:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 69:
+			if 101 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 101 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 69:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 73:
+			goto st442
+		case 105:
+			goto st442
+		case 3397:
+			goto st440
+		case 3429:
+			goto st440
+		case 3653:
+			goto st454
+		case 3685:
+			goto st454
+		}
+		goto tr0
+	st454:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof454
+		}
+	stCase454:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 65:
+			if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 97 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 65:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3393:
+			goto st441
+		case 3425:
+			goto st441
+		case 3649:
+			goto st455
+		case 3681:
+			goto st455
+		}
+		goto tr0
+	st455:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof455
+		}
+	stCase455:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 84:
+			if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 84:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3412:
+			goto st381
+		case 3444:
+			goto st381
+		case 3668:
+			goto st456
+		case 3700:
+			goto st456
+		}
+		goto tr0
+	st456:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof456
+		}
+	stCase456:
+
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 85:
+			if 117 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 117 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 85:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 33:
+			goto tr544
+		case 40:
+			goto st402
+		case 58:
+			goto st383
+		case 3669:
+			goto st457
+		case 3701:
+			goto st457
+		}
+		goto tr21
+	st457:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof457
+		}
+	stCase457:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 82:
+			if 114 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 114 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 82:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3666:
+			goto st458
+		case 3698:
+			goto st458
+		}
+		goto tr0
+	st458:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof458
+		}
+	stCase458:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 69:
+			if 101 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 101 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 69:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3653:
+			goto st381
+		case 3685:
+			goto st381
+		}
+		goto tr0
+	tr538:
+
+		m.pb = m.p
+
+		goto st459
+	tr623:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st459
+	st459:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof459
+		}
+	stCase459:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 79:
+			if 111 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 111 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 79:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3663:
+			goto st460
+		case 3695:
+			goto st460
+		}
+		goto tr0
+	st460:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof460
+		}
+	stCase460:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 84:
+			if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 84:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3668:
+			goto st447
+		case 3700:
+			goto st447
+		}
+		goto tr0
+	tr539:
+
+		m.pb = m.p
+
+		goto st461
+	tr624:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st461
+	st461:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof461
+		}
+	stCase461:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 69:
+			if 101 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 101 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 69:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3397:
+			goto st444
+		case 3429:
+			goto st444
+		case 3653:
+			goto st462
+		case 3685:
+			goto st462
+		}
+		goto tr0
+	st462:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof462
+		}
+	stCase462:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 83:
+			if 115 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 115 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 83:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3411:
+			goto st441
+		case 3443:
+			goto st441
+		case 3667:
+			goto st463
+		case 3699:
+			goto st463
+		}
+		goto tr0
+	st463:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof463
+		}
+	stCase463:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 84:
+			if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 84:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3412:
+			goto st381
+		case 3444:
+			goto st381
+		case 3668:
+			goto st464
+		case 3700:
+			goto st464
+		}
+		goto tr0
+	st464:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof464
+		}
+	stCase464:
+
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 83:
+			if 115 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 115 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 83:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 33:
+			goto tr544
+		case 40:
+			goto st402
+		case 58:
+			goto st383
+		case 3667:
+			goto st381
+		case 3699:
+			goto st381
+		}
+		goto tr21
+	tr529:
+
+		m.pb = m.p
+
+		goto st465
+	st465:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof465
+		}
+	stCase465:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 152:
+			if 140 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 158:
+			if 172 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 175 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch {
+		case _widec < 3224:
+			if 3212 <= _widec && _widec <= 3215 {
+				goto st466
+			}
+		case _widec > 3230:
+			if 3244 <= _widec && _widec <= 3247 {
+				goto st466
+			}
+		default:
+			goto st466
+		}
+		goto tr8
+	st466:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof466
+		}
+	stCase466:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if 3200 <= _widec && _widec <= 3263 {
+			goto st467
+		}
+		goto tr8
+	st467:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof467
+		}
+	stCase467:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 98:
+			switch {
+			case (m.data)[(m.p)] < 68:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 66 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 66 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 68:
+				switch {
+				case (m.data)[(m.p)] < 72:
+					if 70 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 70 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 72:
+					if 84 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 84 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 98:
+			switch {
+			case (m.data)[(m.p)] < 104:
+				switch {
+				case (m.data)[(m.p)] > 100:
+					if 102 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 102 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 100:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 104:
+				switch {
+				case (m.data)[(m.p)] < 226:
+					if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 226:
+					if 239 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 239 {
+						_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+						if m.gitmoji {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr610
+		case 80:
+			goto tr611
+		case 82:
+			goto tr612
+		case 83:
+			goto tr613
+		case 86:
+			goto tr614
+		case 99:
+			goto tr610
+		case 112:
+			goto tr611
+		case 114:
+			goto tr612
+		case 115:
+			goto tr613
+		case 118:
+			goto tr614
+		case 3104:
+			goto tr615
+		case 3298:
+			goto st468
+		case 3311:
+			goto st473
+		case 3394:
+			goto tr616
+		case 3396:
+			goto tr617
+		case 3398:
+			goto tr618
+		case 3412:
+			goto tr619
+		case 3426:
+			goto tr616
+		case 3428:
+			goto tr617
+		case 3430:
+			goto tr618
+		case 3444:
+			goto tr619
+		case 3650:
+			goto tr620
+		case 3652:
+			goto tr621
+		case 3654:
+			goto tr622
+		case 3656:
+			goto tr623
+		case 3668:
+			goto tr624
+		case 3682:
+			goto tr620
+		case 3684:
+			goto tr621
+		case 3686:
+			goto tr622
+		case 3688:
+			goto tr623
+		case 3700:
+			goto tr624
+		}
+		goto tr0
+	st468:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof468
+		}
+	stCase468:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 128 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3200 {
+			goto st469
+		}
+		goto tr8
+	st469:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof469
+		}
+	stCase469:
+		_widec = int16((m.data)[(m.p)])
+		if 141 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 141 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3213 {
+			goto st470
+		}
+		goto tr8
+	st470:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof470
+		}
+	stCase470:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 226:
+			if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 226:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3298:
+			goto st465
+		case 3312:
+			goto st471
+		}
+		goto tr8
+	tr530:
+
+		m.pb = m.p
+
+		goto st471
+	st471:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof471
+		}
+	stCase471:
+		_widec = int16((m.data)[(m.p)])
+		if 159 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3231 {
+			goto st472
+		}
+		goto tr8
+	st472:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof472
+		}
+	stCase472:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 171 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if 3200 <= _widec && _widec <= 3243 {
+			goto st466
+		}
+		goto tr8
+	st473:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof473
+		}
+	stCase473:
+		_widec = int16((m.data)[(m.p)])
+		if 184 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 184 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3256 {
+			goto st474
+		}
+		goto tr8
+	st474:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof474
+		}
+	stCase474:
+		_widec = int16((m.data)[(m.p)])
+		if 143 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3215 {
+			goto st475
+		}
+		goto tr8
+	st475:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof475
+		}
+	stCase475:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 84:
+			switch {
+			case (m.data)[(m.p)] < 68:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 66 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 66 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 68:
+				switch {
+				case (m.data)[(m.p)] > 70:
+					if 72 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 72 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 70:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 84:
+			switch {
+			case (m.data)[(m.p)] < 102:
+				switch {
+				case (m.data)[(m.p)] > 98:
+					if 100 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 100 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 98:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 102:
+				switch {
+				case (m.data)[(m.p)] < 116:
+					if 104 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 104 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 116:
+					if 226 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 226 {
+						_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+						if m.gitmoji {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr610
+		case 80:
+			goto tr611
+		case 82:
+			goto tr612
+		case 83:
+			goto tr613
+		case 86:
+			goto tr614
+		case 99:
+			goto tr610
+		case 112:
+			goto tr611
+		case 114:
+			goto tr612
+		case 115:
+			goto tr613
+		case 118:
+			goto tr614
+		case 3104:
+			goto tr615
+		case 3298:
+			goto st468
+		case 3394:
+			goto tr616
+		case 3396:
+			goto tr617
+		case 3398:
+			goto tr618
+		case 3412:
+			goto tr619
+		case 3426:
+			goto tr616
+		case 3428:
+			goto tr617
+		case 3430:
+			goto tr618
+		case 3444:
+			goto tr619
+		case 3650:
+			goto tr620
+		case 3652:
+			goto tr621
+		case 3654:
+			goto tr622
+		case 3656:
+			goto tr623
+		case 3668:
+			goto tr624
+		case 3682:
+			goto tr620
+		case 3684:
+			goto tr621
+		case 3686:
+			goto tr622
+		case 3688:
+			goto tr623
+		case 3700:
+			goto tr624
+		}
+		goto tr0
+	stCase476:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 98:
+			switch {
+			case (m.data)[(m.p)] < 66:
+				if 58 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 58 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 66:
+				switch {
+				case (m.data)[(m.p)] > 68:
+					if 72 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 72 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 68:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 98:
+			switch {
+			case (m.data)[(m.p)] < 104:
+				if 100 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 100 {
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 104:
+				switch {
+				case (m.data)[(m.p)] > 226:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+						if m.gitmoji {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 226:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr661
+		case 70:
+			goto tr662
+		case 78:
+			goto tr663
+		case 85:
+			goto tr664
+		case 99:
+			goto tr661
+		case 102:
+			goto tr662
+		case 110:
+			goto tr663
+		case 117:
+			goto tr664
+		case 3130:
+			goto tr665
+		case 3298:
+			goto tr666
+		case 3312:
+			goto tr667
+		case 3394:
+			goto tr668
+		case 3396:
+			goto tr669
+		case 3426:
+			goto tr668
+		case 3428:
+			goto tr669
+		case 3650:
+			goto tr670
+		case 3652:
+			goto tr671
+		case 3656:
+			goto tr672
+		case 3682:
+			goto tr670
+		case 3684:
+			goto tr671
+		case 3688:
+			goto tr672
+		}
+		goto tr0
+	tr661:
+
+		m.pb = m.p
+
+		goto st477
+	tr739:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st477
+	st477:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof477
+		}
+	stCase477:
+		switch (m.data)[(m.p)] {
+		case 72:
+			goto st478
+		case 104:
+			goto st478
+		}
+		goto tr0
+	st478:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof478
+		}
+	stCase478:
+		switch (m.data)[(m.p)] {
+		case 79:
+			goto st479
+		case 111:
+			goto st479
+		}
+		goto tr0
+	st479:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof479
+		}
+	stCase479:
+		switch (m.data)[(m.p)] {
+		case 82:
+			goto st480
+		case 114:
+			goto st480
+		}
+		goto tr0
+	st480:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof480
+		}
+	stCase480:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st481
+		case 101:
+			goto st481
+		}
+		goto tr0
+	st481:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof481
+		}
+	stCase481:
+
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		switch (m.data)[(m.p)] {
+		case 33:
+			goto tr677
+		case 40:
+			goto st502
+		case 58:
+			goto st483
+		}
+		goto tr21
+	tr677:
+
+		output.exclamation = true
+		if m.logger != nil {
+			m.logger.Info("commit message communicates a breaking change")
+		}
+		if m.listener != nil {
+			m.listener.OnBreaking(conventionalcommits.Span{Start: m.p, End: m.p + 1})
+		}
+
+		goto st482
+	st482:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof482
+		}
+	stCase482:
+		if (m.data)[(m.p)] == 58 {
+			goto st483
+		}
+		goto tr21
+	st483:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof483
+		}
+	stCase483:
+		if (m.data)[(m.p)] == 32 {
+			goto st484
+		}
+		goto tr25
+	st484:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof484
+		}
+	stCase484:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 11:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 9 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 9 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 31:
+				switch {
+				case (m.data)[(m.p)] < 33:
+					if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3872:
+			goto st493
+		case 4064:
+			goto tr684
+		case 4077:
+			goto tr686
+		case 4080:
+			goto tr687
+		case 4084:
+			goto tr689
+		case 4320:
+			goto tr692
+		case 4333:
+			goto tr694
+		case 4336:
+			goto tr695
+		case 4340:
+			goto tr697
+		}
+		switch {
+		case _widec < 4096:
+			switch {
+			case _widec < 4034:
+				switch {
+				case _widec > 3849:
+					if 3851 <= _widec && _widec <= 3967 {
+						goto tr681
+					}
+				case _widec >= 3840:
+					goto tr681
+				}
+			case _widec > 4063:
+				switch {
+				case _widec > 4079:
+					if 4081 <= _widec && _widec <= 4083 {
+						goto tr688
+					}
+				case _widec >= 4065:
+					goto tr685
+				}
+			default:
+				goto tr683
+			}
+		case _widec > 4104:
+			switch {
+			case _widec < 4290:
+				switch {
+				case _widec > 4127:
+					if 4129 <= _widec && _widec <= 4223 {
+						goto tr690
+					}
+				case _widec >= 4107:
+					goto tr690
+				}
+			case _widec > 4319:
+				switch {
+				case _widec > 4335:
+					if 4337 <= _widec && _widec <= 4339 {
+						goto tr696
+					}
+				case _widec >= 4321:
+					goto tr693
+				}
+			default:
+				goto tr691
+			}
+		default:
+			goto tr690
+		}
+		goto tr27
+	tr681:
+
+		m.pb = m.p
+
+		goto st676
+	st676:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof676
+		}
+	stCase676:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 225:
+			switch {
+			case (m.data)[(m.p)] < 11:
+				if (m.data)[(m.p)] <= 9 {
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 127:
+				switch {
+				case (m.data)[(m.p)] > 223:
+					if 224 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 224 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 194:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 236:
+			switch {
+			case (m.data)[(m.p)] < 240:
+				switch {
+				case (m.data)[(m.p)] > 237:
+					if 238 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 239 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 237:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 240:
+				switch {
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 241:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 10:
+			goto tr971
+		case 4064:
+			goto st487
+		case 4077:
+			goto st489
+		case 4080:
+			goto st490
+		case 4084:
+			goto st492
+		}
+		switch {
+		case _widec < 4034:
+			switch {
+			case _widec > 3849:
+				if 3851 <= _widec && _widec <= 3967 {
+					goto st676
+				}
+			case _widec >= 3840:
+				goto st676
+			}
+		case _widec > 4063:
+			switch {
+			case _widec > 4079:
+				if 4081 <= _widec && _widec <= 4083 {
+					goto st491
+				}
+			case _widec >= 4065:
+				goto st488
+			}
+		default:
+			goto st486
+		}
+		goto tr27
+	tr971:
+
+		output.descr = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message description", "description", output.descr)
+		}
+		if m.listener != nil {
+			m.listener.OnDescription(output.descr, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		if m.err = m.checkHeader(); m.err != nil {
+			if m.recovery {
+				// Record the error and continue, the header is well-formed anyway
+				m.recover()
+			} else {
+				{
+					(m.p)++
+					m.cs = 485
+					goto _out
+				}
+			}
+		}
+
+		goto st485
+	st485:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof485
+		}
+	stCase485:
+		if (m.data)[(m.p)] == 10 {
+			goto tr698
+		}
+		goto tr45
+	tr698:
+
+		if m.logger != nil {
+			m.logger.Debug("found a blank line", "pos", m.p)
+		}
+
+		// Mark the next character so that rewinds can not go back before it
+		// Eg., when it is not a possible trailer token start
+		m.pb = m.p + 1
+		if m.bodyStart < 0 {
+			// The first time is right after the header
+			m.bodyStart = m.pb
+		}
+		if m.gitTrailers {
+			// The footer is the trailer block git finds, already cut off from the input
+			{
+				goto st660
+			}
+		}
+		if m.logger != nil {
+			m.logger.Debug("try to parse a footer trailer token", "pos", m.p)
+		}
+		{
+			goto st654
+		}
+
+		goto st677
+	st677:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof677
+		}
+	stCase677:
+		goto st0
+	tr683:
+
+		m.pb = m.p
+
+		goto st486
+	st486:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof486
+		}
+	stCase486:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st676
+		}
+		goto tr27
+	tr684:
+
+		m.pb = m.p
+
+		goto st487
+	st487:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof487
+		}
+	stCase487:
+		_widec = int16((m.data)[(m.p)])
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4000 <= _widec && _widec <= 4031 {
+			goto st486
+		}
+		goto tr27
+	tr685:
+
+		m.pb = m.p
+
+		goto st488
+	st488:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof488
+		}
+	stCase488:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st486
+		}
+		goto tr27
+	tr686:
+
+		m.pb = m.p
+
+		goto st489
+	st489:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof489
+		}
+	stCase489:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 3999 {
+			goto st486
+		}
+		goto tr27
+	tr687:
+
+		m.pb = m.p
+
+		goto st490
+	st490:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof490
+		}
+	stCase490:
+		_widec = int16((m.data)[(m.p)])
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3984 <= _widec && _widec <= 4031 {
+			goto st488
+		}
+		goto tr27
+	tr688:
+
+		m.pb = m.p
+
+		goto st491
+	st491:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof491
+		}
+	stCase491:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st488
+		}
+		goto tr27
+	tr689:
+
+		m.pb = m.p
+
+		goto st492
+	st492:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof492
+		}
+	stCase492:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 3983 {
+			goto st488
+		}
+		goto tr27
+	st493:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof493
+		}
+	stCase493:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 9:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3872:
+			goto st493
+		case 4064:
+			goto tr684
+		case 4077:
+			goto tr686
+		case 4080:
+			goto tr687
+		case 4084:
+			goto tr689
+		}
+		switch {
+		case _widec < 4034:
+			switch {
+			case _widec > 3849:
+				if 3851 <= _widec && _widec <= 3967 {
+					goto tr681
+				}
+			case _widec >= 3840:
+				goto tr681
+			}
+		case _widec > 4063:
+			switch {
+			case _widec > 4079:
+				if 4081 <= _widec && _widec <= 4083 {
+					goto tr688
+				}
+			case _widec >= 4065:
+				goto tr685
+			}
+		default:
+			goto tr683
+		}
+		goto tr27
+	tr690:
+
+		m.pb = m.p
+
+		goto st678
+	st678:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof678
+		}
+	stCase678:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 10:
+			goto tr971
+		case 4128:
+			goto st494
+		case 4320:
+			goto st496
+		case 4333:
+			goto st498
+		case 4336:
+			goto st499
+		case 4340:
+			goto st501
+		}
+		switch {
+		case _widec < 4290:
+			switch {
+			case _widec > 4104:
+				if 4107 <= _widec && _widec <= 4223 {
+					goto st678
+				}
+			case _widec >= 4096:
+				goto st678
+			}
+		case _widec > 4319:
+			switch {
+			case _widec > 4335:
+				if 4337 <= _widec && _widec <= 4339 {
+					goto st500
+				}
+			case _widec >= 4321:
+				goto st497
+			}
+		default:
+			goto st495
+		}
+		goto tr50
+	st494:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof494
+		}
+	stCase494:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 4128:
+			goto st494
+		case 4320:
+			goto st496
+		case 4333:
+			goto st498
+		case 4336:
+			goto st499
+		case 4340:
+			goto st501
+		}
+		switch {
+		case _widec < 4290:
+			switch {
+			case _widec > 4104:
+				if 4107 <= _widec && _widec <= 4223 {
+					goto st678
+				}
+			case _widec >= 4096:
+				goto st678
+			}
+		case _widec > 4319:
+			switch {
+			case _widec > 4335:
+				if 4337 <= _widec && _widec <= 4339 {
+					goto st500
+				}
+			case _widec >= 4321:
+				goto st497
+			}
+		default:
+			goto st495
+		}
+		goto tr50
+	tr691:
+
+		m.pb = m.p
+
+		goto st495
+	st495:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof495
+		}
+	stCase495:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st678
+		}
+		goto tr50
+	tr692:
+
+		m.pb = m.p
+
+		goto st496
+	st496:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof496
+		}
+	stCase496:
+		_widec = int16((m.data)[(m.p)])
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4256 <= _widec && _widec <= 4287 {
+			goto st495
+		}
+		goto tr50
+	tr693:
+
+		m.pb = m.p
+
+		goto st497
+	st497:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof497
+		}
+	stCase497:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st495
+		}
+		goto tr50
+	tr694:
+
+		m.pb = m.p
+
+		goto st498
+	st498:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof498
+		}
+	stCase498:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4255 {
+			goto st495
+		}
+		goto tr50
+	tr695:
+
+		m.pb = m.p
+
+		goto st499
+	st499:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof499
+		}
+	stCase499:
+		_widec = int16((m.data)[(m.p)])
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4240 <= _widec && _widec <= 4287 {
+			goto st497
+		}
+		goto tr50
+	tr696:
+
+		m.pb = m.p
+
+		goto st500
+	st500:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof500
+		}
+	stCase500:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st497
+		}
+		goto tr50
+	tr697:
+
+		m.pb = m.p
+
+		goto st501
+	st501:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof501
+		}
+	stCase501:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4239 {
+			goto st497
+		}
+		goto tr50
+	st502:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof502
+		}
+	stCase502:
+		switch (m.data)[(m.p)] {
+		case 41:
+			goto tr712
+		case 224:
+			goto tr714
+		case 237:
+			goto tr716
+		case 240:
+			goto tr717
+		case 244:
+			goto tr719
+		}
+		switch {
+		case (m.data)[(m.p)] < 194:
+			switch {
+			case (m.data)[(m.p)] > 39:
+				if 42 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+					goto tr711
+				}
+			case (m.data)[(m.p)] >= 32:
+				goto tr711
+			}
+		case (m.data)[(m.p)] > 223:
+			switch {
+			case (m.data)[(m.p)] > 239:
+				if 241 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 243 {
+					goto tr718
+				}
+			case (m.data)[(m.p)] >= 225:
+				goto tr715
+			}
+		default:
+			goto tr713
+		}
+		goto tr60
+	tr711:
+
+		m.pb = m.p
+
+		goto st503
+	st503:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof503
+		}
+	stCase503:
+		switch (m.data)[(m.p)] {
+		case 41:
+			goto tr721
+		case 224:
+			goto st506
+		case 237:
+			goto st508
+		case 240:
+			goto st509
+		case 244:
+			goto st511
+		}
+		switch {
+		case (m.data)[(m.p)] < 194:
+			switch {
+			case (m.data)[(m.p)] > 39:
+				if 42 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+					goto st503
+				}
+			case (m.data)[(m.p)] >= 32:
+				goto st503
+			}
+		case (m.data)[(m.p)] > 223:
+			switch {
+			case (m.data)[(m.p)] > 239:
+				if 241 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 243 {
+					goto st510
+				}
+			case (m.data)[(m.p)] >= 225:
+				goto st507
+			}
+		default:
+			goto st505
+		}
+		goto tr60
+	tr712:
+
+		m.pb = m.p
+
+		output.scope = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message scope", "scope", output.scope)
+		}
+		if m.listener != nil {
+			m.listener.OnScope(output.scope, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		goto st504
+	tr721:
+
+		output.scope = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message scope", "scope", output.scope)
+		}
+		if m.listener != nil {
+			m.listener.OnScope(output.scope, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		goto st504
+	st504:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof504
+		}
+	stCase504:
+		switch (m.data)[(m.p)] {
+		case 33:
+			goto tr677
+		case 58:
+			goto st483
+		}
+		goto tr21
+	tr713:
+
+		m.pb = m.p
+
+		goto st505
+	st505:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof505
+		}
+	stCase505:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st503
+		}
+		goto tr60
+	tr714:
+
+		m.pb = m.p
+
+		goto st506
+	st506:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof506
+		}
+	stCase506:
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st505
+		}
+		goto tr60
+	tr715:
+
+		m.pb = m.p
+
+		goto st507
+	st507:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof507
+		}
+	stCase507:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st505
+		}
+		goto tr60
+	tr716:
+
+		m.pb = m.p
+
+		goto st508
+	st508:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof508
+		}
+	stCase508:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			goto st505
+		}
+		goto tr60
+	tr717:
+
+		m.pb = m.p
+
+		goto st509
+	st509:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof509
+		}
+	stCase509:
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st507
+		}
+		goto tr60
+	tr718:
+
+		m.pb = m.p
+
+		goto st510
+	st510:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof510
+		}
+	stCase510:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st507
+		}
+		goto tr60
+	tr719:
+
+		m.pb = m.p
+
+		goto st511
+	st511:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof511
+		}
+	stCase511:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			goto st507
+		}
+		goto tr60
+	tr662:
+
+		m.pb = m.p
+
+		goto st512
+	tr740:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st512
+	st512:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof512
+		}
+	stCase512:
+		switch (m.data)[(m.p)] {
+		case 73:
+			goto st513
+		case 105:
+			goto st513
+		}
+		goto tr0
+	st513:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof513
+		}
+	stCase513:
+		switch (m.data)[(m.p)] {
+		case 88:
+			goto st481
+		case 120:
+			goto st481
+		}
+		goto tr0
+	tr663:
+
+		m.pb = m.p
+
+		goto st514
+	tr741:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st514
+	st514:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof514
+		}
+	stCase514:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st515
+		case 101:
+			goto st515
+		}
+		goto tr0
+	st515:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof515
+		}
+	stCase515:
+		switch (m.data)[(m.p)] {
+		case 87:
+			goto st481
+		case 119:
+			goto st481
+		}
+		goto tr0
+	tr664:
+
+		m.pb = m.p
+
+		goto st516
+	tr742:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st516
+	st516:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof516
+		}
+	stCase516:
+		switch (m.data)[(m.p)] {
+		case 80:
+			goto st517
+		case 112:
+			goto st517
+		}
+		goto tr0
+	st517:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof517
+		}
+	stCase517:
+		switch (m.data)[(m.p)] {
+		case 68:
+			goto st518
+		case 71:
+			goto st520
+		case 100:
+			goto st518
+		case 103:
+			goto st520
+		}
+		goto tr0
+	st518:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof518
+		}
+	stCase518:
+		switch (m.data)[(m.p)] {
+		case 65:
+			goto st519
+		case 97:
+			goto st519
+		}
+		goto tr0
+	st519:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof519
+		}
+	stCase519:
+		switch (m.data)[(m.p)] {
+		case 84:
+			goto st480
+		case 116:
+			goto st480
+		}
+		goto tr0
+	st520:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof520
+		}
+	stCase520:
+		switch (m.data)[(m.p)] {
+		case 82:
+			goto st521
+		case 114:
+			goto st521
+		}
+		goto tr0
+	st521:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof521
+		}
+	stCase521:
+		switch (m.data)[(m.p)] {
+		case 65:
+			goto st522
+		case 97:
+			goto st522
+		}
+		goto tr0
+	st522:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof522
+		}
+	stCase522:
+		switch (m.data)[(m.p)] {
+		case 68:
+			goto st480
+		case 100:
+			goto st480
+		}
+		goto tr0
+	tr665:
+
+		m.pb = m.p
+
+		goto st523
+	st523:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof523
+		}
+	stCase523:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 48:
+			switch {
+			case (m.data)[(m.p)] > 43:
+				if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 43:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 57:
+			switch {
+			case (m.data)[(m.p)] > 95:
+				if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 95:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3115:
+			goto st524
+		case 3117:
+			goto st524
+		case 3167:
+			goto st524
+		}
+		switch {
+		case _widec > 3129:
+			if 3169 <= _widec && _widec <= 3194 {
+				goto st524
+			}
+		case _widec >= 3120:
+			goto st524
+		}
+		goto tr8
+	st524:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof524
+		}
+	stCase524:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 48:
+			switch {
+			case (m.data)[(m.p)] > 43:
+				if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 43:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 57:
+			switch {
+			case (m.data)[(m.p)] < 95:
+				if 58 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 58 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 95:
+				if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3115:
+			goto st524
+		case 3117:
+			goto st524
+		case 3130:
+			goto st525
+		case 3167:
+			goto st524
+		}
+		switch {
+		case _widec > 3129:
+			if 3169 <= _widec && _widec <= 3194 {
+				goto st524
+			}
+		case _widec >= 3120:
+			goto st524
+		}
+		goto tr8
+	st525:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof525
+		}
+	stCase525:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 72:
+			switch {
+			case (m.data)[(m.p)] < 66:
+				if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 66:
+				if 68 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 68 {
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 72:
+			switch {
+			case (m.data)[(m.p)] < 100:
+				if 98 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 98 {
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 100:
+				if 104 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 104 {
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr739
+		case 70:
+			goto tr740
+		case 78:
+			goto tr741
+		case 85:
+			goto tr742
+		case 99:
+			goto tr739
+		case 102:
+			goto tr740
+		case 110:
+			goto tr741
+		case 117:
+			goto tr742
+		case 3104:
+			goto tr743
+		case 3394:
+			goto tr744
+		case 3396:
+			goto tr745
+		case 3426:
+			goto tr744
+		case 3428:
+			goto tr745
+		case 3650:
+			goto tr746
+		case 3652:
+			goto tr747
+		case 3656:
+			goto tr748
+		case 3682:
+			goto tr746
+		case 3684:
+			goto tr747
+		case 3688:
+			goto tr748
+		}
+		goto tr0
+	tr743:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		goto st526
+	st526:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof526
+		}
+	stCase526:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 72:
+			switch {
+			case (m.data)[(m.p)] < 66:
+				if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 66:
+				if 68 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 68 {
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 72:
+			switch {
+			case (m.data)[(m.p)] < 100:
+				if 98 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 98 {
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 100:
+				if 104 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 104 {
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr661
+		case 70:
+			goto tr662
+		case 78:
+			goto tr663
+		case 85:
+			goto tr664
+		case 99:
+			goto tr661
+		case 102:
+			goto tr662
+		case 110:
+			goto tr663
+		case 117:
+			goto tr664
+		case 3104:
+			goto st526
+		case 3394:
+			goto tr668
+		case 3396:
+			goto tr669
+		case 3426:
+			goto tr668
+		case 3428:
+			goto tr669
+		case 3650:
+			goto tr670
+		case 3652:
+			goto tr671
+		case 3656:
+			goto tr672
+		case 3682:
+			goto tr670
+		case 3684:
+			goto tr671
+		case 3688:
+			goto tr672
+		}
+		goto tr0
+	tr668:
+
+		m.pb = m.p
+
+		goto st527
+	tr744:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st527
+	st527:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof527
+		}
+	stCase527:
+		switch (m.data)[(m.p)] {
+		case 82:
+			goto st528
+		case 85:
+			goto st534
+		case 114:
+			goto st528
+		case 117:
+			goto st534
+		}
+		goto tr0
+	st528:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof528
+		}
+	stCase528:
+		switch (m.data)[(m.p)] {
+		case 69:
+			goto st529
+		case 101:
+			goto st529
+		}
+		goto tr0
+	st529:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof529
+		}
+	stCase529:
+		switch (m.data)[(m.p)] {
+		case 65:
+			goto st530
+		case 97:
+			goto st530
+		}
+		goto tr0
+	st530:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof530
+		}
+	stCase530:
+		switch (m.data)[(m.p)] {
+		case 75:
+			goto st531
+		case 107:
+			goto st531
+		}
+		goto tr0
+	st531:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof531
+		}
+	stCase531:
+		switch (m.data)[(m.p)] {
+		case 73:
+			goto st532
+		case 105:
+			goto st532
+		}
+		goto tr0
+	st532:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof532
+		}
+	stCase532:
+		switch (m.data)[(m.p)] {
+		case 78:
+			goto st533
+		case 110:
+			goto st533
+		}
+		goto tr0
+	st533:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof533
+		}
+	stCase533:
+		switch (m.data)[(m.p)] {
+		case 71:
+			goto st481
+		case 103:
+			goto st481
+		}
+		goto tr0
+	st534:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof534
+		}
+	stCase534:
+		switch (m.data)[(m.p)] {
+		case 73:
+			goto st535
+		case 105:
+			goto st535
+		}
+		goto tr0
+	st535:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof535
+		}
+	stCase535:
+		switch (m.data)[(m.p)] {
+		case 76:
+			goto st536
+		case 108:
+			goto st536
+		}
+		goto tr0
+	st536:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof536
+		}
+	stCase536:
+		switch (m.data)[(m.p)] {
+		case 68:
+			goto st481
+		case 100:
+			goto st481
+		}
+		goto tr0
+	tr669:
+
+		m.pb = m.p
+
+		goto st537
+	tr745:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st537
+	st537:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof537
+		}
+	stCase537:
+		switch (m.data)[(m.p)] {
+		case 79:
+			goto st538
+		case 111:
+			goto st538
+		}
+		goto tr0
+	st538:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof538
+		}
+	stCase538:
+		switch (m.data)[(m.p)] {
+		case 67:
+			goto st539
+		case 99:
+			goto st539
+		}
+		goto tr0
+	st539:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof539
+		}
+	stCase539:
+		switch (m.data)[(m.p)] {
+		case 83:
+			goto st481
+		case 115:
+			goto st481
+		}
+		goto tr0
+	tr670:
+
+		m.pb = m.p
+
+		goto st540
+	tr746:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st540
+	st540:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof540
+		}
+	stCase540:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 85:
+			if 117 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 117 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 85:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 82:
+			goto st528
+		case 114:
+			goto st528
+		case 3413:
+			goto st534
+		case 3445:
+			goto st534
+		case 3669:
+			goto st541
+		case 3701:
+			goto st541
+		}
+		goto tr0
+	st541:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof541
+		}
+	stCase541:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 71:
+			if 103 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 103 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 71:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 73:
+			goto st535
+		case 105:
+			goto st535
+		case 3655:
+			goto st542
+		case 3687:
+			goto st542
+		}
+		goto tr0
+	st542:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof542
+		}
+	stCase542:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 70:
+			if 102 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 102 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 70:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3654:
+			goto st543
+		case 3686:
+			goto st543
+		}
+		goto tr0
+	st543:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof543
+		}
+	stCase543:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 73:
+			if 105 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 105 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 73:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3657:
+			goto st544
+		case 3689:
+			goto st544
+		}
+		goto tr0
+	st544:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof544
+		}
+	stCase544:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 88:
+			if 120 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 120 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 88:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3672:
+			goto st481
+		case 3704:
+			goto st481
+		}
+		goto tr0
+	tr671:
+
+		m.pb = m.p
+
+		goto st545
+	tr747:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st545
+	st545:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof545
+		}
+	stCase545:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 79:
+			if 111 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 111 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 79:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3407:
+			goto st538
+		case 3439:
+			goto st538
+		case 3663:
+			goto st546
+		case 3695:
+			goto st546
+		}
+		goto tr0
+	st546:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof546
+		}
+	stCase546:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 67:
+			if 99 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 99 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 67:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3395:
+			goto st539
+		case 3427:
+			goto st539
+		case 3651:
+			goto st547
+		case 3683:
+			goto st547
+		}
+		goto tr0
+	st547:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof547
+		}
+	stCase547:
+
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		switch (m.data)[(m.p)] {
+		case 33:
+			goto tr677
+		case 40:
+			goto st502
+		case 58:
+			goto st483
+		case 83:
+			goto st481
+		case 115:
+			goto st481
+		}
+		goto tr21
+	tr672:
+
+		m.pb = m.p
+
+		goto st548
+	tr748:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st548
+	st548:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof548
+		}
+	stCase548:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 79:
+			if 111 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 111 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 79:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3663:
+			goto st549
+		case 3695:
+			goto st549
+		}
+		goto tr0
+	st549:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof549
+		}
+	stCase549:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 84:
+			if 116 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 116 {
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 84:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3668:
+			goto st542
+		case 3700:
+			goto st542
+		}
+		goto tr0
+	tr666:
+
+		m.pb = m.p
+
+		goto st550
+	st550:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof550
+		}
+	stCase550:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 152:
+			if 140 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 158:
+			if 172 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 175 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch {
+		case _widec < 3224:
+			if 3212 <= _widec && _widec <= 3215 {
+				goto st551
+			}
+		case _widec > 3230:
+			if 3244 <= _widec && _widec <= 3247 {
+				goto st551
+			}
+		default:
+			goto st551
+		}
+		goto tr8
+	st551:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof551
+		}
+	stCase551:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if 3200 <= _widec && _widec <= 3263 {
+			goto st552
+		}
+		goto tr8
+	st552:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof552
+		}
+	stCase552:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 98:
+			switch {
+			case (m.data)[(m.p)] < 66:
+				if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 66:
+				switch {
+				case (m.data)[(m.p)] > 68:
+					if 72 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 72 {
+						_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+						if m.typeAliases {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 68:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 98:
+			switch {
+			case (m.data)[(m.p)] < 104:
+				if 100 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 100 {
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 104:
+				switch {
+				case (m.data)[(m.p)] > 226:
+					if 239 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 239 {
+						_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+						if m.gitmoji {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 226:
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr739
+		case 70:
+			goto tr740
+		case 78:
+			goto tr741
+		case 85:
+			goto tr742
+		case 99:
+			goto tr739
+		case 102:
+			goto tr740
+		case 110:
+			goto tr741
+		case 117:
+			goto tr742
+		case 3104:
+			goto tr743
+		case 3298:
+			goto st553
+		case 3311:
+			goto st558
+		case 3394:
+			goto tr744
+		case 3396:
+			goto tr745
+		case 3426:
+			goto tr744
+		case 3428:
+			goto tr745
+		case 3650:
+			goto tr746
+		case 3652:
+			goto tr747
+		case 3656:
+			goto tr748
+		case 3682:
+			goto tr746
+		case 3684:
+			goto tr747
+		case 3688:
+			goto tr748
+		}
+		goto tr0
+	st553:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof553
+		}
+	stCase553:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 128 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3200 {
+			goto st554
+		}
+		goto tr8
+	st554:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof554
+		}
+	stCase554:
+		_widec = int16((m.data)[(m.p)])
+		if 141 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 141 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3213 {
+			goto st555
+		}
+		goto tr8
+	st555:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof555
+		}
+	stCase555:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 226:
+			if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 226:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3298:
+			goto st550
+		case 3312:
+			goto st556
+		}
+		goto tr8
+	tr667:
+
+		m.pb = m.p
+
+		goto st556
+	st556:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof556
+		}
+	stCase556:
+		_widec = int16((m.data)[(m.p)])
+		if 159 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3231 {
+			goto st557
+		}
+		goto tr8
+	st557:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof557
+		}
+	stCase557:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 171 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if 3200 <= _widec && _widec <= 3243 {
+			goto st551
+		}
+		goto tr8
+	st558:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof558
+		}
+	stCase558:
+		_widec = int16((m.data)[(m.p)])
+		if 184 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 184 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3256 {
+			goto st559
+		}
+		goto tr8
+	st559:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof559
+		}
+	stCase559:
+		_widec = int16((m.data)[(m.p)])
+		if 143 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3215 {
+			goto st560
+		}
+		goto tr8
+	st560:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof560
+		}
+	stCase560:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 72:
+			switch {
+			case (m.data)[(m.p)] < 66:
+				if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 66:
+				if 68 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 68 {
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 72:
+			switch {
+			case (m.data)[(m.p)] < 100:
+				if 98 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 98 {
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 100:
+				switch {
+				case (m.data)[(m.p)] > 104:
+					if 226 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 226 {
+						_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+						if m.gitmoji {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 104:
+					_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+					if m.typeAliases {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+				if m.typeAliases {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3328 + (int16((m.data)[(m.p)]) - 0)
+			if m.typeAliases {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 67:
+			goto tr739
+		case 70:
+			goto tr740
+		case 78:
+			goto tr741
+		case 85:
+			goto tr742
+		case 99:
+			goto tr739
+		case 102:
+			goto tr740
+		case 110:
+			goto tr741
+		case 117:
+			goto tr742
+		case 3104:
+			goto tr743
+		case 3298:
+			goto st553
+		case 3394:
+			goto tr744
+		case 3396:
+			goto tr745
+		case 3426:
+			goto tr744
+		case 3428:
+			goto tr745
+		case 3650:
+			goto tr746
+		case 3652:
+			goto tr747
+		case 3656:
+			goto tr748
+		case 3682:
+			goto tr746
+		case 3684:
+			goto tr747
+		case 3688:
+			goto tr748
+		}
+		goto tr0
+	stCase561:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 226:
+			if 58 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 58 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 226:
+			if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 95:
+			goto tr779
+		case 3130:
+			goto tr780
+		case 3298:
+			goto tr781
+		case 3312:
+			goto tr782
+		}
+		switch {
+		case _widec < 65:
+			if 45 <= _widec && _widec <= 57 {
+				goto tr779
+			}
+		case _widec > 90:
+			if 97 <= _widec && _widec <= 122 {
+				goto tr779
+			}
+		default:
+			goto tr779
+		}
+		goto tr0
+	tr779:
+
+		m.pb = m.p
+
+		goto st562
+	tr818:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st562
+	st562:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof562
+		}
+	stCase562:
+
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		switch (m.data)[(m.p)] {
+		case 58:
+			goto st563
+		case 95:
+			goto st562
+		}
+		switch {
+		case (m.data)[(m.p)] < 65:
+			if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 57 {
+				goto st562
+			}
+		case (m.data)[(m.p)] > 90:
+			if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
+				goto st562
+			}
+		default:
+			goto st562
+		}
+		goto tr21
+	st563:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof563
+		}
+	stCase563:
+		if (m.data)[(m.p)] == 32 {
+			goto st564
+		}
+		goto tr25
+	st564:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof564
+		}
+	stCase564:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 11:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 9 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 9 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 31:
+				switch {
+				case (m.data)[(m.p)] < 33:
+					if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3872:
+			goto st573
+		case 4064:
+			goto tr789
+		case 4077:
+			goto tr791
+		case 4080:
+			goto tr792
+		case 4084:
+			goto tr794
+		case 4320:
+			goto tr797
+		case 4333:
+			goto tr799
+		case 4336:
+			goto tr800
+		case 4340:
+			goto tr802
+		}
+		switch {
+		case _widec < 4096:
+			switch {
+			case _widec < 4034:
+				switch {
+				case _widec > 3849:
+					if 3851 <= _widec && _widec <= 3967 {
+						goto tr786
+					}
+				case _widec >= 3840:
+					goto tr786
+				}
+			case _widec > 4063:
+				switch {
+				case _widec > 4079:
+					if 4081 <= _widec && _widec <= 4083 {
+						goto tr793
+					}
+				case _widec >= 4065:
+					goto tr790
+				}
+			default:
+				goto tr788
+			}
+		case _widec > 4104:
+			switch {
+			case _widec < 4290:
+				switch {
+				case _widec > 4127:
+					if 4129 <= _widec && _widec <= 4223 {
+						goto tr795
+					}
+				case _widec >= 4107:
+					goto tr795
+				}
+			case _widec > 4319:
+				switch {
+				case _widec > 4335:
+					if 4337 <= _widec && _widec <= 4339 {
+						goto tr801
+					}
+				case _widec >= 4321:
+					goto tr798
+				}
+			default:
+				goto tr796
+			}
+		default:
+			goto tr795
+		}
+		goto tr27
+	tr786:
+
+		m.pb = m.p
+
+		goto st679
+	st679:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof679
+		}
+	stCase679:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 225:
+			switch {
+			case (m.data)[(m.p)] < 11:
+				if (m.data)[(m.p)] <= 9 {
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 127:
+				switch {
+				case (m.data)[(m.p)] > 223:
+					if 224 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 224 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 194:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 236:
+			switch {
+			case (m.data)[(m.p)] < 240:
+				switch {
+				case (m.data)[(m.p)] > 237:
+					if 238 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 239 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 237:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 240:
+				switch {
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 241:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 10:
+			goto tr977
+		case 4064:
+			goto st567
+		case 4077:
+			goto st569
+		case 4080:
+			goto st570
+		case 4084:
+			goto st572
+		}
+		switch {
+		case _widec < 4034:
+			switch {
+			case _widec > 3849:
+				if 3851 <= _widec && _widec <= 3967 {
+					goto st679
+				}
+			case _widec >= 3840:
+				goto st679
+			}
+		case _widec > 4063:
+			switch {
+			case _widec > 4079:
+				if 4081 <= _widec && _widec <= 4083 {
+					goto st571
+				}
+			case _widec >= 4065:
+				goto st568
+			}
+		default:
+			goto st566
+		}
+		goto tr27
+	tr977:
+
+		output.descr = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message description", "description", output.descr)
+		}
+		if m.listener != nil {
+			m.listener.OnDescription(output.descr, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		if m.err = m.checkHeader(); m.err != nil {
+			if m.recovery {
+				// Record the error and continue, the header is well-formed anyway
+				m.recover()
+			} else {
+				{
+					(m.p)++
+					m.cs = 565
+					goto _out
+				}
+			}
+		}
+
+		goto st565
+	st565:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof565
+		}
+	stCase565:
+		if (m.data)[(m.p)] == 10 {
+			goto tr803
+		}
+		goto tr45
+	tr803:
+
+		if m.logger != nil {
+			m.logger.Debug("found a blank line", "pos", m.p)
+		}
+
+		// Mark the next character so that rewinds can not go back before it
+		// Eg., when it is not a possible trailer token start
+		m.pb = m.p + 1
+		if m.bodyStart < 0 {
+			// The first time is right after the header
+			m.bodyStart = m.pb
+		}
+		if m.gitTrailers {
+			// The footer is the trailer block git finds, already cut off from the input
+			{
+				goto st660
+			}
+		}
+		if m.logger != nil {
+			m.logger.Debug("try to parse a footer trailer token", "pos", m.p)
+		}
+		{
+			goto st654
+		}
+
+		goto st680
+	st680:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof680
+		}
+	stCase680:
+		goto st0
+	tr788:
+
+		m.pb = m.p
+
+		goto st566
+	st566:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof566
+		}
+	stCase566:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st679
+		}
+		goto tr27
+	tr789:
+
+		m.pb = m.p
+
+		goto st567
+	st567:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof567
+		}
+	stCase567:
+		_widec = int16((m.data)[(m.p)])
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4000 <= _widec && _widec <= 4031 {
+			goto st566
+		}
+		goto tr27
+	tr790:
+
+		m.pb = m.p
+
+		goto st568
+	st568:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof568
+		}
+	stCase568:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st566
+		}
+		goto tr27
+	tr791:
+
+		m.pb = m.p
+
+		goto st569
+	st569:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof569
+		}
+	stCase569:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 3999 {
+			goto st566
+		}
+		goto tr27
+	tr792:
+
+		m.pb = m.p
+
+		goto st570
+	st570:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof570
+		}
+	stCase570:
+		_widec = int16((m.data)[(m.p)])
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3984 <= _widec && _widec <= 4031 {
+			goto st568
+		}
+		goto tr27
+	tr793:
+
+		m.pb = m.p
+
+		goto st571
+	st571:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof571
+		}
+	stCase571:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st568
+		}
+		goto tr27
+	tr794:
+
+		m.pb = m.p
+
+		goto st572
+	st572:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof572
+		}
+	stCase572:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 3983 {
+			goto st568
+		}
+		goto tr27
+	st573:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof573
+		}
+	stCase573:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 9:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3872:
+			goto st573
+		case 4064:
+			goto tr789
+		case 4077:
+			goto tr791
+		case 4080:
+			goto tr792
+		case 4084:
+			goto tr794
+		}
+		switch {
+		case _widec < 4034:
+			switch {
+			case _widec > 3849:
+				if 3851 <= _widec && _widec <= 3967 {
+					goto tr786
+				}
+			case _widec >= 3840:
+				goto tr786
+			}
+		case _widec > 4063:
+			switch {
+			case _widec > 4079:
+				if 4081 <= _widec && _widec <= 4083 {
+					goto tr793
+				}
+			case _widec >= 4065:
+				goto tr790
+			}
+		default:
+			goto tr788
+		}
+		goto tr27
+	tr795:
+
+		m.pb = m.p
+
+		goto st681
+	st681:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof681
+		}
+	stCase681:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 10:
+			goto tr977
+		case 4128:
+			goto st574
+		case 4320:
+			goto st576
+		case 4333:
+			goto st578
+		case 4336:
+			goto st579
+		case 4340:
+			goto st581
+		}
+		switch {
+		case _widec < 4290:
+			switch {
+			case _widec > 4104:
+				if 4107 <= _widec && _widec <= 4223 {
+					goto st681
+				}
+			case _widec >= 4096:
+				goto st681
+			}
+		case _widec > 4319:
+			switch {
+			case _widec > 4335:
+				if 4337 <= _widec && _widec <= 4339 {
+					goto st580
+				}
+			case _widec >= 4321:
+				goto st577
+			}
+		default:
+			goto st575
+		}
+		goto tr50
+	st574:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof574
+		}
+	stCase574:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 4128:
+			goto st574
+		case 4320:
+			goto st576
+		case 4333:
+			goto st578
+		case 4336:
+			goto st579
+		case 4340:
+			goto st581
+		}
+		switch {
+		case _widec < 4290:
+			switch {
+			case _widec > 4104:
+				if 4107 <= _widec && _widec <= 4223 {
+					goto st681
+				}
+			case _widec >= 4096:
+				goto st681
+			}
+		case _widec > 4319:
+			switch {
+			case _widec > 4335:
+				if 4337 <= _widec && _widec <= 4339 {
+					goto st580
+				}
+			case _widec >= 4321:
+				goto st577
+			}
+		default:
+			goto st575
+		}
+		goto tr50
+	tr796:
+
+		m.pb = m.p
+
+		goto st575
+	st575:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof575
+		}
+	stCase575:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st681
+		}
+		goto tr50
+	tr797:
+
+		m.pb = m.p
+
+		goto st576
+	st576:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof576
+		}
+	stCase576:
+		_widec = int16((m.data)[(m.p)])
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4256 <= _widec && _widec <= 4287 {
+			goto st575
+		}
+		goto tr50
+	tr798:
+
+		m.pb = m.p
+
+		goto st577
+	st577:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof577
+		}
+	stCase577:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st575
+		}
+		goto tr50
+	tr799:
+
+		m.pb = m.p
+
+		goto st578
+	st578:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof578
+		}
+	stCase578:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4255 {
+			goto st575
+		}
+		goto tr50
+	tr800:
+
+		m.pb = m.p
+
+		goto st579
+	st579:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof579
+		}
+	stCase579:
+		_widec = int16((m.data)[(m.p)])
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4240 <= _widec && _widec <= 4287 {
+			goto st577
+		}
+		goto tr50
+	tr801:
+
+		m.pb = m.p
+
+		goto st580
+	st580:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof580
+		}
+	stCase580:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st577
+		}
+		goto tr50
+	tr802:
+
+		m.pb = m.p
+
+		goto st581
+	st581:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof581
+		}
+	stCase581:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4239 {
+			goto st577
+		}
+		goto tr50
+	tr780:
+
+		m.pb = m.p
+
+		goto st582
+	st582:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof582
+		}
+	stCase582:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 48:
+			switch {
+			case (m.data)[(m.p)] > 43:
+				if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 43:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 57:
+			switch {
+			case (m.data)[(m.p)] > 95:
+				if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 95:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3115:
+			goto st583
+		case 3117:
+			goto st583
+		case 3167:
+			goto st583
+		}
+		switch {
+		case _widec > 3129:
+			if 3169 <= _widec && _widec <= 3194 {
+				goto st583
+			}
+		case _widec >= 3120:
+			goto st583
+		}
+		goto tr8
+	st583:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof583
+		}
+	stCase583:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 48:
+			switch {
+			case (m.data)[(m.p)] > 43:
+				if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 43:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 57:
+			switch {
+			case (m.data)[(m.p)] < 95:
+				if 58 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 58 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 95:
+				if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3115:
+			goto st583
+		case 3117:
+			goto st583
+		case 3130:
+			goto st584
+		case 3167:
+			goto st583
+		}
+		switch {
+		case _widec > 3129:
+			if 3169 <= _widec && _widec <= 3194 {
+				goto st583
+			}
+		case _widec >= 3120:
+			goto st583
+		}
+		goto tr8
+	st584:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof584
+		}
+	stCase584:
+		_widec = int16((m.data)[(m.p)])
+		if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 95:
+			goto tr818
+		case 3104:
+			goto tr819
+		}
+		switch {
+		case _widec < 65:
+			if 45 <= _widec && _widec <= 57 {
+				goto tr818
+			}
+		case _widec > 90:
+			if 97 <= _widec && _widec <= 122 {
+				goto tr818
+			}
+		default:
+			goto tr818
+		}
+		goto tr0
+	tr819:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		goto st585
+	st585:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof585
+		}
+	stCase585:
+		_widec = int16((m.data)[(m.p)])
+		if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 95:
+			goto tr779
+		case 3104:
+			goto st585
+		}
+		switch {
+		case _widec < 65:
+			if 45 <= _widec && _widec <= 57 {
+				goto tr779
+			}
+		case _widec > 90:
+			if 97 <= _widec && _widec <= 122 {
+				goto tr779
+			}
+		default:
+			goto tr779
+		}
+		goto tr0
+	tr781:
+
+		m.pb = m.p
+
+		goto st586
+	st586:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof586
+		}
+	stCase586:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 152:
+			if 140 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 158:
+			if 172 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 175 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch {
+		case _widec < 3224:
+			if 3212 <= _widec && _widec <= 3215 {
+				goto st587
+			}
+		case _widec > 3230:
+			if 3244 <= _widec && _widec <= 3247 {
+				goto st587
+			}
+		default:
+			goto st587
+		}
+		goto tr8
+	st587:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof587
+		}
+	stCase587:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if 3200 <= _widec && _widec <= 3263 {
+			goto st588
+		}
+		goto tr8
+	st588:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof588
+		}
+	stCase588:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 226:
+			if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 226:
+			if 239 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 239 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 95:
+			goto tr818
+		case 3104:
+			goto tr819
+		case 3298:
+			goto st589
+		case 3311:
+			goto st594
+		}
+		switch {
+		case _widec < 65:
+			if 45 <= _widec && _widec <= 57 {
+				goto tr818
+			}
+		case _widec > 90:
+			if 97 <= _widec && _widec <= 122 {
+				goto tr818
+			}
+		default:
+			goto tr818
+		}
+		goto tr0
+	st589:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof589
+		}
+	stCase589:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 128 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3200 {
+			goto st590
+		}
+		goto tr8
+	st590:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof590
+		}
+	stCase590:
+		_widec = int16((m.data)[(m.p)])
+		if 141 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 141 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3213 {
+			goto st591
+		}
+		goto tr8
+	st591:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof591
+		}
+	stCase591:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 226:
+			if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 226:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3298:
+			goto st586
+		case 3312:
+			goto st592
+		}
+		goto tr8
+	tr782:
+
+		m.pb = m.p
+
+		goto st592
+	st592:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof592
+		}
+	stCase592:
+		_widec = int16((m.data)[(m.p)])
+		if 159 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3231 {
+			goto st593
+		}
+		goto tr8
+	st593:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof593
+		}
+	stCase593:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 171 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if 3200 <= _widec && _widec <= 3243 {
+			goto st587
+		}
+		goto tr8
+	st594:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof594
+		}
+	stCase594:
+		_widec = int16((m.data)[(m.p)])
+		if 184 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 184 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3256 {
+			goto st595
+		}
+		goto tr8
+	st595:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof595
+		}
+	stCase595:
+		_widec = int16((m.data)[(m.p)])
+		if 143 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3215 {
+			goto st596
+		}
+		goto tr8
+	st596:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof596
+		}
+	stCase596:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 32:
+			if 226 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 226 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 32:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 95:
+			goto tr818
+		case 3104:
+			goto tr819
+		case 3298:
+			goto st589
+		}
+		switch {
+		case _widec < 65:
+			if 45 <= _widec && _widec <= 57 {
+				goto tr818
+			}
+		case _widec > 90:
+			if 97 <= _widec && _widec <= 122 {
+				goto tr818
+			}
+		default:
+			goto tr818
+		}
+		goto tr0
+	stCase597:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 226:
+			if 58 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 58 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 226:
+			if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 224:
+			goto tr834
+		case 225:
+			goto tr835
+		case 237:
+			goto tr836
+		case 244:
+			goto tr838
+		case 2874:
+			goto tr832
+		case 3042:
+			goto tr835
+		case 3056:
+			goto tr839
+		case 3130:
+			goto tr840
+		case 3298:
+			goto tr841
+		case 3312:
+			goto tr842
+		}
+		switch {
+		case _widec < 194:
+			switch {
+			case _widec > 57:
+				if 59 <= _widec && _widec <= 126 {
+					goto tr832
+				}
+			case _widec >= 32:
+				goto tr832
+			}
+		case _widec > 223:
+			switch {
+			case _widec > 239:
+				if 241 <= _widec && _widec <= 243 {
+					goto tr837
+				}
+			case _widec >= 227:
+				goto tr835
+			}
+		default:
+			goto tr833
+		}
+		goto tr0
+	tr832:
+
+		m.pb = m.p
+
+		goto st598
+	tr905:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st598
+	st598:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof598
+		}
+	stCase598:
+
+		output._type = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message type", "type", output._type)
+		}
+		// Notify only the complete type (ie., not its prefixes)
+		if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+			m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		switch (m.data)[(m.p)] {
+		case 33:
+			goto tr844
+		case 40:
+			goto st619
+		case 58:
+			goto st600
+		case 224:
+			goto st630
+		case 237:
+			goto st632
+		case 240:
+			goto st633
+		case 244:
+			goto st635
+		}
+		switch {
+		case (m.data)[(m.p)] < 194:
+			if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+				goto st598
+			}
+		case (m.data)[(m.p)] > 223:
+			switch {
+			case (m.data)[(m.p)] > 239:
+				if 241 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 243 {
+					goto st634
+				}
+			case (m.data)[(m.p)] >= 225:
+				goto st631
+			}
+		default:
+			goto st629
+		}
+		goto tr21
+	tr844:
+
+		output.exclamation = true
+		if m.logger != nil {
+			m.logger.Info("commit message communicates a breaking change")
+		}
+		if m.listener != nil {
+			m.listener.OnBreaking(conventionalcommits.Span{Start: m.p, End: m.p + 1})
+		}
+
+		goto st599
+	st599:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof599
+		}
+	stCase599:
+		if (m.data)[(m.p)] == 58 {
+			goto st600
+		}
+		goto tr21
+	st600:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof600
+		}
+	stCase600:
+		if (m.data)[(m.p)] == 32 {
+			goto st601
+		}
+		goto tr25
+	st601:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof601
+		}
+	stCase601:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 11:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 9 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 9 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 31:
+				switch {
+				case (m.data)[(m.p)] < 33:
+					if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3872:
+			goto st610
+		case 4064:
+			goto tr858
+		case 4077:
+			goto tr860
+		case 4080:
+			goto tr861
+		case 4084:
+			goto tr863
+		case 4320:
+			goto tr866
+		case 4333:
+			goto tr868
+		case 4336:
+			goto tr869
+		case 4340:
+			goto tr871
+		}
+		switch {
+		case _widec < 4096:
+			switch {
+			case _widec < 4034:
+				switch {
+				case _widec > 3849:
+					if 3851 <= _widec && _widec <= 3967 {
+						goto tr855
+					}
+				case _widec >= 3840:
+					goto tr855
+				}
+			case _widec > 4063:
+				switch {
+				case _widec > 4079:
+					if 4081 <= _widec && _widec <= 4083 {
+						goto tr862
+					}
+				case _widec >= 4065:
+					goto tr859
+				}
+			default:
+				goto tr857
+			}
+		case _widec > 4104:
+			switch {
+			case _widec < 4290:
+				switch {
+				case _widec > 4127:
+					if 4129 <= _widec && _widec <= 4223 {
+						goto tr864
+					}
+				case _widec >= 4107:
+					goto tr864
+				}
+			case _widec > 4319:
+				switch {
+				case _widec > 4335:
+					if 4337 <= _widec && _widec <= 4339 {
+						goto tr870
+					}
+				case _widec >= 4321:
+					goto tr867
+				}
+			default:
+				goto tr865
+			}
+		default:
+			goto tr864
+		}
+		goto tr27
+	tr855:
+
+		m.pb = m.p
+
+		goto st682
+	st682:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof682
+		}
+	stCase682:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 225:
+			switch {
+			case (m.data)[(m.p)] < 11:
+				if (m.data)[(m.p)] <= 9 {
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 127:
+				switch {
+				case (m.data)[(m.p)] > 223:
+					if 224 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 224 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 194:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 236:
+			switch {
+			case (m.data)[(m.p)] < 240:
+				switch {
+				case (m.data)[(m.p)] > 237:
+					if 238 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 239 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 237:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 240:
+				switch {
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 241:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 10:
+			goto tr983
+		case 4064:
+			goto st604
+		case 4077:
+			goto st606
+		case 4080:
+			goto st607
+		case 4084:
+			goto st609
+		}
+		switch {
+		case _widec < 4034:
+			switch {
+			case _widec > 3849:
+				if 3851 <= _widec && _widec <= 3967 {
+					goto st682
+				}
+			case _widec >= 3840:
+				goto st682
+			}
+		case _widec > 4063:
+			switch {
+			case _widec > 4079:
+				if 4081 <= _widec && _widec <= 4083 {
+					goto st608
+				}
+			case _widec >= 4065:
+				goto st605
+			}
+		default:
+			goto st603
+		}
+		goto tr27
+	tr983:
+
+		output.descr = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message description", "description", output.descr)
+		}
+		if m.listener != nil {
+			m.listener.OnDescription(output.descr, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		if m.err = m.checkHeader(); m.err != nil {
+			if m.recovery {
+				// Record the error and continue, the header is well-formed anyway
+				m.recover()
+			} else {
+				{
+					(m.p)++
+					m.cs = 602
+					goto _out
+				}
+			}
+		}
+
+		goto st602
+	st602:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof602
+		}
+	stCase602:
+		if (m.data)[(m.p)] == 10 {
+			goto tr872
+		}
+		goto tr45
+	tr872:
+
+		if m.logger != nil {
+			m.logger.Debug("found a blank line", "pos", m.p)
+		}
+
+		// Mark the next character so that rewinds can not go back before it
+		// Eg., when it is not a possible trailer token start
+		m.pb = m.p + 1
+		if m.bodyStart < 0 {
+			// The first time is right after the header
+			m.bodyStart = m.pb
+		}
+		if m.gitTrailers {
+			// The footer is the trailer block git finds, already cut off from the input
+			{
+				goto st660
+			}
+		}
+		if m.logger != nil {
+			m.logger.Debug("try to parse a footer trailer token", "pos", m.p)
+		}
+		{
+			goto st654
+		}
+
+		goto st683
+	st683:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof683
+		}
+	stCase683:
+		goto st0
+	tr857:
+
+		m.pb = m.p
+
+		goto st603
+	st603:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof603
+		}
+	stCase603:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st682
+		}
+		goto tr27
+	tr858:
+
+		m.pb = m.p
+
+		goto st604
+	st604:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof604
+		}
+	stCase604:
+		_widec = int16((m.data)[(m.p)])
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4000 <= _widec && _widec <= 4031 {
+			goto st603
+		}
+		goto tr27
+	tr859:
+
+		m.pb = m.p
+
+		goto st605
+	st605:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof605
+		}
+	stCase605:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st603
+		}
+		goto tr27
+	tr860:
+
+		m.pb = m.p
+
+		goto st606
+	st606:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof606
+		}
+	stCase606:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 3999 {
+			goto st603
+		}
+		goto tr27
+	tr861:
+
+		m.pb = m.p
+
+		goto st607
+	st607:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof607
+		}
+	stCase607:
+		_widec = int16((m.data)[(m.p)])
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3984 <= _widec && _widec <= 4031 {
+			goto st605
+		}
+		goto tr27
+	tr862:
+
+		m.pb = m.p
+
+		goto st608
+	st608:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof608
+		}
+	stCase608:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 4031 {
+			goto st605
+		}
+		goto tr27
+	tr863:
+
+		m.pb = m.p
+
+		goto st609
+	st609:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof609
+		}
+	stCase609:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 3968 <= _widec && _widec <= 3983 {
+			goto st605
+		}
+		goto tr27
+	st610:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof610
+		}
+	stCase610:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 9:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3872:
+			goto st610
+		case 4064:
+			goto tr858
+		case 4077:
+			goto tr860
+		case 4080:
+			goto tr861
+		case 4084:
+			goto tr863
+		}
+		switch {
+		case _widec < 4034:
+			switch {
+			case _widec > 3849:
+				if 3851 <= _widec && _widec <= 3967 {
+					goto tr855
+				}
+			case _widec >= 3840:
+				goto tr855
+			}
+		case _widec > 4063:
+			switch {
+			case _widec > 4079:
+				if 4081 <= _widec && _widec <= 4083 {
+					goto tr862
+				}
+			case _widec >= 4065:
+				goto tr859
+			}
+		default:
+			goto tr857
+		}
+		goto tr27
+	tr864:
+
+		m.pb = m.p
+
+		goto st684
+	st684:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof684
+		}
+	stCase684:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 10:
+			goto tr983
+		case 4128:
+			goto st611
+		case 4320:
+			goto st613
+		case 4333:
+			goto st615
+		case 4336:
+			goto st616
+		case 4340:
+			goto st618
+		}
+		switch {
+		case _widec < 4290:
+			switch {
+			case _widec > 4104:
+				if 4107 <= _widec && _widec <= 4223 {
+					goto st684
+				}
+			case _widec >= 4096:
+				goto st684
+			}
+		case _widec > 4319:
+			switch {
+			case _widec > 4335:
+				if 4337 <= _widec && _widec <= 4339 {
+					goto st617
+				}
+			case _widec >= 4321:
+				goto st614
+			}
+		default:
+			goto st612
+		}
+		goto tr50
+	st611:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof611
+		}
+	stCase611:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 224:
+			switch {
+			case (m.data)[(m.p)] < 32:
+				switch {
+				case (m.data)[(m.p)] > 8:
+					if 11 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 31 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 32:
+				switch {
+				case (m.data)[(m.p)] > 127:
+					if 194 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 223 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 224:
+			switch {
+			case (m.data)[(m.p)] < 238:
+				switch {
+				case (m.data)[(m.p)] > 236:
+					if 237 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 237 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 225:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 239:
+				switch {
+				case (m.data)[(m.p)] < 241:
+					if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 243:
+					if 244 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 244 {
+						_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+						if m.strictWhitespace {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+					if m.strictWhitespace {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+				if m.strictWhitespace {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 4128:
+			goto st611
+		case 4320:
+			goto st613
+		case 4333:
+			goto st615
+		case 4336:
+			goto st616
+		case 4340:
+			goto st618
+		}
+		switch {
+		case _widec < 4290:
+			switch {
+			case _widec > 4104:
+				if 4107 <= _widec && _widec <= 4223 {
+					goto st684
+				}
+			case _widec >= 4096:
+				goto st684
+			}
+		case _widec > 4319:
+			switch {
+			case _widec > 4335:
+				if 4337 <= _widec && _widec <= 4339 {
+					goto st617
+				}
+			case _widec >= 4321:
+				goto st614
+			}
+		default:
+			goto st612
+		}
+		goto tr50
+	tr865:
+
+		m.pb = m.p
+
+		goto st612
+	st612:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof612
+		}
+	stCase612:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st684
+		}
+		goto tr50
+	tr866:
+
+		m.pb = m.p
+
+		goto st613
+	st613:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof613
+		}
+	stCase613:
+		_widec = int16((m.data)[(m.p)])
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4256 <= _widec && _widec <= 4287 {
+			goto st612
+		}
+		goto tr50
+	tr867:
+
+		m.pb = m.p
+
+		goto st614
+	st614:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof614
+		}
+	stCase614:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st612
+		}
+		goto tr50
+	tr868:
+
+		m.pb = m.p
+
+		goto st615
+	st615:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof615
+		}
+	stCase615:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4255 {
+			goto st612
+		}
+		goto tr50
+	tr869:
+
+		m.pb = m.p
+
+		goto st616
+	st616:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof616
+		}
+	stCase616:
+		_widec = int16((m.data)[(m.p)])
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4240 <= _widec && _widec <= 4287 {
+			goto st614
+		}
+		goto tr50
+	tr870:
+
+		m.pb = m.p
+
+		goto st617
+	st617:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof617
+		}
+	stCase617:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4287 {
+			goto st614
+		}
+		goto tr50
+	tr871:
+
+		m.pb = m.p
+
+		goto st618
+	st618:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof618
+		}
+	stCase618:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 3840 + (int16((m.data)[(m.p)]) - 0)
+			if m.strictWhitespace {
+				_widec += 256
+			}
+		}
+		if 4224 <= _widec && _widec <= 4239 {
+			goto st614
+		}
+		goto tr50
+	st619:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof619
+		}
+	stCase619:
+		switch (m.data)[(m.p)] {
+		case 41:
+			goto tr886
+		case 224:
+			goto tr888
+		case 237:
+			goto tr890
+		case 240:
+			goto tr891
+		case 244:
+			goto tr893
+		}
+		switch {
+		case (m.data)[(m.p)] < 194:
+			switch {
+			case (m.data)[(m.p)] > 39:
+				if 42 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+					goto tr885
+				}
+			case (m.data)[(m.p)] >= 32:
+				goto tr885
+			}
+		case (m.data)[(m.p)] > 223:
+			switch {
+			case (m.data)[(m.p)] > 239:
+				if 241 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 243 {
+					goto tr892
+				}
+			case (m.data)[(m.p)] >= 225:
+				goto tr889
+			}
+		default:
+			goto tr887
+		}
+		goto tr60
+	tr885:
+
+		m.pb = m.p
+
+		goto st620
+	st620:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof620
+		}
+	stCase620:
+		switch (m.data)[(m.p)] {
+		case 41:
+			goto tr895
+		case 224:
+			goto st623
+		case 237:
+			goto st625
+		case 240:
+			goto st626
+		case 244:
+			goto st628
+		}
+		switch {
+		case (m.data)[(m.p)] < 194:
+			switch {
+			case (m.data)[(m.p)] > 39:
+				if 42 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+					goto st620
+				}
+			case (m.data)[(m.p)] >= 32:
+				goto st620
+			}
+		case (m.data)[(m.p)] > 223:
+			switch {
+			case (m.data)[(m.p)] > 239:
+				if 241 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 243 {
+					goto st627
+				}
+			case (m.data)[(m.p)] >= 225:
+				goto st624
+			}
+		default:
+			goto st622
+		}
+		goto tr60
+	tr886:
+
+		m.pb = m.p
+
+		output.scope = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message scope", "scope", output.scope)
+		}
+		if m.listener != nil {
+			m.listener.OnScope(output.scope, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		goto st621
+	tr895:
+
+		output.scope = m.str(m.pb, m.p)
+		if m.logger != nil {
+			m.logger.Info("valid commit message scope", "scope", output.scope)
+		}
+		if m.listener != nil {
+			m.listener.OnScope(output.scope, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+
+		goto st621
+	st621:
+
+		if (m.p + 1) == m.pe {
+			m.err = m.emitErrorOnCurrentCharacter(ErrEarly)
+		}
+
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof621
+		}
+	stCase621:
+		switch (m.data)[(m.p)] {
+		case 33:
+			goto tr844
+		case 58:
+			goto st600
+		}
+		goto tr21
+	tr887:
+
+		m.pb = m.p
+
+		goto st622
+	st622:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof622
+		}
+	stCase622:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st620
+		}
+		goto tr60
+	tr888:
+
+		m.pb = m.p
+
+		goto st623
+	st623:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof623
+		}
+	stCase623:
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st622
+		}
+		goto tr60
+	tr889:
+
+		m.pb = m.p
+
+		goto st624
+	st624:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof624
+		}
+	stCase624:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st622
+		}
+		goto tr60
+	tr890:
+
+		m.pb = m.p
+
+		goto st625
+	st625:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof625
+		}
+	stCase625:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			goto st622
+		}
+		goto tr60
+	tr891:
+
+		m.pb = m.p
+
+		goto st626
+	st626:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof626
+		}
+	stCase626:
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st624
+		}
+		goto tr60
+	tr892:
+
+		m.pb = m.p
+
+		goto st627
+	st627:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof627
+		}
+	stCase627:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st624
+		}
+		goto tr60
+	tr893:
+
+		m.pb = m.p
+
+		goto st628
+	st628:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof628
+		}
+	stCase628:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			goto st624
+		}
+		goto tr60
+	tr833:
+
+		m.pb = m.p
+
+		goto st629
+	tr906:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st629
+	st629:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof629
+		}
+	stCase629:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st598
+		}
+		goto tr0
+	tr834:
+
+		m.pb = m.p
+
+		goto st630
+	tr907:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st630
+	st630:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof630
+		}
+	stCase630:
+		if 160 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st629
+		}
+		goto tr0
+	tr835:
+
+		m.pb = m.p
+
+		goto st631
+	tr908:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st631
+	st631:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof631
+		}
+	stCase631:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st629
+		}
+		goto tr0
+	tr836:
+
+		m.pb = m.p
+
+		goto st632
+	tr909:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st632
+	st632:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof632
+		}
+	stCase632:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			goto st629
+		}
+		goto tr0
+	tr839:
+
+		m.pb = m.p
+
+		goto st633
+	tr910:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st633
+	st633:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof633
+		}
+	stCase633:
+		if 144 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st631
+		}
+		goto tr0
+	tr837:
+
+		m.pb = m.p
+
+		goto st634
+	tr911:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st634
+	st634:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof634
+		}
+	stCase634:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			goto st631
+		}
+		goto tr0
+	tr838:
+
+		m.pb = m.p
+
+		goto st635
+	tr912:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		m.pb = m.p
+
+		goto st635
+	st635:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof635
+		}
+	stCase635:
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			goto st631
+		}
+		goto tr0
+	tr840:
+
+		m.pb = m.p
+
+		goto st636
+	st636:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof636
+		}
+	stCase636:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 48:
+			switch {
+			case (m.data)[(m.p)] > 43:
+				if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 43:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 57:
+			switch {
+			case (m.data)[(m.p)] > 95:
+				if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 95:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3115:
+			goto st637
+		case 3117:
+			goto st637
+		case 3167:
+			goto st637
+		}
+		switch {
+		case _widec > 3129:
+			if 3169 <= _widec && _widec <= 3194 {
+				goto st637
+			}
+		case _widec >= 3120:
+			goto st637
+		}
+		goto tr8
+	st637:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof637
+		}
+	stCase637:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 48:
+			switch {
+			case (m.data)[(m.p)] > 43:
+				if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] >= 43:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 57:
+			switch {
+			case (m.data)[(m.p)] < 95:
+				if 58 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 58 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 95:
+				if 97 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 122 {
+					_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+					if m.gitmoji {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3115:
+			goto st637
+		case 3117:
+			goto st637
+		case 3130:
+			goto st638
+		case 3167:
+			goto st637
+		}
+		switch {
+		case _widec > 3129:
+			if 3169 <= _widec && _widec <= 3194 {
+				goto st637
+			}
+		case _widec >= 3120:
+			goto st637
+		}
+		goto tr8
+	st638:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof638
+		}
+	stCase638:
+		_widec = int16((m.data)[(m.p)])
+		if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 224:
+			goto tr907
+		case 237:
+			goto tr909
+		case 240:
+			goto tr910
+		case 244:
+			goto tr912
+		case 2848:
+			goto tr905
+		case 3104:
+			goto tr913
+		}
+		switch {
+		case _widec < 194:
+			if 33 <= _widec && _widec <= 126 {
+				goto tr905
+			}
+		case _widec > 223:
+			switch {
+			case _widec > 239:
+				if 241 <= _widec && _widec <= 243 {
+					goto tr911
+				}
+			case _widec >= 225:
+				goto tr908
+			}
+		default:
+			goto tr906
+		}
+		goto tr0
+	tr913:
+
+		output.gitmoji = m.str(m.pb, m.p)
+		m.typeStart = m.p
+		if m.logger != nil {
+			m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+		}
+
+		goto st639
+	st639:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof639
+		}
+	stCase639:
+		_widec = int16((m.data)[(m.p)])
+		if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 224:
+			goto tr834
+		case 237:
+			goto tr836
+		case 240:
+			goto tr839
+		case 244:
+			goto tr838
+		case 2848:
+			goto tr832
+		case 3104:
+			goto st639
+		}
+		switch {
+		case _widec < 194:
+			if 33 <= _widec && _widec <= 126 {
+				goto tr832
+			}
+		case _widec > 223:
+			switch {
+			case _widec > 239:
+				if 241 <= _widec && _widec <= 243 {
+					goto tr837
+				}
+			case _widec >= 225:
+				goto tr835
+			}
+		default:
+			goto tr833
+		}
+		goto tr0
+	tr841:
+
+		m.pb = m.p
+
+		goto st640
+	st640:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof640
+		}
+	stCase640:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 152:
+			if 140 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 158:
+			if 172 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 175 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch {
+		case _widec < 3224:
+			if 3212 <= _widec && _widec <= 3215 {
+				goto st641
+			}
+		case _widec > 3230:
+			if 3244 <= _widec && _widec <= 3247 {
+				goto st641
+			}
+		default:
+			goto st641
+		}
+		goto tr8
+	st641:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof641
+		}
+	stCase641:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 191 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if 3200 <= _widec && _widec <= 3263 {
+			goto st642
+		}
+		goto tr8
+	st642:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof642
+		}
+	stCase642:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 226:
+			if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] > 226:
+			if 239 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 239 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 224:
+			goto tr907
+		case 225:
+			goto tr908
+		case 237:
+			goto tr909
+		case 240:
+			goto tr910
+		case 244:
+			goto tr912
+		case 2848:
+			goto tr905
+		case 3042:
+			goto tr908
+		case 3055:
+			goto tr908
+		case 3104:
+			goto tr913
+		case 3298:
+			goto st643
+		case 3311:
+			goto st648
+		}
+		switch {
+		case _widec < 194:
+			if 33 <= _widec && _widec <= 126 {
+				goto tr905
+			}
+		case _widec > 223:
+			switch {
+			case _widec > 238:
+				if 241 <= _widec && _widec <= 243 {
+					goto tr911
+				}
+			case _widec >= 227:
+				goto tr908
+			}
+		default:
+			goto tr906
+		}
+		goto tr0
+	st643:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof643
+		}
+	stCase643:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 128 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3200 {
+			goto st644
+		}
+		goto tr8
+	st644:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof644
+		}
+	stCase644:
+		_widec = int16((m.data)[(m.p)])
+		if 141 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 141 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3213 {
+			goto st645
+		}
+		goto tr8
+	st645:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof645
+		}
+	stCase645:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 226:
+			if 240 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 240 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 226:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 3298:
+			goto st640
+		case 3312:
+			goto st646
+		}
+		goto tr8
+	tr842:
+
+		m.pb = m.p
+
+		goto st646
+	st646:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof646
+		}
+	stCase646:
+		_widec = int16((m.data)[(m.p)])
+		if 159 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 159 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3231 {
+			goto st647
+		}
+		goto tr8
+	st647:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof647
+		}
+	stCase647:
+		_widec = int16((m.data)[(m.p)])
+		if 128 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 171 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if 3200 <= _widec && _widec <= 3243 {
+			goto st641
+		}
+		goto tr8
+	st648:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof648
+		}
+	stCase648:
+		_widec = int16((m.data)[(m.p)])
+		if 184 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 184 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3256 {
+			goto st649
+		}
+		goto tr8
+	st649:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof649
+		}
+	stCase649:
+		_widec = int16((m.data)[(m.p)])
+		if 143 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 143 {
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		if _widec == 3215 {
+			goto st650
+		}
+		goto tr8
+	st650:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof650
+		}
+	stCase650:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 32:
+			if 226 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 226 {
+				_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+				if m.gitmoji {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 32:
+			_widec = 2816 + (int16((m.data)[(m.p)]) - 0)
+			if m.gitmoji {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 224:
+			goto tr907
+		case 225:
+			goto tr908
+		case 237:
+			goto tr909
+		case 240:
+			goto tr910
+		case 244:
+			goto tr912
+		case 2848:
+			goto tr905
+		case 3042:
+			goto tr908
+		case 3104:
+			goto tr913
+		case 3298:
+			goto st643
+		}
+		switch {
+		case _widec < 194:
+			if 33 <= _widec && _widec <= 126 {
+				goto tr905
+			}
+		case _widec > 223:
+			switch {
+			case _widec > 239:
+				if 241 <= _widec && _widec <= 243 {
+					goto tr911
+				}
+			case _widec >= 227:
+				goto tr908
+			}
+		default:
+			goto tr906
+		}
+		goto tr0
+	tr933:
+
+		// Increment number of newlines to use in case we're still in the body
+		m.countNewlines++
+		m.lastNewline = m.p
+		if m.logger != nil {
+			m.logger.Debug("found a newline", "pos", m.p)
+		}
+
+		goto st654
+	st654:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof654
+		}
+	stCase654:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 65:
+			switch {
+			case (m.data)[(m.p)] < 46:
+				switch {
+				case (m.data)[(m.p)] > 34:
+					if 36 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 44 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] >= 33:
+					_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+					if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+						_widec += 512
+					}
+				}
+			case (m.data)[(m.p)] > 47:
+				switch {
+				case (m.data)[(m.p)] > 57:
+					if 59 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 64 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] >= 48:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+				if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+					_widec += 512
+				}
+			}
+		case (m.data)[(m.p)] > 65:
+			switch {
+			case (m.data)[(m.p)] < 91:
+				switch {
+				case (m.data)[(m.p)] > 66:
+					if 67 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 90 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 66:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 96:
+				switch {
+				case (m.data)[(m.p)] > 122:
+					if 123 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] >= 97:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+				if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+					_widec += 512
+				}
+			}
+		default:
+			_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 10:
+			goto tr933
+		case 1090:
+			goto tr935
+		}
+		switch {
+		case _widec < 2084:
+			switch {
+			case _widec < 1089:
+				if 1072 <= _widec && _widec <= 1081 {
+					goto tr934
+				}
+			case _widec > 1114:
+				switch {
+				case _widec > 1146:
+					if 2081 <= _widec && _widec <= 2082 {
+						goto tr934
+					}
+				case _widec >= 1121:
+					goto tr934
+				}
+			default:
+				goto tr934
+			}
+		case _widec > 2092:
+			switch {
+			case _widec < 2107:
+				if 2094 <= _widec && _widec <= 2095 {
+					goto tr934
+				}
+			case _widec > 2112:
+				switch {
+				case _widec > 2144:
+					if 2171 <= _widec && _widec <= 2174 {
+						goto tr934
+					}
+				case _widec >= 2139:
+					goto tr934
+				}
+			default:
+				goto tr934
+			}
+		default:
+			goto tr934
+		}
+		goto tr100
+	tr934:
+
+		m.pb = m.p
+
+		goto st65
+	st65:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof65
+		}
+	stCase65:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 48:
+			switch {
+			case (m.data)[(m.p)] < 36:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 33 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 34 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 44:
+				switch {
+				case (m.data)[(m.p)] > 45:
+					if 46 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 47 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] >= 45:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+				if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+					_widec += 512
+				}
+			}
+		case (m.data)[(m.p)] > 57:
+			switch {
+			case (m.data)[(m.p)] < 65:
+				switch {
+				case (m.data)[(m.p)] > 58:
+					if 59 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 64 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] >= 58:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 90:
+				switch {
+				case (m.data)[(m.p)] < 97:
+					if 91 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 96 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] > 122:
+					if 123 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				default:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 1056:
+			goto tr101
+		case 1069:
+			goto st67
+		case 1082:
+			goto tr104
+		}
+		switch {
+		case _widec < 2084:
+			switch {
+			case _widec < 1089:
+				if 1072 <= _widec && _widec <= 1081 {
+					goto st65
+				}
+			case _widec > 1114:
+				switch {
+				case _widec > 1146:
+					if 2081 <= _widec && _widec <= 2082 {
+						goto st65
+					}
+				case _widec >= 1121:
+					goto st65
+				}
+			default:
+				goto st65
+			}
+		case _widec > 2092:
+			switch {
+			case _widec < 2107:
+				if 2094 <= _widec && _widec <= 2095 {
+					goto st65
+				}
+			case _widec > 2112:
+				switch {
+				case _widec > 2144:
+					if 2171 <= _widec && _widec <= 2174 {
+						goto st65
+					}
+				case _widec >= 2139:
+					goto st65
+				}
+			default:
+				goto st65
+			}
+		default:
+			goto st65
+		}
+		goto tr100
+	tr101:
+
+		m.currentFooterKey = strings.ToLower(m.str(m.pb, m.p))
+		if m.currentFooterKey == "breaking change" {
+			m.currentFooterKey = "breaking-change"
+		}
+		m.footerKeySpan = conventionalcommits.Span{Start: m.pb, End: m.p}
+		if m.logger != nil {
+			m.logger.Debug("possibly valid footer token", "token", m.currentFooterKey, "pos", m.p)
+		}
+
+		goto st66
+	st66:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof66
+		}
+	stCase66:
+		_widec = int16((m.data)[(m.p)])
+		if 35 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 35 {
+			_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+		}
+		if _widec == 1059 {
+			goto tr105
+		}
+		goto tr100
+	tr105:
+
+		if m.logger != nil {
+			m.logger.Debug("try to parse a footer trailer value", "pos", m.p)
+		}
+		{
+			goto st84
+		}
+
+		goto st655
+	st655:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof655
+		}
+	stCase655:
+		goto st0
+	st67:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof67
+		}
+	stCase67:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 59:
+			switch {
+			case (m.data)[(m.p)] < 36:
+				if 33 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 34 {
+					_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+					if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+						_widec += 512
+					}
+				}
+			case (m.data)[(m.p)] > 44:
+				switch {
+				case (m.data)[(m.p)] > 47:
+					if 48 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 57 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 46:
+					_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+					if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+						_widec += 512
+					}
+				}
+			default:
+				_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+				if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+					_widec += 512
+				}
+			}
+		case (m.data)[(m.p)] > 64:
+			switch {
+			case (m.data)[(m.p)] < 91:
+				if 65 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 90 {
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 96:
+				switch {
+				case (m.data)[(m.p)] > 122:
+					if 123 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] >= 97:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+				if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+					_widec += 512
+				}
+			}
+		default:
+			_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+			if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+				_widec += 512
+			}
+		}
+		switch {
+		case _widec < 2084:
+			switch {
+			case _widec < 1089:
+				if 1072 <= _widec && _widec <= 1081 {
+					goto st65
+				}
+			case _widec > 1114:
+				switch {
+				case _widec > 1146:
+					if 2081 <= _widec && _widec <= 2082 {
+						goto st65
+					}
+				case _widec >= 1121:
+					goto st65
+				}
+			default:
+				goto st65
+			}
+		case _widec > 2092:
+			switch {
+			case _widec < 2107:
+				if 2094 <= _widec && _widec <= 2095 {
+					goto st65
+				}
+			case _widec > 2112:
+				switch {
+				case _widec > 2144:
+					if 2171 <= _widec && _widec <= 2174 {
+						goto st65
+					}
+				case _widec >= 2139:
+					goto st65
+				}
+			default:
+				goto st65
+			}
+		default:
+			goto st65
+		}
+		goto tr100
+	tr104:
+
+		m.currentFooterKey = strings.ToLower(m.str(m.pb, m.p))
+		if m.currentFooterKey == "breaking change" {
+			m.currentFooterKey = "breaking-change"
+		}
+		m.footerKeySpan = conventionalcommits.Span{Start: m.pb, End: m.p}
+		if m.logger != nil {
+			m.logger.Debug("possibly valid footer token", "token", m.currentFooterKey, "pos", m.p)
+		}
+
+		goto st68
+	st68:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof68
+		}
+	stCase68:
+		_widec = int16((m.data)[(m.p)])
+		if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+			_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+		}
+		if _widec == 1056 {
+			goto tr106
+		}
+		goto tr100
+	tr106:
+
+		if m.logger != nil {
+			m.logger.Debug("try to parse a footer trailer value", "pos", m.p)
+		}
+		{
+			goto st84
+		}
+
+		goto st656
+	st656:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof656
+		}
+	stCase656:
+		_widec = int16((m.data)[(m.p)])
+		if 32 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 32 {
+			_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+		}
+		if _widec == 1056 {
+			goto tr106
+		}
+		goto st0
+	tr935:
+
+		m.pb = m.p
+
+		goto st69
+	st69:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof69
+		}
+	stCase69:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 58:
+			switch {
+			case (m.data)[(m.p)] < 36:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 33 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 34 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 44:
+				switch {
+				case (m.data)[(m.p)] < 46:
+					if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 47:
+					if 48 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 57 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+					if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+						_widec += 512
+					}
+				}
+			default:
+				_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+				if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+					_widec += 512
+				}
+			}
+		case (m.data)[(m.p)] > 58:
+			switch {
+			case (m.data)[(m.p)] < 83:
+				switch {
+				case (m.data)[(m.p)] < 65:
+					if 59 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 64 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] > 81:
+					if 82 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 82 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 90:
+				switch {
+				case (m.data)[(m.p)] < 97:
+					if 91 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 96 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] > 122:
+					if 123 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				default:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 1056:
+			goto tr101
+		case 1069:
+			goto st67
+		case 1082:
+			goto tr104
+		case 1106:
+			goto st70
+		}
+		switch {
+		case _widec < 2084:
+			switch {
+			case _widec < 1089:
+				if 1072 <= _widec && _widec <= 1081 {
+					goto st65
+				}
+			case _widec > 1114:
+				switch {
+				case _widec > 1146:
+					if 2081 <= _widec && _widec <= 2082 {
+						goto st65
+					}
+				case _widec >= 1121:
+					goto st65
+				}
+			default:
+				goto st65
+			}
+		case _widec > 2092:
+			switch {
+			case _widec < 2107:
+				if 2094 <= _widec && _widec <= 2095 {
+					goto st65
+				}
+			case _widec > 2112:
+				switch {
+				case _widec > 2144:
+					if 2171 <= _widec && _widec <= 2174 {
+						goto st65
+					}
+				case _widec >= 2139:
+					goto st65
+				}
+			default:
+				goto st65
+			}
+		default:
+			goto st65
+		}
+		goto tr100
+	st70:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof70
+		}
+	stCase70:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 58:
+			switch {
+			case (m.data)[(m.p)] < 36:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 33 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 34 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 44:
+				switch {
+				case (m.data)[(m.p)] < 46:
+					if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 47:
+					if 48 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 57 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+					if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+						_widec += 512
+					}
+				}
+			default:
+				_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+				if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+					_widec += 512
+				}
+			}
+		case (m.data)[(m.p)] > 58:
+			switch {
+			case (m.data)[(m.p)] < 70:
+				switch {
+				case (m.data)[(m.p)] < 65:
+					if 59 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 64 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] > 68:
+					if 69 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 69 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 90:
+				switch {
+				case (m.data)[(m.p)] < 97:
+					if 91 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 96 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] > 122:
+					if 123 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				default:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 1056:
+			goto tr101
+		case 1069:
+			goto st67
+		case 1082:
+			goto tr104
+		case 1093:
+			goto st71
+		}
+		switch {
+		case _widec < 2084:
+			switch {
+			case _widec < 1089:
+				if 1072 <= _widec && _widec <= 1081 {
+					goto st65
+				}
+			case _widec > 1114:
+				switch {
+				case _widec > 1146:
+					if 2081 <= _widec && _widec <= 2082 {
+						goto st65
+					}
+				case _widec >= 1121:
+					goto st65
+				}
+			default:
+				goto st65
+			}
+		case _widec > 2092:
+			switch {
+			case _widec < 2107:
+				if 2094 <= _widec && _widec <= 2095 {
+					goto st65
+				}
+			case _widec > 2112:
+				switch {
+				case _widec > 2144:
+					if 2171 <= _widec && _widec <= 2174 {
+						goto st65
+					}
+				case _widec >= 2139:
+					goto st65
+				}
+			default:
+				goto st65
+			}
+		default:
+			goto st65
+		}
+		goto tr100
+	st71:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof71
+		}
+	stCase71:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 58:
+			switch {
+			case (m.data)[(m.p)] < 36:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 33 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 34 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 44:
+				switch {
+				case (m.data)[(m.p)] < 46:
+					if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 47:
+					if 48 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 57 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+					if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+						_widec += 512
+					}
+				}
+			default:
+				_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+				if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+					_widec += 512
+				}
+			}
+		case (m.data)[(m.p)] > 58:
+			switch {
+			case (m.data)[(m.p)] < 66:
+				switch {
+				case (m.data)[(m.p)] > 64:
+					if 65 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 65 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] >= 59:
+					_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+					if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+						_widec += 512
+					}
+				}
+			case (m.data)[(m.p)] > 90:
+				switch {
+				case (m.data)[(m.p)] < 97:
+					if 91 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 96 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] > 122:
+					if 123 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				default:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 1056:
+			goto tr101
+		case 1069:
+			goto st67
+		case 1082:
+			goto tr104
+		case 1089:
+			goto st72
+		}
+		switch {
+		case _widec < 2084:
+			switch {
+			case _widec < 1090:
+				if 1072 <= _widec && _widec <= 1081 {
+					goto st65
+				}
+			case _widec > 1114:
+				switch {
+				case _widec > 1146:
+					if 2081 <= _widec && _widec <= 2082 {
+						goto st65
+					}
+				case _widec >= 1121:
+					goto st65
+				}
+			default:
+				goto st65
+			}
+		case _widec > 2092:
+			switch {
+			case _widec < 2107:
+				if 2094 <= _widec && _widec <= 2095 {
+					goto st65
+				}
+			case _widec > 2112:
+				switch {
+				case _widec > 2144:
+					if 2171 <= _widec && _widec <= 2174 {
+						goto st65
+					}
+				case _widec >= 2139:
+					goto st65
+				}
+			default:
+				goto st65
+			}
+		default:
+			goto st65
+		}
+		goto tr100
+	st72:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof72
+		}
+	stCase72:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 58:
+			switch {
+			case (m.data)[(m.p)] < 36:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 33 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 34 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 44:
+				switch {
+				case (m.data)[(m.p)] < 46:
+					if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 47:
+					if 48 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 57 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+					if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+						_widec += 512
+					}
+				}
+			default:
+				_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+				if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+					_widec += 512
+				}
+			}
+		case (m.data)[(m.p)] > 58:
+			switch {
+			case (m.data)[(m.p)] < 76:
+				switch {
+				case (m.data)[(m.p)] < 65:
+					if 59 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 64 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] > 74:
+					if 75 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 75 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 90:
+				switch {
+				case (m.data)[(m.p)] < 97:
+					if 91 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 96 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] > 122:
+					if 123 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				default:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 1056:
+			goto tr101
+		case 1069:
+			goto st67
+		case 1082:
+			goto tr104
+		case 1099:
+			goto st73
+		}
+		switch {
+		case _widec < 2084:
+			switch {
+			case _widec < 1089:
+				if 1072 <= _widec && _widec <= 1081 {
+					goto st65
+				}
+			case _widec > 1114:
+				switch {
+				case _widec > 1146:
+					if 2081 <= _widec && _widec <= 2082 {
+						goto st65
+					}
+				case _widec >= 1121:
+					goto st65
+				}
+			default:
+				goto st65
+			}
+		case _widec > 2092:
+			switch {
+			case _widec < 2107:
+				if 2094 <= _widec && _widec <= 2095 {
+					goto st65
+				}
+			case _widec > 2112:
+				switch {
+				case _widec > 2144:
+					if 2171 <= _widec && _widec <= 2174 {
+						goto st65
+					}
+				case _widec >= 2139:
+					goto st65
+				}
+			default:
+				goto st65
+			}
+		default:
+			goto st65
+		}
+		goto tr100
+	st73:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof73
+		}
+	stCase73:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 58:
+			switch {
+			case (m.data)[(m.p)] < 36:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 33 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 34 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 44:
+				switch {
+				case (m.data)[(m.p)] < 46:
+					if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 47:
+					if 48 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 57 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+					if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+						_widec += 512
+					}
+				}
+			default:
+				_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+				if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+					_widec += 512
+				}
+			}
+		case (m.data)[(m.p)] > 58:
+			switch {
+			case (m.data)[(m.p)] < 74:
+				switch {
+				case (m.data)[(m.p)] < 65:
+					if 59 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 64 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] > 72:
+					if 73 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 73 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 90:
+				switch {
+				case (m.data)[(m.p)] < 97:
+					if 91 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 96 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] > 122:
+					if 123 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				default:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 1056:
+			goto tr101
+		case 1069:
+			goto st67
+		case 1082:
+			goto tr104
+		case 1097:
+			goto st74
+		}
+		switch {
+		case _widec < 2084:
+			switch {
+			case _widec < 1089:
+				if 1072 <= _widec && _widec <= 1081 {
+					goto st65
+				}
+			case _widec > 1114:
+				switch {
+				case _widec > 1146:
+					if 2081 <= _widec && _widec <= 2082 {
+						goto st65
+					}
+				case _widec >= 1121:
+					goto st65
+				}
+			default:
+				goto st65
+			}
+		case _widec > 2092:
+			switch {
+			case _widec < 2107:
+				if 2094 <= _widec && _widec <= 2095 {
+					goto st65
+				}
+			case _widec > 2112:
+				switch {
+				case _widec > 2144:
+					if 2171 <= _widec && _widec <= 2174 {
+						goto st65
+					}
+				case _widec >= 2139:
+					goto st65
+				}
+			default:
+				goto st65
+			}
+		default:
+			goto st65
+		}
+		goto tr100
+	st74:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof74
+		}
+	stCase74:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 58:
+			switch {
+			case (m.data)[(m.p)] < 36:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 33 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 34 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 44:
+				switch {
+				case (m.data)[(m.p)] < 46:
+					if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 47:
+					if 48 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 57 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+					if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+						_widec += 512
+					}
+				}
+			default:
+				_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+				if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+					_widec += 512
+				}
+			}
+		case (m.data)[(m.p)] > 58:
+			switch {
+			case (m.data)[(m.p)] < 79:
+				switch {
+				case (m.data)[(m.p)] < 65:
+					if 59 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 64 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] > 77:
+					if 78 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 78 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 90:
+				switch {
+				case (m.data)[(m.p)] < 97:
+					if 91 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 96 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] > 122:
+					if 123 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				default:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 1056:
+			goto tr101
+		case 1069:
+			goto st67
+		case 1082:
+			goto tr104
+		case 1102:
+			goto st75
+		}
+		switch {
+		case _widec < 2084:
+			switch {
+			case _widec < 1089:
+				if 1072 <= _widec && _widec <= 1081 {
+					goto st65
+				}
+			case _widec > 1114:
+				switch {
+				case _widec > 1146:
+					if 2081 <= _widec && _widec <= 2082 {
+						goto st65
+					}
+				case _widec >= 1121:
+					goto st65
+				}
+			default:
+				goto st65
+			}
+		case _widec > 2092:
+			switch {
+			case _widec < 2107:
+				if 2094 <= _widec && _widec <= 2095 {
+					goto st65
+				}
+			case _widec > 2112:
+				switch {
+				case _widec > 2144:
+					if 2171 <= _widec && _widec <= 2174 {
+						goto st65
+					}
+				case _widec >= 2139:
+					goto st65
+				}
+			default:
+				goto st65
+			}
+		default:
+			goto st65
+		}
+		goto tr100
+	st75:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof75
+		}
+	stCase75:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 58:
+			switch {
+			case (m.data)[(m.p)] < 36:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 33 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 34 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 44:
+				switch {
+				case (m.data)[(m.p)] < 46:
+					if 45 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 45 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				case (m.data)[(m.p)] > 47:
+					if 48 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 57 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+					if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+						_widec += 512
+					}
+				}
+			default:
+				_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+				if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+					_widec += 512
+				}
+			}
+		case (m.data)[(m.p)] > 58:
+			switch {
+			case (m.data)[(m.p)] < 72:
+				switch {
+				case (m.data)[(m.p)] < 65:
+					if 59 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 64 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] > 70:
+					if 71 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 71 {
+						_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+					}
+				default:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 90:
+				switch {
+				case (m.data)[(m.p)] < 97:
+					if 91 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 96 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] > 122:
+					if 123 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				default:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 1056:
+			goto tr101
+		case 1069:
+			goto st67
+		case 1082:
+			goto tr104
+		case 1095:
+			goto st76
+		}
+		switch {
+		case _widec < 2084:
+			switch {
+			case _widec < 1089:
+				if 1072 <= _widec && _widec <= 1081 {
+					goto st65
+				}
+			case _widec > 1114:
+				switch {
+				case _widec > 1146:
+					if 2081 <= _widec && _widec <= 2082 {
+						goto st65
+					}
+				case _widec >= 1121:
+					goto st65
+				}
+			default:
+				goto st65
+			}
+		case _widec > 2092:
+			switch {
+			case _widec < 2107:
+				if 2094 <= _widec && _widec <= 2095 {
+					goto st65
+				}
+			case _widec > 2112:
+				switch {
+				case _widec > 2144:
+					if 2171 <= _widec && _widec <= 2174 {
+						goto st65
+					}
+				case _widec >= 2139:
+					goto st65
+				}
+			default:
+				goto st65
+			}
+		default:
+			goto st65
+		}
+		goto tr100
+	st76:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof76
+		}
+	stCase76:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] < 48:
+			switch {
+			case (m.data)[(m.p)] < 36:
+				switch {
+				case (m.data)[(m.p)] > 32:
+					if 33 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 34 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] >= 32:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 44:
+				switch {
+				case (m.data)[(m.p)] > 45:
+					if 46 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 47 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] >= 45:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+				if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+					_widec += 512
+				}
+			}
+		case (m.data)[(m.p)] > 57:
+			switch {
+			case (m.data)[(m.p)] < 65:
+				switch {
+				case (m.data)[(m.p)] > 58:
+					if 59 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 64 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] >= 58:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			case (m.data)[(m.p)] > 90:
+				switch {
+				case (m.data)[(m.p)] < 97:
+					if 91 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 96 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				case (m.data)[(m.p)] > 122:
+					if 123 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 126 {
+						_widec = 1280 + (int16((m.data)[(m.p)]) - 0)
+						if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+							_widec += 256
+						}
+						if m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 {
+							_widec += 512
+						}
+					}
+				default:
+					_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+					if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+						_widec += 256
+					}
+				}
+			default:
+				_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 1056:
+			goto tr114
+		case 1069:
+			goto st67
+		case 1082:
+			goto tr104
+		}
+		switch {
+		case _widec < 2084:
+			switch {
+			case _widec < 1089:
+				if 1072 <= _widec && _widec <= 1081 {
+					goto st65
+				}
+			case _widec > 1114:
+				switch {
+				case _widec > 1146:
+					if 2081 <= _widec && _widec <= 2082 {
+						goto st65
+					}
+				case _widec >= 1121:
+					goto st65
+				}
+			default:
+				goto st65
+			}
+		case _widec > 2092:
+			switch {
+			case _widec < 2107:
+				if 2094 <= _widec && _widec <= 2095 {
+					goto st65
+				}
+			case _widec > 2112:
+				switch {
+				case _widec > 2144:
+					if 2171 <= _widec && _widec <= 2174 {
+						goto st65
+					}
+				case _widec >= 2139:
+					goto st65
+				}
+			default:
+				goto st65
+			}
+		default:
+			goto st65
+		}
+		goto tr100
+	tr114:
+
+		m.currentFooterKey = strings.ToLower(m.str(m.pb, m.p))
+		if m.currentFooterKey == "breaking change" {
+			m.currentFooterKey = "breaking-change"
+		}
+		m.footerKeySpan = conventionalcommits.Span{Start: m.pb, End: m.p}
+		if m.logger != nil {
+			m.logger.Debug("possibly valid footer token", "token", m.currentFooterKey, "pos", m.p)
+		}
+
+		goto st77
+	st77:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof77
+		}
+	stCase77:
+		_widec = int16((m.data)[(m.p)])
+		switch {
+		case (m.data)[(m.p)] > 35:
+			if 67 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 67 {
+				_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+				if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+					_widec += 256
+				}
+			}
+		case (m.data)[(m.p)] >= 35:
+			_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 1059:
+			goto tr105
+		case 1091:
+			goto st78
+		}
+		goto tr100
+	st78:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof78
+		}
+	stCase78:
+		_widec = int16((m.data)[(m.p)])
+		if 72 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 72 {
+			_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+		}
+		if _widec == 1096 {
+			goto st79
+		}
+		goto tr100
+	st79:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof79
+		}
+	stCase79:
+		_widec = int16((m.data)[(m.p)])
+		if 65 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 65 {
+			_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+		}
+		if _widec == 1089 {
+			goto st80
+		}
+		goto tr100
+	st80:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof80
+		}
+	stCase80:
+		_widec = int16((m.data)[(m.p)])
+		if 78 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 78 {
+			_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+		}
+		if _widec == 1102 {
+			goto st81
+		}
+		goto tr100
+	st81:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof81
+		}
+	stCase81:
+		_widec = int16((m.data)[(m.p)])
+		if 71 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 71 {
+			_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+		}
+		if _widec == 1095 {
+			goto st82
+		}
+		goto tr100
+	st82:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof82
+		}
+	stCase82:
+		_widec = int16((m.data)[(m.p)])
+		if 69 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 69 {
+			_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+		}
+		if _widec == 1093 {
+			goto st83
+		}
+		goto tr100
+	st83:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof83
+		}
+	stCase83:
+		_widec = int16((m.data)[(m.p)])
+		if 58 <= (m.data)[(m.p)] && (m.data)[(m.p)] <= 58 {
+			_widec = 768 + (int16((m.data)[(m.p)]) - 0)
+			if m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() {
+				_widec += 256
+			}
+		}
+		if _widec == 1082 {
+			goto tr104
+		}
+		goto tr100
+	st660:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof660
+		}
+	stCase660:
+		goto tr945
+	tr945:
+
+		m.pb = m.p
+
+		goto st661
+	st661:
+		if (m.p)++; (m.p) == (m.pe) {
+			goto _testEof661
+		}
+	stCase661:
+		goto st661
+	stOut:
+	_testEof2:
+		m.cs = 2
+		goto _testEof
+	_testEof3:
+		m.cs = 3
+		goto _testEof
+	_testEof4:
+		m.cs = 4
+		goto _testEof
+	_testEof5:
+		m.cs = 5
+		goto _testEof
+	_testEof6:
+		m.cs = 6
+		goto _testEof
+	_testEof7:
+		m.cs = 7
+		goto _testEof
+	_testEof8:
+		m.cs = 8
+		goto _testEof
+	_testEof9:
+		m.cs = 9
+		goto _testEof
+	_testEof10:
+		m.cs = 10
+		goto _testEof
+	_testEof11:
+		m.cs = 11
+		goto _testEof
+	_testEof12:
+		m.cs = 12
+		goto _testEof
+	_testEof651:
+		m.cs = 651
+		goto _testEof
+	_testEof13:
+		m.cs = 13
+		goto _testEof
+	_testEof652:
+		m.cs = 652
+		goto _testEof
+	_testEof14:
+		m.cs = 14
+		goto _testEof
+	_testEof15:
+		m.cs = 15
+		goto _testEof
+	_testEof16:
+		m.cs = 16
+		goto _testEof
+	_testEof17:
+		m.cs = 17
+		goto _testEof
+	_testEof18:
+		m.cs = 18
+		goto _testEof
+	_testEof19:
+		m.cs = 19
+		goto _testEof
+	_testEof20:
+		m.cs = 20
+		goto _testEof
+	_testEof21:
+		m.cs = 21
+		goto _testEof
+	_testEof653:
+		m.cs = 653
+		goto _testEof
+	_testEof22:
+		m.cs = 22
+		goto _testEof
+	_testEof23:
+		m.cs = 23
+		goto _testEof
+	_testEof24:
+		m.cs = 24
+		goto _testEof
+	_testEof25:
+		m.cs = 25
+		goto _testEof
+	_testEof26:
+		m.cs = 26
+		goto _testEof
+	_testEof27:
+		m.cs = 27
+		goto _testEof
+	_testEof28:
+		m.cs = 28
+		goto _testEof
+	_testEof29:
+		m.cs = 29
+		goto _testEof
+	_testEof30:
+		m.cs = 30
+		goto _testEof
+	_testEof31:
+		m.cs = 31
+		goto _testEof
+	_testEof32:
+		m.cs = 32
+		goto _testEof
+	_testEof33:
+		m.cs = 33
+		goto _testEof
+	_testEof34:
+		m.cs = 34
+		goto _testEof
+	_testEof35:
+		m.cs = 35
+		goto _testEof
+	_testEof36:
+		m.cs = 36
+		goto _testEof
+	_testEof37:
+		m.cs = 37
+		goto _testEof
+	_testEof38:
+		m.cs = 38
+		goto _testEof
+	_testEof39:
+		m.cs = 39
+		goto _testEof
+	_testEof40:
+		m.cs = 40
+		goto _testEof
+	_testEof41:
+		m.cs = 41
+		goto _testEof
+	_testEof42:
+		m.cs = 42
+		goto _testEof
+	_testEof43:
+		m.cs = 43
+		goto _testEof
+	_testEof44:
+		m.cs = 44
+		goto _testEof
+	_testEof45:
+		m.cs = 45
+		goto _testEof
+	_testEof46:
+		m.cs = 46
+		goto _testEof
+	_testEof47:
+		m.cs = 47
+		goto _testEof
+	_testEof48:
+		m.cs = 48
+		goto _testEof
+	_testEof49:
+		m.cs = 49
+		goto _testEof
+	_testEof50:
+		m.cs = 50
+		goto _testEof
+	_testEof51:
+		m.cs = 51
+		goto _testEof
+	_testEof52:
+		m.cs = 52
+		goto _testEof
+	_testEof53:
+		m.cs = 53
+		goto _testEof
+	_testEof54:
+		m.cs = 54
+		goto _testEof
+	_testEof55:
+		m.cs = 55
+		goto _testEof
+	_testEof56:
+		m.cs = 56
+		goto _testEof
+	_testEof57:
+		m.cs = 57
+		goto _testEof
+	_testEof58:
+		m.cs = 58
+		goto _testEof
+	_testEof59:
+		m.cs = 59
+		goto _testEof
+	_testEof60:
+		m.cs = 60
+		goto _testEof
+	_testEof61:
+		m.cs = 61
+		goto _testEof
+	_testEof62:
+		m.cs = 62
+		goto _testEof
+	_testEof63:
+		m.cs = 63
+		goto _testEof
+	_testEof64:
+		m.cs = 64
+		goto _testEof
+	_testEof84:
+		m.cs = 84
+		goto _testEof
+	_testEof657:
+		m.cs = 657
+		goto _testEof
+	_testEof658:
+		m.cs = 658
+		goto _testEof
+	_testEof85:
+		m.cs = 85
+		goto _testEof
+	_testEof86:
+		m.cs = 86
+		goto _testEof
+	_testEof87:
+		m.cs = 87
+		goto _testEof
+	_testEof88:
+		m.cs = 88
+		goto _testEof
+	_testEof89:
+		m.cs = 89
+		goto _testEof
+	_testEof90:
+		m.cs = 90
+		goto _testEof
+	_testEof91:
+		m.cs = 91
+		goto _testEof
+	_testEof92:
+		m.cs = 92
+		goto _testEof
+	_testEof659:
+		m.cs = 659
+		goto _testEof
+	_testEof93:
+		m.cs = 93
+		goto _testEof
+	_testEof662:
+		m.cs = 662
+		goto _testEof
+	_testEof94:
+		m.cs = 94
+		goto _testEof
+	_testEof663:
+		m.cs = 663
+		goto _testEof
+	_testEof96:
+		m.cs = 96
+		goto _testEof
+	_testEof97:
+		m.cs = 97
+		goto _testEof
+	_testEof98:
+		m.cs = 98
+		goto _testEof
+	_testEof99:
+		m.cs = 99
+		goto _testEof
+	_testEof100:
+		m.cs = 100
+		goto _testEof
+	_testEof101:
+		m.cs = 101
+		goto _testEof
+	_testEof102:
+		m.cs = 102
+		goto _testEof
+	_testEof103:
+		m.cs = 103
+		goto _testEof
+	_testEof664:
+		m.cs = 664
+		goto _testEof
+	_testEof104:
+		m.cs = 104
+		goto _testEof
+	_testEof665:
+		m.cs = 665
+		goto _testEof
+	_testEof105:
+		m.cs = 105
+		goto _testEof
+	_testEof106:
+		m.cs = 106
+		goto _testEof
+	_testEof107:
+		m.cs = 107
+		goto _testEof
+	_testEof108:
+		m.cs = 108
+		goto _testEof
+	_testEof109:
+		m.cs = 109
+		goto _testEof
+	_testEof110:
+		m.cs = 110
+		goto _testEof
+	_testEof111:
+		m.cs = 111
+		goto _testEof
+	_testEof112:
+		m.cs = 112
+		goto _testEof
+	_testEof666:
+		m.cs = 666
+		goto _testEof
+	_testEof113:
+		m.cs = 113
+		goto _testEof
+	_testEof114:
+		m.cs = 114
+		goto _testEof
+	_testEof115:
+		m.cs = 115
+		goto _testEof
+	_testEof116:
+		m.cs = 116
+		goto _testEof
+	_testEof117:
+		m.cs = 117
+		goto _testEof
+	_testEof118:
+		m.cs = 118
+		goto _testEof
+	_testEof119:
+		m.cs = 119
+		goto _testEof
+	_testEof120:
+		m.cs = 120
+		goto _testEof
+	_testEof121:
+		m.cs = 121
+		goto _testEof
+	_testEof122:
+		m.cs = 122
+		goto _testEof
+	_testEof123:
+		m.cs = 123
+		goto _testEof
+	_testEof124:
+		m.cs = 124
+		goto _testEof
+	_testEof125:
+		m.cs = 125
+		goto _testEof
+	_testEof126:
+		m.cs = 126
+		goto _testEof
+	_testEof127:
+		m.cs = 127
+		goto _testEof
+	_testEof128:
+		m.cs = 128
+		goto _testEof
+	_testEof129:
+		m.cs = 129
+		goto _testEof
+	_testEof130:
+		m.cs = 130
+		goto _testEof
+	_testEof131:
+		m.cs = 131
+		goto _testEof
+	_testEof132:
+		m.cs = 132
+		goto _testEof
+	_testEof133:
+		m.cs = 133
+		goto _testEof
+	_testEof134:
+		m.cs = 134
+		goto _testEof
+	_testEof135:
+		m.cs = 135
+		goto _testEof
+	_testEof136:
+		m.cs = 136
+		goto _testEof
+	_testEof137:
+		m.cs = 137
+		goto _testEof
+	_testEof138:
+		m.cs = 138
+		goto _testEof
+	_testEof139:
+		m.cs = 139
+		goto _testEof
+	_testEof140:
+		m.cs = 140
+		goto _testEof
+	_testEof141:
+		m.cs = 141
+		goto _testEof
+	_testEof142:
+		m.cs = 142
+		goto _testEof
+	_testEof143:
+		m.cs = 143
+		goto _testEof
+	_testEof144:
+		m.cs = 144
+		goto _testEof
+	_testEof145:
+		m.cs = 145
+		goto _testEof
+	_testEof146:
+		m.cs = 146
+		goto _testEof
+	_testEof147:
+		m.cs = 147
+		goto _testEof
+	_testEof148:
+		m.cs = 148
+		goto _testEof
+	_testEof149:
+		m.cs = 149
+		goto _testEof
+	_testEof150:
+		m.cs = 150
+		goto _testEof
+	_testEof151:
+		m.cs = 151
+		goto _testEof
+	_testEof152:
+		m.cs = 152
+		goto _testEof
+	_testEof153:
+		m.cs = 153
+		goto _testEof
+	_testEof154:
+		m.cs = 154
+		goto _testEof
+	_testEof155:
+		m.cs = 155
+		goto _testEof
+	_testEof156:
+		m.cs = 156
+		goto _testEof
+	_testEof157:
+		m.cs = 157
+		goto _testEof
+	_testEof158:
+		m.cs = 158
+		goto _testEof
+	_testEof159:
+		m.cs = 159
+		goto _testEof
+	_testEof160:
+		m.cs = 160
+		goto _testEof
+	_testEof161:
+		m.cs = 161
+		goto _testEof
+	_testEof162:
+		m.cs = 162
+		goto _testEof
+	_testEof163:
+		m.cs = 163
+		goto _testEof
+	_testEof164:
+		m.cs = 164
+		goto _testEof
+	_testEof165:
+		m.cs = 165
+		goto _testEof
+	_testEof166:
+		m.cs = 166
+		goto _testEof
+	_testEof167:
+		m.cs = 167
+		goto _testEof
+	_testEof168:
+		m.cs = 168
+		goto _testEof
+	_testEof169:
+		m.cs = 169
+		goto _testEof
+	_testEof170:
+		m.cs = 170
+		goto _testEof
+	_testEof171:
+		m.cs = 171
+		goto _testEof
+	_testEof172:
+		m.cs = 172
+		goto _testEof
+	_testEof173:
+		m.cs = 173
+		goto _testEof
+	_testEof174:
+		m.cs = 174
+		goto _testEof
+	_testEof175:
+		m.cs = 175
+		goto _testEof
+	_testEof176:
+		m.cs = 176
+		goto _testEof
+	_testEof177:
+		m.cs = 177
+		goto _testEof
+	_testEof178:
+		m.cs = 178
+		goto _testEof
+	_testEof179:
+		m.cs = 179
+		goto _testEof
+	_testEof180:
+		m.cs = 180
+		goto _testEof
+	_testEof181:
+		m.cs = 181
+		goto _testEof
+	_testEof182:
+		m.cs = 182
+		goto _testEof
+	_testEof183:
+		m.cs = 183
+		goto _testEof
+	_testEof184:
+		m.cs = 184
+		goto _testEof
+	_testEof185:
+		m.cs = 185
+		goto _testEof
+	_testEof186:
+		m.cs = 186
+		goto _testEof
+	_testEof187:
+		m.cs = 187
+		goto _testEof
+	_testEof188:
+		m.cs = 188
+		goto _testEof
+	_testEof189:
+		m.cs = 189
+		goto _testEof
+	_testEof190:
+		m.cs = 190
+		goto _testEof
+	_testEof191:
+		m.cs = 191
+		goto _testEof
+	_testEof192:
+		m.cs = 192
+		goto _testEof
+	_testEof193:
+		m.cs = 193
+		goto _testEof
+	_testEof195:
+		m.cs = 195
+		goto _testEof
+	_testEof196:
+		m.cs = 196
+		goto _testEof
+	_testEof197:
+		m.cs = 197
+		goto _testEof
+	_testEof198:
+		m.cs = 198
+		goto _testEof
+	_testEof199:
+		m.cs = 199
+		goto _testEof
+	_testEof200:
+		m.cs = 200
+		goto _testEof
+	_testEof201:
+		m.cs = 201
+		goto _testEof
+	_testEof202:
+		m.cs = 202
+		goto _testEof
+	_testEof667:
+		m.cs = 667
+		goto _testEof
+	_testEof203:
+		m.cs = 203
+		goto _testEof
+	_testEof668:
+		m.cs = 668
+		goto _testEof
+	_testEof204:
+		m.cs = 204
+		goto _testEof
+	_testEof205:
+		m.cs = 205
+		goto _testEof
+	_testEof206:
+		m.cs = 206
+		goto _testEof
+	_testEof207:
+		m.cs = 207
+		goto _testEof
+	_testEof208:
+		m.cs = 208
+		goto _testEof
+	_testEof209:
+		m.cs = 209
+		goto _testEof
+	_testEof210:
+		m.cs = 210
+		goto _testEof
+	_testEof211:
+		m.cs = 211
+		goto _testEof
+	_testEof669:
+		m.cs = 669
+		goto _testEof
+	_testEof212:
+		m.cs = 212
+		goto _testEof
+	_testEof213:
+		m.cs = 213
+		goto _testEof
+	_testEof214:
+		m.cs = 214
+		goto _testEof
+	_testEof215:
+		m.cs = 215
+		goto _testEof
+	_testEof216:
+		m.cs = 216
+		goto _testEof
+	_testEof217:
+		m.cs = 217
+		goto _testEof
+	_testEof218:
+		m.cs = 218
+		goto _testEof
+	_testEof219:
+		m.cs = 219
+		goto _testEof
+	_testEof220:
+		m.cs = 220
+		goto _testEof
+	_testEof221:
+		m.cs = 221
+		goto _testEof
+	_testEof222:
+		m.cs = 222
+		goto _testEof
+	_testEof223:
+		m.cs = 223
+		goto _testEof
+	_testEof224:
+		m.cs = 224
+		goto _testEof
+	_testEof225:
+		m.cs = 225
+		goto _testEof
+	_testEof226:
+		m.cs = 226
+		goto _testEof
+	_testEof227:
+		m.cs = 227
+		goto _testEof
+	_testEof228:
+		m.cs = 228
+		goto _testEof
+	_testEof229:
+		m.cs = 229
+		goto _testEof
+	_testEof230:
+		m.cs = 230
+		goto _testEof
+	_testEof231:
+		m.cs = 231
+		goto _testEof
+	_testEof232:
+		m.cs = 232
+		goto _testEof
+	_testEof233:
+		m.cs = 233
+		goto _testEof
+	_testEof234:
+		m.cs = 234
+		goto _testEof
+	_testEof235:
+		m.cs = 235
+		goto _testEof
+	_testEof236:
+		m.cs = 236
+		goto _testEof
+	_testEof237:
+		m.cs = 237
+		goto _testEof
+	_testEof238:
+		m.cs = 238
+		goto _testEof
+	_testEof239:
+		m.cs = 239
+		goto _testEof
+	_testEof240:
+		m.cs = 240
+		goto _testEof
+	_testEof241:
+		m.cs = 241
+		goto _testEof
+	_testEof242:
+		m.cs = 242
+		goto _testEof
+	_testEof243:
+		m.cs = 243
+		goto _testEof
+	_testEof244:
+		m.cs = 244
+		goto _testEof
+	_testEof245:
+		m.cs = 245
+		goto _testEof
+	_testEof246:
+		m.cs = 246
+		goto _testEof
+	_testEof247:
+		m.cs = 247
+		goto _testEof
+	_testEof248:
+		m.cs = 248
+		goto _testEof
+	_testEof249:
+		m.cs = 249
+		goto _testEof
+	_testEof250:
+		m.cs = 250
+		goto _testEof
+	_testEof251:
+		m.cs = 251
+		goto _testEof
+	_testEof252:
+		m.cs = 252
+		goto _testEof
+	_testEof253:
+		m.cs = 253
+		goto _testEof
+	_testEof254:
+		m.cs = 254
+		goto _testEof
+	_testEof255:
+		m.cs = 255
+		goto _testEof
+	_testEof256:
+		m.cs = 256
+		goto _testEof
+	_testEof257:
+		m.cs = 257
+		goto _testEof
+	_testEof258:
+		m.cs = 258
+		goto _testEof
+	_testEof259:
+		m.cs = 259
+		goto _testEof
+	_testEof260:
+		m.cs = 260
+		goto _testEof
+	_testEof261:
+		m.cs = 261
+		goto _testEof
+	_testEof262:
+		m.cs = 262
+		goto _testEof
+	_testEof263:
+		m.cs = 263
+		goto _testEof
+	_testEof264:
+		m.cs = 264
+		goto _testEof
+	_testEof265:
+		m.cs = 265
+		goto _testEof
+	_testEof266:
+		m.cs = 266
+		goto _testEof
+	_testEof267:
+		m.cs = 267
+		goto _testEof
+	_testEof268:
+		m.cs = 268
+		goto _testEof
+	_testEof269:
+		m.cs = 269
+		goto _testEof
+	_testEof270:
+		m.cs = 270
+		goto _testEof
+	_testEof271:
+		m.cs = 271
+		goto _testEof
+	_testEof272:
+		m.cs = 272
+		goto _testEof
+	_testEof273:
+		m.cs = 273
+		goto _testEof
+	_testEof274:
+		m.cs = 274
+		goto _testEof
+	_testEof275:
+		m.cs = 275
+		goto _testEof
+	_testEof276:
+		m.cs = 276
+		goto _testEof
+	_testEof277:
+		m.cs = 277
+		goto _testEof
+	_testEof278:
+		m.cs = 278
+		goto _testEof
+	_testEof279:
+		m.cs = 279
+		goto _testEof
+	_testEof280:
+		m.cs = 280
+		goto _testEof
+	_testEof281:
+		m.cs = 281
+		goto _testEof
+	_testEof282:
+		m.cs = 282
+		goto _testEof
+	_testEof283:
+		m.cs = 283
+		goto _testEof
+	_testEof284:
+		m.cs = 284
+		goto _testEof
+	_testEof285:
+		m.cs = 285
+		goto _testEof
+	_testEof286:
+		m.cs = 286
+		goto _testEof
+	_testEof287:
+		m.cs = 287
+		goto _testEof
+	_testEof288:
+		m.cs = 288
+		goto _testEof
+	_testEof289:
+		m.cs = 289
+		goto _testEof
+	_testEof290:
+		m.cs = 290
+		goto _testEof
+	_testEof291:
+		m.cs = 291
+		goto _testEof
+	_testEof293:
+		m.cs = 293
+		goto _testEof
+	_testEof294:
+		m.cs = 294
+		goto _testEof
+	_testEof295:
+		m.cs = 295
+		goto _testEof
+	_testEof296:
+		m.cs = 296
+		goto _testEof
+	_testEof297:
+		m.cs = 297
+		goto _testEof
+	_testEof670:
+		m.cs = 670
+		goto _testEof
+	_testEof298:
+		m.cs = 298
+		goto _testEof
+	_testEof671:
+		m.cs = 671
+		goto _testEof
+	_testEof299:
+		m.cs = 299
+		goto _testEof
+	_testEof300:
+		m.cs = 300
+		goto _testEof
+	_testEof301:
+		m.cs = 301
+		goto _testEof
+	_testEof302:
+		m.cs = 302
+		goto _testEof
+	_testEof303:
+		m.cs = 303
+		goto _testEof
+	_testEof304:
+		m.cs = 304
+		goto _testEof
+	_testEof305:
+		m.cs = 305
+		goto _testEof
+	_testEof306:
+		m.cs = 306
+		goto _testEof
+	_testEof672:
+		m.cs = 672
+		goto _testEof
+	_testEof307:
+		m.cs = 307
+		goto _testEof
+	_testEof308:
+		m.cs = 308
+		goto _testEof
+	_testEof309:
+		m.cs = 309
+		goto _testEof
+	_testEof310:
+		m.cs = 310
+		goto _testEof
+	_testEof311:
+		m.cs = 311
+		goto _testEof
+	_testEof312:
+		m.cs = 312
+		goto _testEof
+	_testEof313:
+		m.cs = 313
+		goto _testEof
+	_testEof314:
+		m.cs = 314
+		goto _testEof
+	_testEof315:
+		m.cs = 315
+		goto _testEof
+	_testEof316:
+		m.cs = 316
+		goto _testEof
+	_testEof317:
+		m.cs = 317
+		goto _testEof
+	_testEof318:
+		m.cs = 318
+		goto _testEof
+	_testEof319:
+		m.cs = 319
+		goto _testEof
+	_testEof320:
+		m.cs = 320
+		goto _testEof
+	_testEof321:
+		m.cs = 321
+		goto _testEof
+	_testEof322:
+		m.cs = 322
+		goto _testEof
+	_testEof323:
+		m.cs = 323
+		goto _testEof
+	_testEof324:
+		m.cs = 324
+		goto _testEof
+	_testEof325:
+		m.cs = 325
+		goto _testEof
+	_testEof326:
+		m.cs = 326
+		goto _testEof
+	_testEof327:
+		m.cs = 327
+		goto _testEof
+	_testEof328:
+		m.cs = 328
+		goto _testEof
+	_testEof329:
+		m.cs = 329
+		goto _testEof
+	_testEof330:
+		m.cs = 330
+		goto _testEof
+	_testEof331:
+		m.cs = 331
+		goto _testEof
+	_testEof332:
+		m.cs = 332
+		goto _testEof
+	_testEof333:
+		m.cs = 333
+		goto _testEof
+	_testEof334:
+		m.cs = 334
+		goto _testEof
+	_testEof335:
+		m.cs = 335
+		goto _testEof
+	_testEof336:
+		m.cs = 336
+		goto _testEof
+	_testEof337:
+		m.cs = 337
+		goto _testEof
+	_testEof338:
+		m.cs = 338
+		goto _testEof
+	_testEof339:
+		m.cs = 339
+		goto _testEof
+	_testEof340:
+		m.cs = 340
+		goto _testEof
+	_testEof341:
+		m.cs = 341
+		goto _testEof
+	_testEof342:
+		m.cs = 342
+		goto _testEof
+	_testEof343:
+		m.cs = 343
+		goto _testEof
+	_testEof344:
+		m.cs = 344
+		goto _testEof
+	_testEof345:
+		m.cs = 345
+		goto _testEof
+	_testEof346:
+		m.cs = 346
+		goto _testEof
+	_testEof347:
+		m.cs = 347
+		goto _testEof
+	_testEof348:
+		m.cs = 348
+		goto _testEof
+	_testEof349:
+		m.cs = 349
+		goto _testEof
+	_testEof350:
+		m.cs = 350
+		goto _testEof
+	_testEof351:
+		m.cs = 351
+		goto _testEof
+	_testEof352:
+		m.cs = 352
+		goto _testEof
+	_testEof353:
+		m.cs = 353
+		goto _testEof
+	_testEof354:
+		m.cs = 354
+		goto _testEof
+	_testEof355:
+		m.cs = 355
+		goto _testEof
+	_testEof356:
+		m.cs = 356
+		goto _testEof
+	_testEof357:
+		m.cs = 357
+		goto _testEof
+	_testEof358:
+		m.cs = 358
+		goto _testEof
+	_testEof359:
+		m.cs = 359
+		goto _testEof
+	_testEof360:
+		m.cs = 360
+		goto _testEof
+	_testEof361:
+		m.cs = 361
+		goto _testEof
+	_testEof362:
+		m.cs = 362
+		goto _testEof
+	_testEof363:
+		m.cs = 363
+		goto _testEof
+	_testEof364:
+		m.cs = 364
+		goto _testEof
+	_testEof365:
+		m.cs = 365
+		goto _testEof
+	_testEof366:
+		m.cs = 366
+		goto _testEof
+	_testEof367:
+		m.cs = 367
+		goto _testEof
+	_testEof368:
+		m.cs = 368
+		goto _testEof
+	_testEof369:
+		m.cs = 369
+		goto _testEof
+	_testEof370:
+		m.cs = 370
+		goto _testEof
+	_testEof371:
+		m.cs = 371
+		goto _testEof
+	_testEof372:
+		m.cs = 372
+		goto _testEof
+	_testEof373:
+		m.cs = 373
+		goto _testEof
+	_testEof374:
+		m.cs = 374
+		goto _testEof
+	_testEof375:
+		m.cs = 375
+		goto _testEof
+	_testEof377:
+		m.cs = 377
+		goto _testEof
+	_testEof378:
+		m.cs = 378
+		goto _testEof
+	_testEof379:
+		m.cs = 379
+		goto _testEof
+	_testEof380:
+		m.cs = 380
+		goto _testEof
+	_testEof381:
+		m.cs = 381
+		goto _testEof
+	_testEof382:
+		m.cs = 382
+		goto _testEof
+	_testEof383:
+		m.cs = 383
+		goto _testEof
+	_testEof384:
+		m.cs = 384
+		goto _testEof
+	_testEof673:
+		m.cs = 673
+		goto _testEof
+	_testEof385:
+		m.cs = 385
+		goto _testEof
+	_testEof674:
+		m.cs = 674
+		goto _testEof
+	_testEof386:
+		m.cs = 386
+		goto _testEof
+	_testEof387:
+		m.cs = 387
+		goto _testEof
+	_testEof388:
+		m.cs = 388
+		goto _testEof
+	_testEof389:
+		m.cs = 389
+		goto _testEof
+	_testEof390:
+		m.cs = 390
+		goto _testEof
+	_testEof391:
+		m.cs = 391
+		goto _testEof
+	_testEof392:
+		m.cs = 392
+		goto _testEof
+	_testEof393:
+		m.cs = 393
+		goto _testEof
+	_testEof675:
+		m.cs = 675
+		goto _testEof
+	_testEof394:
+		m.cs = 394
+		goto _testEof
+	_testEof395:
+		m.cs = 395
+		goto _testEof
+	_testEof396:
+		m.cs = 396
+		goto _testEof
+	_testEof397:
+		m.cs = 397
+		goto _testEof
+	_testEof398:
+		m.cs = 398
+		goto _testEof
+	_testEof399:
+		m.cs = 399
+		goto _testEof
+	_testEof400:
+		m.cs = 400
+		goto _testEof
+	_testEof401:
+		m.cs = 401
+		goto _testEof
+	_testEof402:
+		m.cs = 402
+		goto _testEof
+	_testEof403:
+		m.cs = 403
+		goto _testEof
+	_testEof404:
+		m.cs = 404
+		goto _testEof
+	_testEof405:
+		m.cs = 405
+		goto _testEof
+	_testEof406:
+		m.cs = 406
+		goto _testEof
+	_testEof407:
+		m.cs = 407
+		goto _testEof
+	_testEof408:
+		m.cs = 408
+		goto _testEof
+	_testEof409:
+		m.cs = 409
+		goto _testEof
+	_testEof410:
+		m.cs = 410
+		goto _testEof
+	_testEof411:
+		m.cs = 411
+		goto _testEof
+	_testEof412:
+		m.cs = 412
+		goto _testEof
+	_testEof413:
+		m.cs = 413
+		goto _testEof
+	_testEof414:
+		m.cs = 414
+		goto _testEof
+	_testEof415:
+		m.cs = 415
+		goto _testEof
+	_testEof416:
+		m.cs = 416
+		goto _testEof
+	_testEof417:
+		m.cs = 417
+		goto _testEof
+	_testEof418:
+		m.cs = 418
+		goto _testEof
+	_testEof419:
+		m.cs = 419
+		goto _testEof
+	_testEof420:
+		m.cs = 420
+		goto _testEof
+	_testEof421:
+		m.cs = 421
+		goto _testEof
+	_testEof422:
+		m.cs = 422
+		goto _testEof
+	_testEof423:
+		m.cs = 423
+		goto _testEof
+	_testEof424:
+		m.cs = 424
+		goto _testEof
+	_testEof425:
+		m.cs = 425
+		goto _testEof
+	_testEof426:
+		m.cs = 426
+		goto _testEof
+	_testEof427:
+		m.cs = 427
+		goto _testEof
+	_testEof428:
+		m.cs = 428
+		goto _testEof
+	_testEof429:
+		m.cs = 429
+		goto _testEof
+	_testEof430:
+		m.cs = 430
+		goto _testEof
+	_testEof431:
+		m.cs = 431
+		goto _testEof
+	_testEof432:
+		m.cs = 432
+		goto _testEof
+	_testEof433:
+		m.cs = 433
+		goto _testEof
+	_testEof434:
+		m.cs = 434
+		goto _testEof
+	_testEof435:
+		m.cs = 435
+		goto _testEof
+	_testEof436:
+		m.cs = 436
+		goto _testEof
+	_testEof437:
+		m.cs = 437
+		goto _testEof
+	_testEof438:
+		m.cs = 438
+		goto _testEof
+	_testEof439:
+		m.cs = 439
+		goto _testEof
+	_testEof440:
+		m.cs = 440
+		goto _testEof
+	_testEof441:
+		m.cs = 441
+		goto _testEof
+	_testEof442:
+		m.cs = 442
+		goto _testEof
+	_testEof443:
+		m.cs = 443
+		goto _testEof
+	_testEof444:
+		m.cs = 444
+		goto _testEof
+	_testEof445:
+		m.cs = 445
+		goto _testEof
+	_testEof446:
+		m.cs = 446
+		goto _testEof
+	_testEof447:
+		m.cs = 447
+		goto _testEof
+	_testEof448:
+		m.cs = 448
+		goto _testEof
+	_testEof449:
+		m.cs = 449
+		goto _testEof
+	_testEof450:
+		m.cs = 450
+		goto _testEof
+	_testEof451:
+		m.cs = 451
+		goto _testEof
+	_testEof452:
+		m.cs = 452
+		goto _testEof
+	_testEof453:
+		m.cs = 453
+		goto _testEof
+	_testEof454:
+		m.cs = 454
+		goto _testEof
+	_testEof455:
+		m.cs = 455
+		goto _testEof
+	_testEof456:
+		m.cs = 456
+		goto _testEof
+	_testEof457:
+		m.cs = 457
+		goto _testEof
+	_testEof458:
+		m.cs = 458
+		goto _testEof
+	_testEof459:
+		m.cs = 459
+		goto _testEof
+	_testEof460:
+		m.cs = 460
+		goto _testEof
+	_testEof461:
+		m.cs = 461
+		goto _testEof
+	_testEof462:
+		m.cs = 462
+		goto _testEof
+	_testEof463:
+		m.cs = 463
+		goto _testEof
+	_testEof464:
+		m.cs = 464
+		goto _testEof
+	_testEof465:
+		m.cs = 465
+		goto _testEof
+	_testEof466:
+		m.cs = 466
+		goto _testEof
+	_testEof467:
+		m.cs = 467
+		goto _testEof
+	_testEof468:
+		m.cs = 468
+		goto _testEof
+	_testEof469:
+		m.cs = 469
+		goto _testEof
+	_testEof470:
+		m.cs = 470
+		goto _testEof
+	_testEof471:
+		m.cs = 471
+		goto _testEof
+	_testEof472:
+		m.cs = 472
+		goto _testEof
+	_testEof473:
+		m.cs = 473
+		goto _testEof
+	_testEof474:
+		m.cs = 474
+		goto _testEof
+	_testEof475:
+		m.cs = 475
+		goto _testEof
+	_testEof477:
+		m.cs = 477
+		goto _testEof
+	_testEof478:
+		m.cs = 478
+		goto _testEof
+	_testEof479:
+		m.cs = 479
+		goto _testEof
+	_testEof480:
+		m.cs = 480
+		goto _testEof
+	_testEof481:
+		m.cs = 481
+		goto _testEof
+	_testEof482:
+		m.cs = 482
+		goto _testEof
+	_testEof483:
+		m.cs = 483
+		goto _testEof
+	_testEof484:
+		m.cs = 484
+		goto _testEof
+	_testEof676:
+		m.cs = 676
+		goto _testEof
+	_testEof485:
+		m.cs = 485
+		goto _testEof
+	_testEof677:
+		m.cs = 677
+		goto _testEof
+	_testEof486:
+		m.cs = 486
+		goto _testEof
+	_testEof487:
+		m.cs = 487
+		goto _testEof
+	_testEof488:
+		m.cs = 488
+		goto _testEof
+	_testEof489:
+		m.cs = 489
+		goto _testEof
+	_testEof490:
+		m.cs = 490
+		goto _testEof
+	_testEof491:
+		m.cs = 491
+		goto _testEof
+	_testEof492:
+		m.cs = 492
+		goto _testEof
+	_testEof493:
+		m.cs = 493
+		goto _testEof
+	_testEof678:
+		m.cs = 678
+		goto _testEof
+	_testEof494:
+		m.cs = 494
+		goto _testEof
+	_testEof495:
+		m.cs = 495
+		goto _testEof
+	_testEof496:
+		m.cs = 496
+		goto _testEof
+	_testEof497:
+		m.cs = 497
+		goto _testEof
+	_testEof498:
+		m.cs = 498
+		goto _testEof
+	_testEof499:
+		m.cs = 499
+		goto _testEof
+	_testEof500:
+		m.cs = 500
+		goto _testEof
+	_testEof501:
+		m.cs = 501
+		goto _testEof
+	_testEof502:
+		m.cs = 502
+		goto _testEof
+	_testEof503:
+		m.cs = 503
+		goto _testEof
+	_testEof504:
+		m.cs = 504
+		goto _testEof
+	_testEof505:
+		m.cs = 505
+		goto _testEof
+	_testEof506:
+		m.cs = 506
+		goto _testEof
+	_testEof507:
+		m.cs = 507
+		goto _testEof
+	_testEof508:
+		m.cs = 508
+		goto _testEof
+	_testEof509:
+		m.cs = 509
+		goto _testEof
+	_testEof510:
+		m.cs = 510
+		goto _testEof
+	_testEof511:
+		m.cs = 511
+		goto _testEof
+	_testEof512:
+		m.cs = 512
+		goto _testEof
+	_testEof513:
+		m.cs = 513
+		goto _testEof
+	_testEof514:
+		m.cs = 514
+		goto _testEof
+	_testEof515:
+		m.cs = 515
+		goto _testEof
+	_testEof516:
+		m.cs = 516
+		goto _testEof
+	_testEof517:
+		m.cs = 517
+		goto _testEof
+	_testEof518:
+		m.cs = 518
+		goto _testEof
+	_testEof519:
+		m.cs = 519
+		goto _testEof
+	_testEof520:
+		m.cs = 520
+		goto _testEof
+	_testEof521:
+		m.cs = 521
+		goto _testEof
+	_testEof522:
+		m.cs = 522
+		goto _testEof
+	_testEof523:
+		m.cs = 523
+		goto _testEof
+	_testEof524:
+		m.cs = 524
+		goto _testEof
+	_testEof525:
+		m.cs = 525
 		goto _testEof
-	_testEof3:
-		m.cs = 3
+	_testEof526:
+		m.cs = 526
 		goto _testEof
-	_testEof4:
-		m.cs = 4
+	_testEof527:
+		m.cs = 527
 		goto _testEof
-	_testEof5:
-		m.cs = 5
+	_testEof528:
+		m.cs = 528
 		goto _testEof
-	_testEof6:
-		m.cs = 6
+	_testEof529:
+		m.cs = 529
 		goto _testEof
-	_testEof7:
-		m.cs = 7
+	_testEof530:
+		m.cs = 530
 		goto _testEof
-	_testEof8:
-		m.cs = 8
+	_testEof531:
+		m.cs = 531
 		goto _testEof
-	_testEof125:
-		m.cs = 125
+	_testEof532:
+		m.cs = 532
 		goto _testEof
-	_testEof9:
-		m.cs = 9
+	_testEof533:
+		m.cs = 533
 		goto _testEof
-	_testEof126:
-		m.cs = 126
+	_testEof534:
+		m.cs = 534
 		goto _testEof
-	_testEof10:
-		m.cs = 10
+	_testEof535:
+		m.cs = 535
 		goto _testEof
-	_testEof11:
-		m.cs = 11
+	_testEof536:
+		m.cs = 536
 		goto _testEof
-	_testEof12:
-		m.cs = 12
+	_testEof537:
+		m.cs = 537
 		goto _testEof
-	_testEof13:
-		m.cs = 13
+	_testEof538:
+		m.cs = 538
 		goto _testEof
-	_testEof33:
-		m.cs = 33
+	_testEof539:
+		m.cs = 539
 		goto _testEof
-	_testEof130:
-		m.cs = 130
+	_testEof540:
+		m.cs = 540
 		goto _testEof
-	_testEof131:
-		m.cs = 131
+	_testEof541:
+		m.cs = 541
 		goto _testEof
-	_testEof34:
-		m.cs = 34
+	_testEof542:
+		m.cs = 542
 		goto _testEof
-	_testEof132:
-		m.cs = 132
+	_testEof543:
+		m.cs = 543
 		goto _testEof
-	_testEof36:
-		m.cs = 36
+	_testEof544:
+		m.cs = 544
 		goto _testEof
-	_testEof37:
-		m.cs = 37
+	_testEof545:
+		m.cs = 545
 		goto _testEof
-	_testEof38:
-		m.cs = 38
+	_testEof546:
+		m.cs = 546
 		goto _testEof
-	_testEof39:
-		m.cs = 39
+	_testEof547:
+		m.cs = 547
 		goto _testEof
-	_testEof40:
-		m.cs = 40
+	_testEof548:
+		m.cs = 548
 		goto _testEof
-	_testEof41:
-		m.cs = 41
+	_testEof549:
+		m.cs = 549
 		goto _testEof
-	_testEof42:
-		m.cs = 42
+	_testEof550:
+		m.cs = 550
 		goto _testEof
-	_testEof43:
-		m.cs = 43
+	_testEof551:
+		m.cs = 551
 		goto _testEof
-	_testEof133:
-		m.cs = 133
+	_testEof552:
+		m.cs = 552
 		goto _testEof
-	_testEof44:
-		m.cs = 44
+	_testEof553:
+		m.cs = 553
 		goto _testEof
-	_testEof134:
-		m.cs = 134
+	_testEof554:
+		m.cs = 554
 		goto _testEof
-	_testEof45:
-		m.cs = 45
+	_testEof555:
+		m.cs = 555
 		goto _testEof
-	_testEof46:
-		m.cs = 46
+	_testEof556:
+		m.cs = 556
 		goto _testEof
-	_testEof47:
-		m.cs = 47
+	_testEof557:
+		m.cs = 557
 		goto _testEof
-	_testEof48:
-		m.cs = 48
+	_testEof558:
+		m.cs = 558
 		goto _testEof
-	_testEof49:
-		m.cs = 49
+	_testEof559:
+		m.cs = 559
 		goto _testEof
-	_testEof50:
-		m.cs = 50
+	_testEof560:
+		m.cs = 560
 		goto _testEof
-	_testEof51:
-		m.cs = 51
+	_testEof562:
+		m.cs = 562
 		goto _testEof
-	_testEof52:
-		m.cs = 52
+	_testEof563:
+		m.cs = 563
 		goto _testEof
-	_testEof53:
-		m.cs = 53
+	_testEof564:
+		m.cs = 564
 		goto _testEof
-	_testEof54:
-		m.cs = 54
+	_testEof679:
+		m.cs = 679
 		goto _testEof
-	_testEof55:
-		m.cs = 55
+	_testEof565:
+		m.cs = 565
 		goto _testEof
-	_testEof56:
-		m.cs = 56
+	_testEof680:
+		m.cs = 680
 		goto _testEof
-	_testEof57:
-		m.cs = 57
+	_testEof566:
+		m.cs = 566
 		goto _testEof
-	_testEof58:
-		m.cs = 58
+	_testEof567:
+		m.cs = 567
 		goto _testEof
-	_testEof59:
-		m.cs = 59
+	_testEof568:
+		m.cs = 568
 		goto _testEof
-	_testEof60:
-		m.cs = 60
+	_testEof569:
+		m.cs = 569
 		goto _testEof
-	_testEof61:
-		m.cs = 61
+	_testEof570:
+		m.cs = 570
 		goto _testEof
-	_testEof62:
-		m.cs = 62
+	_testEof571:
+		m.cs = 571
 		goto _testEof
-	_testEof63:
-		m.cs = 63
+	_testEof572:
+		m.cs = 572
 		goto _testEof
-	_testEof64:
-		m.cs = 64
+	_testEof573:
+		m.cs = 573
 		goto _testEof
-	_testEof65:
-		m.cs = 65
+	_testEof681:
+		m.cs = 681
 		goto _testEof
-	_testEof66:
-		m.cs = 66
+	_testEof574:
+		m.cs = 574
 		goto _testEof
-	_testEof67:
-		m.cs = 67
+	_testEof575:
+		m.cs = 575
 		goto _testEof
-	_testEof68:
-		m.cs = 68
+	_testEof576:
+		m.cs = 576
 		goto _testEof
-	_testEof69:
-		m.cs = 69
+	_testEof577:
+		m.cs = 577
 		goto _testEof
-	_testEof70:
-		m.cs = 70
+	_testEof578:
+		m.cs = 578
 		goto _testEof
-	_testEof71:
-		m.cs = 71
+	_testEof579:
+		m.cs = 579
 		goto _testEof
-	_testEof72:
-		m.cs = 72
+	_testEof580:
+		m.cs = 580
 		goto _testEof
-	_testEof73:
-		m.cs = 73
+	_testEof581:
+		m.cs = 581
 		goto _testEof
-	_testEof74:
-		m.cs = 74
+	_testEof582:
+		m.cs = 582
 		goto _testEof
-	_testEof75:
-		m.cs = 75
+	_testEof583:
+		m.cs = 583
 		goto _testEof
-	_testEof77:
-		m.cs = 77
+	_testEof584:
+		m.cs = 584
 		goto _testEof
-	_testEof78:
-		m.cs = 78
+	_testEof585:
+		m.cs = 585
 		goto _testEof
-	_testEof79:
-		m.cs = 79
+	_testEof586:
+		m.cs = 586
 		goto _testEof
-	_testEof80:
-		m.cs = 80
+	_testEof587:
+		m.cs = 587
 		goto _testEof
-	_testEof81:
-		m.cs = 81
+	_testEof588:
+		m.cs = 588
 		goto _testEof
-	_testEof82:
-		m.cs = 82
+	_testEof589:
+		m.cs = 589
 		goto _testEof
-	_testEof83:
-		m.cs = 83
+	_testEof590:
+		m.cs = 590
 		goto _testEof
-	_testEof84:
-		m.cs = 84
+	_testEof591:
+		m.cs = 591
 		goto _testEof
-	_testEof135:
-		m.cs = 135
+	_testEof592:
+		m.cs = 592
 		goto _testEof
-	_testEof85:
-		m.cs = 85
+	_testEof593:
+		m.cs = 593
 		goto _testEof
-	_testEof136:
-		m.cs = 136
+	_testEof594:
+		m.cs = 594
 		goto _testEof
-	_testEof86:
-		m.cs = 86
+	_testEof595:
+		m.cs = 595
 		goto _testEof
-	_testEof87:
-		m.cs = 87
+	_testEof596:
+		m.cs = 596
 		goto _testEof
-	_testEof88:
-		m.cs = 88
+	_testEof598:
+		m.cs = 598
 		goto _testEof
-	_testEof89:
-		m.cs = 89
+	_testEof599:
+		m.cs = 599
 		goto _testEof
-	_testEof90:
-		m.cs = 90
+	_testEof600:
+		m.cs = 600
 		goto _testEof
-	_testEof91:
-		m.cs = 91
+	_testEof601:
+		m.cs = 601
 		goto _testEof
-	_testEof92:
-		m.cs = 92
+	_testEof682:
+		m.cs = 682
 		goto _testEof
-	_testEof93:
-		m.cs = 93
+	_testEof602:
+		m.cs = 602
 		goto _testEof
-	_testEof94:
-		m.cs = 94
+	_testEof683:
+		m.cs = 683
 		goto _testEof
-	_testEof95:
-		m.cs = 95
+	_testEof603:
+		m.cs = 603
 		goto _testEof
-	_testEof96:
-		m.cs = 96
+	_testEof604:
+		m.cs = 604
 		goto _testEof
-	_testEof97:
-		m.cs = 97
+	_testEof605:
+		m.cs = 605
 		goto _testEof
-	_testEof98:
-		m.cs = 98
+	_testEof606:
+		m.cs = 606
 		goto _testEof
-	_testEof99:
-		m.cs = 99
+	_testEof607:
+		m.cs = 607
 		goto _testEof
-	_testEof100:
-		m.cs = 100
+	_testEof608:
+		m.cs = 608
 		goto _testEof
-	_testEof101:
-		m.cs = 101
+	_testEof609:
+		m.cs = 609
 		goto _testEof
-	_testEof102:
-		m.cs = 102
+	_testEof610:
+		m.cs = 610
 		goto _testEof
-	_testEof103:
-		m.cs = 103
+	_testEof684:
+		m.cs = 684
 		goto _testEof
-	_testEof104:
-		m.cs = 104
+	_testEof611:
+		m.cs = 611
 		goto _testEof
-	_testEof105:
-		m.cs = 105
+	_testEof612:
+		m.cs = 612
 		goto _testEof
-	_testEof106:
-		m.cs = 106
+	_testEof613:
+		m.cs = 613
 		goto _testEof
-	_testEof107:
-		m.cs = 107
+	_testEof614:
+		m.cs = 614
 		goto _testEof
-	_testEof108:
-		m.cs = 108
+	_testEof615:
+		m.cs = 615
 		goto _testEof
-	_testEof109:
-		m.cs = 109
+	_testEof616:
+		m.cs = 616
 		goto _testEof
-	_testEof110:
-		m.cs = 110
+	_testEof617:
+		m.cs = 617
 		goto _testEof
-	_testEof111:
-		m.cs = 111
+	_testEof618:
+		m.cs = 618
 		goto _testEof
-	_testEof112:
-		m.cs = 112
+	_testEof619:
+		m.cs = 619
 		goto _testEof
-	_testEof113:
-		m.cs = 113
+	_testEof620:
+		m.cs = 620
 		goto _testEof
-	_testEof114:
-		m.cs = 114
+	_testEof621:
+		m.cs = 621
 		goto _testEof
-	_testEof115:
-		m.cs = 115
+	_testEof622:
+		m.cs = 622
 		goto _testEof
-	_testEof117:
-		m.cs = 117
+	_testEof623:
+		m.cs = 623
 		goto _testEof
-	_testEof118:
-		m.cs = 118
+	_testEof624:
+		m.cs = 624
 		goto _testEof
-	_testEof119:
-		m.cs = 119
+	_testEof625:
+		m.cs = 625
 		goto _testEof
-	_testEof120:
-		m.cs = 120
+	_testEof626:
+		m.cs = 626
 		goto _testEof
-	_testEof137:
-		m.cs = 137
+	_testEof627:
+		m.cs = 627
 		goto _testEof
-	_testEof121:
-		m.cs = 121
+	_testEof628:
+		m.cs = 628
 		goto _testEof
-	_testEof138:
-		m.cs = 138
+	_testEof629:
+		m.cs = 629
 		goto _testEof
-	_testEof122:
-		m.cs = 122
+	_testEof630:
+		m.cs = 630
 		goto _testEof
-	_testEof123:
-		m.cs = 123
+	_testEof631:
+		m.cs = 631
 		goto _testEof
-	_testEof124:
-		m.cs = 124
+	_testEof632:
+		m.cs = 632
 		goto _testEof
-	_testEof127:
-		m.cs = 127
+	_testEof633:
+		m.cs = 633
 		goto _testEof
-	_testEof14:
-		m.cs = 14
+	_testEof634:
+		m.cs = 634
 		goto _testEof
-	_testEof15:
-		m.cs = 15
+	_testEof635:
+		m.cs = 635
 		goto _testEof
-	_testEof128:
-		m.cs = 128
+	_testEof636:
+		m.cs = 636
+		goto _testEof
+	_testEof637:
+		m.cs = 637
+		goto _testEof
+	_testEof638:
+		m.cs = 638
+		goto _testEof
+	_testEof639:
+		m.cs = 639
+		goto _testEof
+	_testEof640:
+		m.cs = 640
+		goto _testEof
+	_testEof641:
+		m.cs = 641
+		goto _testEof
+	_testEof642:
+		m.cs = 642
+		goto _testEof
+	_testEof643:
+		m.cs = 643
+		goto _testEof
+	_testEof644:
+		m.cs = 644
+		goto _testEof
+	_testEof645:
+		m.cs = 645
+		goto _testEof
+	_testEof646:
+		m.cs = 646
+		goto _testEof
+	_testEof647:
+		m.cs = 647
+		goto _testEof
+	_testEof648:
+		m.cs = 648
+		goto _testEof
+	_testEof649:
+		m.cs = 649
+		goto _testEof
+	_testEof650:
+		m.cs = 650
+		goto _testEof
+	_testEof654:
+		m.cs = 654
+		goto _testEof
+	_testEof65:
+		m.cs = 65
+		goto _testEof
+	_testEof66:
+		m.cs = 66
+		goto _testEof
+	_testEof655:
+		m.cs = 655
+		goto _testEof
+	_testEof67:
+		m.cs = 67
+		goto _testEof
+	_testEof68:
+		m.cs = 68
 		goto _testEof
-	_testEof16:
-		m.cs = 16
+	_testEof656:
+		m.cs = 656
 		goto _testEof
-	_testEof17:
-		m.cs = 17
+	_testEof69:
+		m.cs = 69
 		goto _testEof
-	_testEof129:
-		m.cs = 129
+	_testEof70:
+		m.cs = 70
 		goto _testEof
-	_testEof18:
-		m.cs = 18
+	_testEof71:
+		m.cs = 71
 		goto _testEof
-	_testEof19:
-		m.cs = 19
+	_testEof72:
+		m.cs = 72
 		goto _testEof
-	_testEof20:
-		m.cs = 20
+	_testEof73:
+		m.cs = 73
 		goto _testEof
-	_testEof21:
-		m.cs = 21
+	_testEof74:
+		m.cs = 74
 		goto _testEof
-	_testEof22:
-		m.cs = 22
+	_testEof75:
+		m.cs = 75
 		goto _testEof
-	_testEof23:
-		m.cs = 23
+	_testEof76:
+		m.cs = 76
 		goto _testEof
-	_testEof24:
-		m.cs = 24
+	_testEof77:
+		m.cs = 77
 		goto _testEof
-	_testEof25:
-		m.cs = 25
+	_testEof78:
+		m.cs = 78
 		goto _testEof
-	_testEof26:
-		m.cs = 26
+	_testEof79:
+		m.cs = 79
 		goto _testEof
-	_testEof27:
-		m.cs = 27
+	_testEof80:
+		m.cs = 80
 		goto _testEof
-	_testEof28:
-		m.cs = 28
+	_testEof81:
+		m.cs = 81
 		goto _testEof
-	_testEof29:
-		m.cs = 29
+	_testEof82:
+		m.cs = 82
 		goto _testEof
-	_testEof30:
-		m.cs = 30
+	_testEof83:
+		m.cs = 83
 		goto _testEof
-	_testEof31:
-		m.cs = 31
+	_testEof660:
+		m.cs = 660
 		goto _testEof
-	_testEof32:
-		m.cs = 32
+	_testEof661:
+		m.cs = 661
 		goto _testEof
 
 	_testEof:
@@ -3302,65 +30827,70 @@ func (m *machine) Parse(input []byte) (conventionalcommits.Message, error) {
 		}
 		if (m.p) == (m.eof) {
 			switch m.cs {
-			case 2, 3, 4, 13, 36, 37, 38, 39, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 77, 78, 79, 80, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99, 100, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115:
+			case 657:
 
-				if m.pe > 0 {
-					if m.p != m.pe {
-						m.err = m.emitErrorOnCurrentCharacter(ErrType)
+				if m.err = m.checkFooter(output.footers); m.err != nil {
+					if m.recovery {
+						// Record the error and continue, skipping the footer trailer
+						m.recover()
 					} else {
-						// assert(m.p == m.pe)
-						m.err = m.emitErrorOnPreviousCharacter(ErrTypeIncomplete)
+						{
+							(m.p)++
+							m.cs = 0
+							goto _out
+						}
+					}
+				} else {
+					if len(output.footers) == 0 {
+						m.footerStart = m.footerKeySpan.Start
+					}
+					value := m.str(m.pb, m.p)
+					output.footers[m.currentFooterKey] = append(output.footers[m.currentFooterKey], value)
+					if m.logger != nil {
+						m.logger.Info("valid commit message footer trailer", m.currentFooterKey, value)
+					}
+					if m.listener != nil {
+						// The body, if any, is complete
+						m.flushBody()
+						if m.currentFooterKey == "breaking-change" {
+							m.listener.OnBreaking(m.footerKeySpan)
+						}
+						m.listener.OnFooter(m.currentFooterKey, value, m.footerKeySpan, conventionalcommits.Span{Start: m.pb, End: m.p})
 					}
 				}
 
-			case 5, 6, 12, 40, 41, 47, 81, 82, 88, 117, 118, 124:
+			case 661:
 
-				if m.err == nil {
-					m.err = m.emitErrorOnCurrentCharacter(ErrColon)
+				end := m.pb + len(bytes.TrimRight(m.text(), "\n"))
+				m.body.reset()
+				m.body.add(m.data, m.pb, end)
+				if m.listener != nil {
+					m.notifyBody(m.pb, end)
 				}
-
-			case 7, 42, 83, 119:
-
-				if m.err == nil {
-					m.err = m.emitErrorOnCurrentCharacter(ErrDescriptionInit)
+				if m.logger != nil {
+					m.logger.Info("valid commit message body content", "body", string(m.data[m.pb:end]))
 				}
 
-			case 8, 43, 84, 120:
+			case 659:
 
-				if m.p < m.pe && m.data[m.p] == 10 {
-					m.err = m.emitError(ErrNewline, m.p+1)
-				} else {
-					// assert(m.p == m.pe)
-					m.err = m.emitErrorOnPreviousCharacter(ErrDescription)
+				if m.listener != nil {
+					m.notifyBody(m.pb-m.countNewlines, m.p)
 				}
-
-			case 9, 44, 85, 121:
-
-				m.err = m.emitErrorWithoutCharacter(ErrMissingBlankLineAtBeginning)
-
-			case 125, 133, 135, 137:
-
-				output.descr = string(m.text())
-				m.emitInfo("valid commit message description", "description", output.descr)
-
-			case 130:
-
-				output.footers[m.currentFooterKey] = append(output.footers[m.currentFooterKey], string(m.text()))
-				m.emitInfo("valid commit message footer trailer", m.currentFooterKey, string(m.text()))
-
-			case 132:
-
 				// Append newlines
+				m.body.addNewlines(m.data, m.pb, m.countNewlines)
 				for m.countNewlines > 0 {
-					output.body += "\n"
 					m.countNewlines--
-					m.emitInfo("valid commit message body content", "body", "\n")
+					if m.logger != nil {
+						m.logger.Info("valid commit message body content", "body", "\n")
+					}
 				}
 				// Append body content
-				output.body += string(m.text())
-				m.emitInfo("valid commit message body content", "body", string(m.text()))
+				m.body.add(m.data, m.pb, m.p)
+				if m.logger != nil {
+					m.logger.Info("valid commit message body content", "body", string(m.text()))
+				}
 
-			case 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32:
+			case 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 83:
 
 				if len(output.footers) == 0 {
 					// Backtrack to the last marker
@@ -3373,9 +30903,11 @@ func (m *machine) Parse(input []byte) (conventionalcommits.Message, error) {
 					}
 					(m.p) = (m.pb) - 1
 
-					m.emitDebug("try to parse body content", "pos", m.p)
+					if m.logger != nil {
+						m.logger.Debug("try to parse body content", "pos", m.p)
+					}
 					{
-						goto st34
+						goto st92
 					}
 				} else {
 					// A rewind happens when an error while parsing a footer trailer is encountered
@@ -3387,45 +30919,311 @@ func (m *machine) Parse(input []byte) (conventionalcommits.Message, error) {
 						// assert(m.p == m.pe)
 						m.err = m.emitErrorOnPreviousCharacter(ErrTrailerIncomplete)
 					}
+					if m.recovery {
+						m.recover()
+						if m.p < m.pe {
+							// Skip the current line and continue looking for footer trailers
+							(m.p)--
+
+							{
+								goto st93
+							}
+						}
+					}
 				}
 
-			case 1, 35, 76, 116:
+			case 2, 3, 54, 55, 57, 58, 59, 60, 61, 62, 63, 147, 148, 183, 184, 186, 187, 188, 189, 190, 191, 192, 245, 246, 281, 282, 284, 285, 286, 287, 288, 289, 290, 328, 329, 365, 366, 368, 369, 370, 371, 372, 373, 374, 428, 429, 465, 466, 468, 469, 470, 471, 472, 473, 474, 523, 524, 550, 551, 553, 554, 555, 556, 557, 558, 559, 582, 583, 586, 587, 589, 590, 591, 592, 593, 594, 595, 636, 637, 640, 641, 643, 644, 645, 646, 647, 648, 649:
 
-				m.err = m.emitErrorWithoutCharacter(ErrEmpty)
+				if m.p < m.pe {
+					m.err = m.emitErrorOnCurrentCharacter(ErrGitmoji)
+				} else {
+					// assert(m.p == m.pe)
+					m.err = m.emitErrorOnPreviousCharacter(ErrGitmojiIncomplete)
+				}
+
+				if m.recovery {
+					m.recover()
+					if m.p < m.pe {
+						// Skip the rest of the header
+						(m.p)--
+
+						{
+							goto st94
+						}
+					}
+				}
+
+			case 6, 7, 8, 40, 41, 42, 43, 44, 45, 46, 47, 48, 50, 51, 52, 53, 96, 97, 98, 99, 131, 132, 133, 134, 135, 136, 137, 138, 139, 140, 141, 142, 143, 144, 145, 146, 151, 152, 153, 154, 155, 156, 157, 158, 159, 160, 161, 162, 163, 164, 165, 166, 167, 168, 169, 171, 172, 173, 175, 176, 177, 178, 179, 180, 181, 195, 196, 197, 198, 230, 231, 232, 233, 234, 235, 236, 237, 238, 239, 240, 241, 242, 243, 244, 249, 250, 251, 252, 253, 254, 255, 256, 257, 258, 259, 260, 261, 262, 263, 264, 265, 266, 267, 269, 270, 271, 273, 274, 275, 276, 277, 278, 279, 293, 318, 319, 320, 321, 322, 323, 324, 325, 326, 327, 332, 333, 334, 335, 336, 337, 338, 339, 340, 341, 342, 343, 344, 345, 346, 347, 348, 349, 350, 351, 353, 354, 355, 357, 358, 359, 360, 361, 362, 363, 377, 378, 379, 380, 412, 413, 414, 415, 416, 417, 418, 419, 420, 421, 422, 423, 424, 425, 426, 427, 432, 433, 434, 435, 436, 437, 438, 439, 440, 441, 442, 443, 444, 445, 446, 447, 448, 449, 450, 451, 453, 454, 455, 457, 458, 459, 460, 461, 462, 463, 477, 478, 479, 480, 512, 513, 514, 515, 516, 517, 518, 519, 520, 521, 522, 527, 528, 529, 530, 531, 532, 533, 534, 535, 536, 537, 538, 539, 540, 541, 542, 543, 544, 545, 546, 548, 549, 629, 630, 631, 632, 633, 634, 635:
 
 				if m.pe > 0 {
 					if m.p != m.pe {
-						m.err = m.emitErrorOnCurrentCharacter(ErrType)
+						m.err = m.suggestType(m.emitErrorOnCurrentCharacter(ErrType))
 					} else {
 						// assert(m.p == m.pe)
-						m.err = m.emitErrorOnPreviousCharacter(ErrTypeIncomplete)
+						m.err = m.suggestType(m.emitErrorOnPreviousCharacter(ErrTypeIncomplete))
+					}
+				}
+
+				if m.recovery {
+					m.recover()
+					if m.p < m.pe {
+						// Skip the rest of the header
+						(m.p)--
+
+						{
+							goto st94
+						}
 					}
 				}
 
-			case 10, 11, 45, 46, 86, 87, 122, 123:
+			case 30, 31, 33, 34, 35, 36, 37, 38, 39, 121, 122, 124, 125, 126, 127, 128, 129, 130, 220, 221, 223, 224, 225, 226, 227, 228, 229, 315, 316, 402, 403, 405, 406, 407, 408, 409, 410, 411, 502, 503, 505, 506, 507, 508, 509, 510, 511, 619, 620, 622, 623, 624, 625, 626, 627, 628:
 
 				if m.p < m.pe {
 					m.err = m.emitErrorOnCurrentCharacter(ErrScope)
+				} else {
+					// assert(m.p == m.pe)
+					m.err = m.emitErrorOnPreviousCharacter(ErrScopeIncomplete)
 				}
 
-				// assert(m.p == m.pe)
-				m.err = m.emitErrorOnPreviousCharacter(ErrScopeIncomplete)
+				if m.recovery {
+					m.recover()
+					if m.p < m.pe {
+						// Skip the rest of the header
+						(m.p)--
+
+						{
+							goto st94
+						}
+					}
+				}
+
+			case 9, 10, 32, 49, 100, 101, 123, 170, 174, 182, 199, 200, 222, 268, 272, 280, 294, 295, 317, 352, 356, 364, 381, 382, 404, 452, 456, 464, 481, 482, 504, 547, 562, 598, 599, 621:
+
+				if m.err == nil {
+					m.err = m.fixColon(m.emitErrorOnCurrentCharacter(ErrColon))
+				}
+
+				if m.recovery {
+					m.recover()
+					if m.p < m.pe {
+						// Skip the rest of the header
+						(m.p)--
+
+						{
+							goto st94
+						}
+					}
+				}
+
+			case 11, 102, 201, 296, 383, 483, 563, 600:
+
+				if m.err == nil {
+					m.err = m.fixDescriptionInit(m.emitErrorOnCurrentCharacter(ErrDescriptionInit))
+				}
+
+				if m.recovery {
+					m.recover()
+					if m.p < m.pe {
+						// Skip the rest of the header
+						(m.p)--
+
+						{
+							goto st94
+						}
+					}
+				}
+
+			case 12, 14, 15, 16, 17, 18, 19, 20, 21, 103, 105, 106, 107, 108, 109, 110, 111, 112, 202, 204, 205, 206, 207, 208, 209, 210, 211, 297, 299, 300, 301, 302, 303, 304, 305, 306, 384, 386, 387, 388, 389, 390, 391, 392, 393, 484, 486, 487, 488, 489, 490, 491, 492, 493, 564, 566, 567, 568, 569, 570, 571, 572, 573, 601, 603, 604, 605, 606, 607, 608, 609, 610:
+
+				if m.err == nil {
+					if m.p < m.pe {
+						switch m.data[m.p] {
+						case 9:
+							// assert(m.strictWhitespace)
+							m.err = m.emitErrorWithoutCharacter(ErrDescriptionTab)
+						case 32:
+							// assert(m.strictWhitespace)
+							m.err = m.emitErrorOnCurrentCharacter(ErrDescriptionWhitespace)
+						case 10:
+							m.err = m.emitError(m.p+1, ErrNewline)
+						default:
+							m.err = m.emitError(m.runeStart(), ErrDescriptionEncoding)
+						}
+					} else {
+						// assert(m.p == m.pe)
+						m.err = m.emitErrorOnPreviousCharacter(ErrDescription)
+					}
+				}
+
+				if m.recovery {
+					m.recover()
+					if m.p < m.pe {
+						// Skip the rest of the header
+						(m.p)--
+
+						{
+							goto st94
+						}
+					}
+				}
+
+			case 22, 23, 24, 25, 26, 27, 28, 29, 113, 114, 115, 116, 117, 118, 119, 120, 212, 213, 214, 215, 216, 217, 218, 219, 307, 308, 309, 310, 311, 312, 313, 314, 394, 395, 396, 397, 398, 399, 400, 401, 494, 495, 496, 497, 498, 499, 500, 501, 574, 575, 576, 577, 578, 579, 580, 581, 611, 612, 613, 614, 615, 616, 617, 618:
+
+				if m.p < m.pe && m.data[m.p] == 9 {
+					m.err = m.emitErrorWithoutCharacter(ErrDescriptionTab)
+				} else if m.p < m.pe && m.data[m.p] != 10 {
+					m.err = m.emitError(m.runeStart(), ErrDescriptionEncoding)
+				} else {
+					// assert(m.data[m.p - 1] == 32)
+					i := m.p - 1
+					for ; m.data[i-1] == 32; i-- {
+					}
+					m.err = m.emitError(i, ErrDescriptionTrailingWhitespace)
+				}
+
+				if m.recovery {
+					m.recover()
+					if m.p < m.pe {
+						// Skip the rest of the header
+						(m.p)--
+
+						{
+							goto st94
+						}
+					}
+				}
+
+			case 13, 104, 203, 298, 385, 485, 565, 602:
+
+				m.err = m.fixBlankLine(m.emitErrorWithoutCharacter(ErrMissingBlankLineAtBeginning))
+
+				if m.recovery {
+					m.recover()
+					if m.p < m.pe {
+						// Assume the body (or the footer) starts at the current character
+						m.pb = m.p
+						if m.bodyStart < 0 {
+							m.bodyStart = m.pb
+						}
+						(m.p)--
+
+						if m.gitTrailers {
+							{
+								goto st660
+							}
+						}
+						{
+							goto st654
+						}
+					}
+				}
+
+			case 84, 85, 86, 87, 88, 89, 90, 91:
+
+				if m.p < m.pe {
+					m.err = m.emitErrorOnCurrentCharacter(ErrTrailer)
+				} else {
+					// The input ends right after the footer trailer separator
+					m.err = m.emitErrorOnPreviousCharacter(ErrTrailerIncomplete)
+				}
+
+				if m.recovery {
+					m.recover()
+					if m.p < m.pe {
+						// Skip the rest of the current footer trailer
+						(m.p)--
+
+						{
+							goto st93
+						}
+					}
+				}
+
+			case 651, 653, 664, 666, 667, 669, 670, 672, 673, 675, 676, 678, 679, 681, 682, 684:
+
+				output.descr = m.str(m.pb, m.p)
+				if m.logger != nil {
+					m.logger.Info("valid commit message description", "description", output.descr)
+				}
+				if m.listener != nil {
+					m.listener.OnDescription(output.descr, conventionalcommits.Span{Start: m.pb, End: m.p})
+				}
+
+				if m.err = m.checkHeader(); m.err != nil {
+					if m.recovery {
+						// Record the error and continue, the header is well-formed anyway
+						m.recover()
+					} else {
+						{
+							(m.p)++
+							m.cs = 0
+							goto _out
+						}
+					}
+				}
 
-			case 34:
+			case 92:
 
+				if m.listener != nil {
+					m.notifyBody(m.pb-m.countNewlines, m.p)
+				}
 				// Append newlines
+				m.body.addNewlines(m.data, m.pb, m.countNewlines)
 				for m.countNewlines > 0 {
-					output.body += "\n"
 					m.countNewlines--
-					m.emitInfo("valid commit message body content", "body", "\n")
+					if m.logger != nil {
+						m.logger.Info("valid commit message body content", "body", "\n")
+					}
 				}
 				// Append body content
-				output.body += string(m.text())
-				m.emitInfo("valid commit message body content", "body", string(m.text()))
+				m.body.add(m.data, m.pb, m.p)
+				if m.logger != nil {
+					m.logger.Info("valid commit message body content", "body", string(m.text()))
+				}
 
-				m.emitDebug("try to parse a footer trailer token", "pos", m.p)
+				// Mark the next character so that rewinds can not go back before it
+				// Eg., when it is not a possible trailer token start
+				m.pb = m.p + 1
+				if m.bodyStart < 0 {
+					// The first time is right after the header
+					m.bodyStart = m.pb
+				}
+				if m.gitTrailers {
+					// The footer is the trailer block git finds, already cut off from the input
+					{
+						goto st660
+					}
+				}
+				if m.logger != nil {
+					m.logger.Debug("try to parse a footer trailer token", "pos", m.p)
+				}
 				{
-					goto st127
+					goto st654
+				}
+
+			case 1, 4, 5, 56, 64, 95, 149, 150, 185, 193, 194, 247, 248, 283, 291, 292, 330, 331, 367, 375, 376, 430, 431, 467, 475, 476, 525, 526, 552, 560, 561, 584, 585, 588, 596, 597, 638, 639, 642, 650:
+
+				m.err = m.emitErrorWithoutCharacter(ErrEmpty)
+
+				if m.pe > 0 {
+					if m.p != m.pe {
+						m.err = m.suggestType(m.emitErrorOnCurrentCharacter(ErrType))
+					} else {
+						// assert(m.p == m.pe)
+						m.err = m.suggestType(m.emitErrorOnPreviousCharacter(ErrTypeIncomplete))
+					}
+				}
+
+				if m.recovery {
+					m.recover()
+					if m.p < m.pe {
+						// Skip the rest of the header
+						(m.p)--
+
+						{
+							goto st94
+						}
+					}
 				}
 
 			}
@@ -3436,7 +31234,46 @@ func (m *machine) Parse(input []byte) (conventionalcommits.Message, error) {
 		}
 	}
 
-	if m.cs < firstFinal {
+	if m.listener != nil {
+		m.flushBody()
+	}
+	output.body = m.bodyString()
+
+	if len(trailers) > 0 && m.cs >= firstFinal && m.err == nil {
+		m.addGitTrailers(output.footers, trailers)
+	}
+
+	if m.verbatimBody && m.bodyStart >= 0 && m.footerStart > m.bodyStart {
+		verbatim := m.str(m.bodyStart, m.footerStart)
+		output.verbatim = &verbatim
+	}
+
+	if len(m.requiredFooters) > 0 && m.cs >= firstFinal && m.err == nil {
+		if errs := m.checkRequiredFooters(output.footers); len(errs) > 0 {
+			if m.recovery {
+				m.errors = append(m.errors, errs...)
+			} else {
+				m.err = errs[0]
+			}
+		}
+	}
+
+	if m.cs < firstFinal && m.err == nil && len(m.errors) == 0 {
+		// Never stop without telling why
+		m.err = m.emitErrorOnPreviousCharacter(ErrEarly)
+	}
+
+	if m.recovery {
+		if m.err != nil {
+			m.recover()
+		}
+		if len(m.errors) > 0 {
+			// Return whatever has been found, together with all the errors
+			return output.export(), Errors(m.errors)
+		}
+	}
+
+	if m.cs < firstFinal || m.err != nil {
 		if m.bestEffort && output.minimal() {
 			// An error occurred but partial parsing is on and partial message is minimally valid
 			return output.export(), m.err
@@ -3457,12 +31294,134 @@ func (m *machine) HasBestEffort() bool {
 	return m.bestEffort
 }
 
+// WithErrorRecovery enables the error recovery mode.
+func (m *machine) WithErrorRecovery() {
+	m.recovery = true
+}
+
+// HasErrorRecovery tells whether the receiving machine has the error recovery mode on or off.
+func (m *machine) HasErrorRecovery() bool {
+	return m.recovery
+}
+
+// WithStrictWhitespace enables the strict whitespace mode.
+func (m *machine) WithStrictWhitespace() {
+	m.strictWhitespace = true
+}
+
+// HasStrictWhitespace tells whether the receiving machine has the strict whitespace mode on or off.
+func (m *machine) HasStrictWhitespace() bool {
+	return m.strictWhitespace
+}
+
+// WithGitmoji enables the gitmoji mode.
+func (m *machine) WithGitmoji() {
+	m.gitmoji = true
+}
+
+// HasGitmoji tells whether the receiving machine has the gitmoji mode on or off.
+func (m *machine) HasGitmoji() bool {
+	return m.gitmoji
+}
+
+// WithTypeAliases enables the type aliases mode.
+func (m *machine) WithTypeAliases() {
+	m.typeAliases = true
+}
+
+// HasTypeAliases tells whether the receiving machine has the type aliases mode on or off.
+func (m *machine) HasTypeAliases() bool {
+	return m.typeAliases
+}
+
+// WithHeaderMaxLength sets the maximum number of characters the header can have.
+func (m *machine) WithHeaderMaxLength(n int) {
+	m.headerMaxLength = n
+}
+
+// WithDescriptionMinLength sets the minimum number of characters the description must have.
+func (m *machine) WithDescriptionMinLength(n int) {
+	m.descrMinLength = n
+}
+
+// WithoutDescriptionTrailingPeriod forbids the description to end with a period.
+func (m *machine) WithoutDescriptionTrailingPeriod() {
+	m.noTrailingPeriod = true
+}
+
+// WithFooterTokenChars extends the characters the footer trailer tokens can contain.
+func (m *machine) WithFooterTokenChars(chars string) {
+	m.footerTokenChars = chars
+}
+
+// WithFooterKeys restricts the footer trailers to the ones with the given tokens.
+func (m *machine) WithFooterKeys(keys ...string) {
+	m.footerKeys = make(map[string]bool, len(keys))
+	for _, key := range keys {
+		m.footerKeys[footerKey(key)] = true
+	}
+}
+
+// WithRequiredFooterKeys sets the tokens of the footer trailers the commit messages must have.
+func (m *machine) WithRequiredFooterKeys(keys ...string) {
+	m.requiredFooters = keys
+}
+
+// WithoutDuplicateFooterKeys forbids the footer trailers to repeat their tokens.
+func (m *machine) WithoutDuplicateFooterKeys() {
+	m.noDuplicates = true
+}
+
+// WithVerbatimBody enables the verbatim body mode.
+func (m *machine) WithVerbatimBody() {
+	m.verbatimBody = true
+}
+
+// HasVerbatimBody tells whether the receiving machine has the verbatim body mode on or off.
+func (m *machine) HasVerbatimBody() bool {
+	return m.verbatimBody
+}
+
+// WithZeroCopy enables the zero-copy mode.
+func (m *machine) WithZeroCopy() {
+	m.zeroCopy = true
+}
+
+// HasZeroCopy tells whether the receiving machine has the zero-copy mode on or off.
+func (m *machine) HasZeroCopy() bool {
+	return m.zeroCopy
+}
+
+// WithFooterInAnyParagraph lets the footer start in any paragraph following the header.
+func (m *machine) WithFooterInAnyParagraph() {
+	m.footerAnyParagraph = true
+}
+
+// WithGitTrailers enables the git trailers compatibility mode, with the given footer trailer separators.
+func (m *machine) WithGitTrailers(separators string) {
+	m.gitTrailers = true
+	m.gitSeparators = separators
+	if m.gitSeparators == "" {
+		m.gitSeparators = ":"
+	}
+}
+
+// HasGitTrailers tells whether the receiving machine has the git trailers compatibility mode on or off.
+func (m *machine) HasGitTrailers() bool {
+	return m.gitTrailers
+}
+
 // WithTypes tells the parser which commit message types to consider.
 func (m *machine) WithTypes(t conventionalcommits.TypeConfig) {
 	m.typeConfig = t
 }
 
+// WithListener tells the parser which listener to notify about what it finds.
+func (m *machine) WithListener(l conventionalcommits.Listener) {
+	m.listener = l
+}
+
 // WithLogger tells the parser which logger to use.
-func (m *machine) WithLogger(l *logrus.Logger) {
+func (m *machine) WithLogger(l conventionalcommits.StructuredLogger) {
 	m.logger = l
 }
@@ -3,9 +3,10 @@ package parser
 import (
 	"fmt"
 	"bytes"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/leodido/go-conventionalcommits"
-	"github.com/sirupsen/logrus"
 )
 
 // ColumnPositionTemplate is the template used to communicate the column where errors occur.
@@ -16,6 +17,10 @@ const (
 	ErrType = "illegal '%s' character in commit message type"
 	// ErrTypeIncomplete represents an error when the type part of the commit message is not complete.
 	ErrTypeIncomplete = "incomplete commit message type after '%s' character"
+	// ErrGitmoji represents an error about illegal characters into the gitmoji prefix of the commit message.
+	ErrGitmoji = "illegal '%s' character in gitmoji"
+	// ErrGitmojiIncomplete represents an error when the gitmoji prefix of the commit message is not complete.
+	ErrGitmojiIncomplete = "incomplete gitmoji after '%s' character"
 	// ErrColon is the error message that communicate that the mandatory colon after the type part of the commit message is missing.
 	ErrColon = "expecting colon (':') character, got '%s' character"
 	// ErrScope represents an error about illegal characters into the the scope part of the commit message.
@@ -30,6 +35,20 @@ const (
 	ErrDescriptionInit = "expecting at least one white-space (' ') character, got '%s' character"
 	// ErrDescription tells the user that after the whitespace is mandatory a description.
 	ErrDescription = "expecting a description text (without newlines) after '%s' character"
+	// ErrDescriptionWhitespace tells the user that in strict mode exactly one whitespace is allowed before the description.
+	ErrDescriptionWhitespace = "expecting exactly one white-space (' ') character, got another '%s' character"
+	// ErrDescriptionTrailingWhitespace tells the user that in strict mode the description can not end with whitespaces.
+	ErrDescriptionTrailingWhitespace = "illegal trailing white-space (' ') character in description"
+	// ErrDescriptionTab tells the user that in strict mode tab characters are not allowed into the description.
+	ErrDescriptionTab = "illegal tab character in description"
+	// ErrHeaderMaxLength tells the user that the header (the first line of the commit message) is too long.
+	ErrHeaderMaxLength = "header exceeds the maximum length of %d characters with '%s' character"
+	// ErrDescriptionMinLength tells the user that the description is too short.
+	ErrDescriptionMinLength = "expecting a description text of at least %d characters, got %d characters"
+	// ErrDescriptionTrailingPeriod tells the user that the description can not end with a period.
+	ErrDescriptionTrailingPeriod = "illegal trailing period ('.') character in description"
+	// ErrDescriptionEncoding tells the user that the description contains an invalid UTF-8 sequence.
+	ErrDescriptionEncoding = "invalid UTF-8 sequence in description"
 	// ErrNewline communicates an illegal newline to the user.
 	ErrNewline = "illegal newline"
 	// ErrMissingBlankLineAtBeginning tells the user that the a blank line is missing after the description or after the body.
@@ -38,6 +57,12 @@ const (
 	ErrTrailer = "illegal '%s' character in trailer"
 	// ErrTrailerIncomplete represent an error when a trailer is not complete.
 	ErrTrailerIncomplete = "incomplete footer trailer after '%s' character"
+	// ErrTrailerTokenNotAllowed represents an error when a footer trailer token is not among the allowed ones.
+	ErrTrailerTokenNotAllowed = "footer trailer token '%s' not allowed"
+	// ErrTrailerTokenDuplicate represents an error when a footer trailer token repeats, while duplicates are forbidden.
+	ErrTrailerTokenDuplicate = "duplicate footer trailer token '%s'"
+	// ErrTrailerTokenMissing represents an error when a required footer trailer is missing.
+	ErrTrailerTokenMissing = "missing required footer trailer token '%s'"
 )
 
 %%{
@@ -45,6 +70,9 @@ machine conventionalcommits;
 
 include common "common.rl";
 
+# Generated from common/types.json (see tools/typesgen)
+include types "types.rl";
+
 # unsigned alphabet
 alphtype uint8;
 
@@ -58,13 +86,22 @@ action err_empty {
 	m.err = m.emitErrorWithoutCharacter(ErrEmpty)
 }
 
+action err_gitmoji {
+	if m.p < m.pe {
+		m.err = m.emitErrorOnCurrentCharacter(ErrGitmoji)
+	} else {
+		// assert(m.p == m.pe)
+		m.err = m.emitErrorOnPreviousCharacter(ErrGitmojiIncomplete)
+	}
+}
+
 action err_type {
 	if m.pe > 0 {
 		if m.p != m.pe {
-			m.err = m.emitErrorOnCurrentCharacter(ErrType)
+			m.err = m.suggestType(m.emitErrorOnCurrentCharacter(ErrType))
 		} else {
 			// assert(m.p == m.pe)
-			m.err = m.emitErrorOnPreviousCharacter(ErrTypeIncomplete)
+			m.err = m.suggestType(m.emitErrorOnPreviousCharacter(ErrTypeIncomplete))
 		}
 	}
 }
@@ -72,32 +109,56 @@ action err_type {
 action err_malformed_scope {
 	if m.p < m.pe {
 		m.err = m.emitErrorOnCurrentCharacter(ErrScope)
+	} else {
+		// assert(m.p == m.pe)
+		m.err = m.emitErrorOnPreviousCharacter(ErrScopeIncomplete)
 	}
 }
 
-action err_malformed_scope_closing {
-	// assert(m.p == m.pe)
-	m.err = m.emitErrorOnPreviousCharacter(ErrScopeIncomplete)
-}
-
 action err_colon {
 	if m.err == nil {
-		m.err = m.emitErrorOnCurrentCharacter(ErrColon)
+		m.err = m.fixColon(m.emitErrorOnCurrentCharacter(ErrColon))
 	}
 }
 
 action err_description_init {
 	if m.err == nil {
-		m.err = m.emitErrorOnCurrentCharacter(ErrDescriptionInit)
+		m.err = m.fixDescriptionInit(m.emitErrorOnCurrentCharacter(ErrDescriptionInit))
 	}
 }
 
 action err_description {
-	if m.p < m.pe && m.data[m.p] == 10 {
-		m.err = m.emitError(ErrNewline, m.p + 1)
+	if m.err == nil {
+		if m.p < m.pe {
+			switch m.data[m.p] {
+			case 9:
+				// assert(m.strictWhitespace)
+				m.err = m.emitErrorWithoutCharacter(ErrDescriptionTab)
+			case 32:
+				// assert(m.strictWhitespace)
+				m.err = m.emitErrorOnCurrentCharacter(ErrDescriptionWhitespace)
+			case 10:
+				m.err = m.emitError(m.p + 1, ErrNewline)
+			default:
+				m.err = m.emitError(m.runeStart(), ErrDescriptionEncoding)
+			}
+		} else {
+			// assert(m.p == m.pe)
+			m.err = m.emitErrorOnPreviousCharacter(ErrDescription)
+		}
+	}
+}
+
+action err_description_strict {
+	if m.p < m.pe && m.data[m.p] == 9 {
+		m.err = m.emitErrorWithoutCharacter(ErrDescriptionTab)
+	} else if m.p < m.pe && m.data[m.p] != 10 {
+		m.err = m.emitError(m.runeStart(), ErrDescriptionEncoding)
 	} else {
-		// assert(m.p == m.pe)
-		m.err = m.emitErrorOnPreviousCharacter(ErrDescription)
+		// assert(m.data[m.p - 1] == 32)
+		i := m.p - 1
+		for ; m.data[i - 1] == 32; i-- {}
+		m.err = m.emitError(i, ErrDescriptionTrailingWhitespace)
 	}
 }
 
@@ -108,74 +169,176 @@ action check_early_exit {
 }
 
 action err_begin_blank_line {
-	m.err = m.emitErrorWithoutCharacter(ErrMissingBlankLineAtBeginning)
+	m.err = m.fixBlankLine(m.emitErrorWithoutCharacter(ErrMissingBlankLineAtBeginning))
+}
+
+action err_trailer_value {
+	if m.p < m.pe {
+		m.err = m.emitErrorOnCurrentCharacter(ErrTrailer)
+	} else {
+		// The input ends right after the footer trailer separator
+		m.err = m.emitErrorOnPreviousCharacter(ErrTrailerIncomplete)
+	}
 }
 
 # Setters
 
 action set_type {
-	output._type = string(m.text())
-	m.emitInfo("valid commit message type", "type", output._type)
+	output._type = m.str(m.pb, m.p)
+	if m.logger != nil {
+		m.logger.Info("valid commit message type", "type", output._type)
+	}
+	// Notify only the complete type (ie., not its prefixes)
+	if m.listener != nil && m.p < m.pe && bytes.IndexByte([]byte("(!:"), m.data[m.p]) >= 0 {
+		m.listener.OnType(output._type, conventionalcommits.Span{Start: m.pb, End: m.p})
+	}
+}
+
+action set_gitmoji {
+	output.gitmoji = m.str(m.pb, m.p)
+	m.typeStart = m.p
+	if m.logger != nil {
+		m.logger.Info("valid commit message gitmoji", "gitmoji", output.gitmoji)
+	}
 }
 
 action set_scope {
-	output.scope = string(m.text())
-	m.emitInfo("valid commit message scope", "scope", output.scope)
+	output.scope = m.str(m.pb, m.p)
+	if m.logger != nil {
+		m.logger.Info("valid commit message scope", "scope", output.scope)
+	}
+	if m.listener != nil {
+		m.listener.OnScope(output.scope, conventionalcommits.Span{Start: m.pb, End: m.p})
+	}
 }
 
 action set_description {
-	output.descr = string(m.text())
-	m.emitInfo("valid commit message description", "description", output.descr)
+	output.descr = m.str(m.pb, m.p)
+	if m.logger != nil {
+		m.logger.Info("valid commit message description", "description", output.descr)
+	}
+	if m.listener != nil {
+		m.listener.OnDescription(output.descr, conventionalcommits.Span{Start: m.pb, End: m.p})
+	}
+}
+
+action check_header {
+	if m.err = m.checkHeader(); m.err != nil {
+		if m.recovery {
+			// Record the error and continue, the header is well-formed anyway
+			m.recover()
+		} else {
+			fbreak;
+		}
+	}
 }
 
 action set_exclamation {
 	output.exclamation = true
-	m.emitInfo("commit message communicates a breaking change")
+	if m.logger != nil {
+		m.logger.Info("commit message communicates a breaking change")
+	}
+	if m.listener != nil {
+		m.listener.OnBreaking(conventionalcommits.Span{Start: m.p, End: m.p + 1})
+	}
 }
 
 action set_body_blank_line {
-	m.emitDebug("found a blank line", "pos", m.p)
+	if m.logger != nil {
+		m.logger.Debug("found a blank line", "pos", m.p)
+	}
 }
 
 action set_current_footer_key {
-	// todo > alnum[[- ]alnum] string to lower can be more performant?
-	m.currentFooterKey = string(bytes.ToLower(m.text()))
+	m.currentFooterKey = strings.ToLower(m.str(m.pb, m.p))
 	if m.currentFooterKey == "breaking change" {
 		m.currentFooterKey = "breaking-change"
 	}
-	m.emitDebug("possibly valid footer token", "token", m.currentFooterKey, "pos", m.p)
+	m.footerKeySpan = conventionalcommits.Span{Start: m.pb, End: m.p}
+	if m.logger != nil {
+		m.logger.Debug("possibly valid footer token", "token", m.currentFooterKey, "pos", m.p)
+	}
 }
 
 action set_footer {
-	output.footers[m.currentFooterKey] = append(output.footers[m.currentFooterKey], string(m.text()))
-	m.emitInfo("valid commit message footer trailer", m.currentFooterKey, string(m.text()))
+	if m.err = m.checkFooter(output.footers); m.err != nil {
+		if m.recovery {
+			// Record the error and continue, skipping the footer trailer
+			m.recover()
+		} else {
+			fbreak;
+		}
+	} else {
+		if len(output.footers) == 0 {
+			m.footerStart = m.footerKeySpan.Start
+		}
+		value := m.str(m.pb, m.p)
+		output.footers[m.currentFooterKey] = append(output.footers[m.currentFooterKey], value)
+		if m.logger != nil {
+			m.logger.Info("valid commit message footer trailer", m.currentFooterKey, value)
+		}
+		if m.listener != nil {
+			// The body, if any, is complete
+			m.flushBody()
+			if m.currentFooterKey == "breaking-change" {
+				m.listener.OnBreaking(m.footerKeySpan)
+			}
+			m.listener.OnFooter(m.currentFooterKey, value, m.footerKeySpan, conventionalcommits.Span{Start: m.pb, End: m.p})
+		}
+	}
+}
+
+action set_git_body {
+	end := m.pb + len(bytes.TrimRight(m.text(), "\n"))
+	m.body.reset()
+	m.body.add(m.data, m.pb, end)
+	if m.listener != nil {
+		m.notifyBody(m.pb, end)
+	}
+	if m.logger != nil {
+		m.logger.Info("valid commit message body content", "body", string(m.data[m.pb:end]))
+	}
 }
 
 action count_nl {
 	// Increment number of newlines to use in case we're still in the body
 	m.countNewlines++
 	m.lastNewline = m.p
-	m.emitDebug("found a newline", "pos", m.p)
+	if m.logger != nil {
+		m.logger.Debug("found a newline", "pos", m.p)
+	}
 }
 
 action append_body {
+	if m.listener != nil {
+		m.notifyBody(m.pb - m.countNewlines, m.p)
+	}
 	// Append newlines
+	m.body.addNewlines(m.data, m.pb, m.countNewlines)
 	for ; m.countNewlines > 0; {
-		output.body += "\n"
 		m.countNewlines--
-		m.emitInfo("valid commit message body content", "body", "\n")
+		if m.logger != nil {
+			m.logger.Info("valid commit message body content", "body", "\n")
+		}
 	}
 	// Append body content
-	output.body += string(m.text())
-	m.emitInfo("valid commit message body content", "body", string(m.text()))
+	m.body.add(m.data, m.pb, m.p)
+	if m.logger != nil {
+		m.logger.Info("valid commit message body content", "body", string(m.text()))
+	}
 }
 
 action append_body_before_blank_line {
 	// Append content to body
 	m.pb++
 	m.p++
-	output.body += string(m.text())
-	m.emitInfo("valid commit message body content", "body", string(m.text()))
+	m.body.add(m.data, m.pb, m.p)
+	if m.listener != nil {
+		m.notifyBody(m.pb, m.p)
+	}
+	if m.logger != nil {
+		m.logger.Info("valid commit message body content", "body", string(m.text()))
+	}
 	// Do not advance over the current char
 	fhold;
 }
@@ -183,12 +346,27 @@ action append_body_before_blank_line {
 # Jumps
 
 action start_trailer_parsing {
-	m.emitDebug("try to parse a footer trailer token", "pos", m.p)
+	// Mark the next character so that rewinds can not go back before it
+	// Eg., when it is not a possible trailer token start
+	m.pb = m.p + 1
+	if m.bodyStart < 0 {
+		// The first time is right after the header
+		m.bodyStart = m.pb
+	}
+	if m.gitTrailers {
+		// The footer is the trailer block git finds, already cut off from the input
+		fgoto git_body;
+	}
+	if m.logger != nil {
+		m.logger.Debug("try to parse a footer trailer token", "pos", m.p)
+	}
 	fgoto trailer_beg;
 }
 
 action complete_trailer_parsing {
-	m.emitDebug("try to parse a footer trailer value", "pos", m.p)
+	if m.logger != nil {
+		m.logger.Debug("try to parse a footer trailer value", "pos", m.p)
+	}
 	fgoto trailer_end;
 }
 
@@ -203,7 +381,9 @@ action rewind {
 			m.pb = m.lastNewline + 1
 		}
 		fexec m.pb;
-		m.emitDebug("try to parse body content", "pos", m.p)
+		if m.logger != nil {
+			m.logger.Debug("try to parse body content", "pos", m.p)
+		}
 		fgoto body;
 	} else {
 		// A rewind happens when an error while parsing a footer trailer is encountered
@@ -215,42 +395,154 @@ action rewind {
 			// assert(m.p == m.pe)
 			m.err = m.emitErrorOnPreviousCharacter(ErrTrailerIncomplete)
 		}
+		if m.recovery {
+			m.recover()
+			if m.p < m.pe {
+				// Skip the current line and continue looking for footer trailers
+				fhold;
+				fgoto trailer_recovery;
+			}
+		}
+	}
+}
+
+# Recovery
+
+action recover_header {
+	if m.recovery {
+		m.recover()
+		if m.p < m.pe {
+			// Skip the rest of the header
+			fhold;
+			fgoto header_recovery;
+		}
+	}
+}
+
+action recover_blank_line {
+	if m.recovery {
+		m.recover()
+		if m.p < m.pe {
+			// Assume the body (or the footer) starts at the current character
+			m.pb = m.p
+			if m.bodyStart < 0 {
+				m.bodyStart = m.pb
+			}
+			fhold;
+			if m.gitTrailers {
+				fgoto git_body;
+			}
+			fgoto trailer_beg;
+		}
 	}
 }
 
+action recover_trailer_value {
+	if m.recovery {
+		m.recover()
+		if m.p < m.pe {
+			// Skip the rest of the current footer trailer
+			fhold;
+			fgoto trailer_recovery;
+		}
+	}
+}
+
+action skip_blank_line {
+	if m.p + 1 < m.pe && m.data[m.p + 1] == 10 {
+		m.p++
+	}
+}
+
+action strict_whitespace { m.strictWhitespace }
+
+action gitmoji { m.gitmoji }
+
+action type_aliases { m.typeAliases }
+
+action final_paragraph { m.footerAnyParagraph || m.p >= m.finalParagraph || m.breakingChangeLine() }
+
+action footer_token_char { m.footerTokenChars != "" && strings.IndexByte(m.footerTokenChars, m.data[m.p]) >= 0 }
+
 action blank_line_ahead { m.p + 2 < m.pe && m.data[m.p + 1] == 10 && m.data[m.p + 2] == 10 }
 
 # Machine definitions
 
-minimal_types = ('fix'i | 'feat'i);
+# Gitmoji shortcodes (eg., :sparkles:)
+gitmoji_shortcode = colon (lower | digit | [_+\-])+ colon;
+
+# Pictographic characters (U+2300..U+23FF, U+2600..U+27BF, U+2B00..U+2BFF, U+1F000..U+1FAFF)
+emoji_char = 0xE2 (0x8C..0x8F | 0x98..0x9E | 0xAC..0xAF) utf8_tail | 0xF0 0x9F 0x80..0xAB utf8_tail;
+
+# Variation selector-16 (U+FE0F)
+emoji_presentation = 0xEF 0xB8 0x8F;
+
+# Zero width joiner (U+200D)
+emoji_zwj = 0xE2 0x80 0x8D;
 
-conventional_types = ('build'i | 'ci'i | 'chore'i | 'docs'i | 'feat'i | 'fix'i | 'perf'i | 'refactor'i | 'revert'i | 'style'i | 'test'i);
+# Unicode emojis, eventually joined into sequences (eg., ✨, ⚡️, 🧑‍💻)
+gitmoji_unicode = emoji_char emoji_presentation? (emoji_zwj emoji_char emoji_presentation?)*;
 
-falco_types = ('build'i | 'ci'i | 'chore'i | 'docs'i | 'feat'i | 'fix'i | 'perf'i | 'new'i | 'revert'i | 'update'i | 'test'i | 'rule'i);
+# Optional gitmoji prefix, only when the gitmoji mode is on
+gitmoji_prefix = ((gitmoji_shortcode | gitmoji_unicode) >mark <>err(err_gitmoji) %set_gitmoji ws*) when gitmoji;
 
-free_form_types = print+;
+# Aliases of the types (see common/types.json), only when the type aliases mode is on
 
-scope = lpar ((print* -- lpar) -- rpar) >mark %err(err_malformed_scope) %eof(err_malformed_scope_closing) %set_scope rpar;
+minimal_types = minimal_types_list | (minimal_type_aliases_list when type_aliases);
+
+conventional_types = conventional_types_list | (conventional_type_aliases_list when type_aliases);
+
+falco_types = falco_types_list | (falco_type_aliases_list when type_aliases);
+
+angular_types = angular_types_list | (angular_type_aliases_list when type_aliases);
+
+electron_types = electron_types_list | (electron_type_aliases_list when type_aliases);
+
+eslint_types = eslint_types_list | (eslint_type_aliases_list when type_aliases);
+
+# Linux kernel subsystems (eg., net, drm/i915, x86/mm)
+linux_kernel_types = (alnum | [_./\-])+;
+
+free_form_types = utf8_print+;
+
+scope = lpar ((utf8_print* -- lpar) -- rpar) >mark $err(err_malformed_scope) %set_scope rpar;
+
+# Angular scopes are lowercase names, eventually containing dashes (eg., compiler-cli)
+angular_scope = lpar ((lower | digit) (lower | digit | dash)*) >mark $err(err_malformed_scope) %set_scope rpar;
 
 breaking = exclamation >set_exclamation;
 
-## todo > strict option to enforce a single whitespace?
-description = ws+ >err(err_description_init) <: (any - nl)+ >mark >err(err_description) %set_description;
+description_char = utf8_any - nl;
+
+description_strict_char = description_char - ws - tab;
 
-blank_line = nl nl >err(err_begin_blank_line) >set_body_blank_line;
+# By default, any number of whitespaces can separate the colon from the description.
+description_lenient = (ws* <: description_char+ >mark $err(err_description) %set_description %check_header) when !strict_whitespace;
+
+# In strict mode exactly one whitespace separates the colon from the description.
+# Also, the description can not contain tabs nor end with whitespaces.
+description_strict = (description_strict_char ((description_char - tab)* description_strict_char)?) >mark <err(err_description_strict) %set_description %check_header when strict_whitespace;
+
+description = ws >err(err_description_init) (description_lenient | description_strict);
+
+blank_line = nl nl >err(err_begin_blank_line) >err(recover_blank_line) >set_body_blank_line;
 
 trailer_tok_breaking = 'BREAKING CHANGE';
 
 trailer_sep_breaking = colon ws+;
 
-trailer_tok = alnum+ (dash alnum+)*;
+# The characters the footer trailer tokens contain, eventually extended by the user (eg., underscores)
+trailer_tok_char = alnum | ((graph - alnum - dash - colon - '#') when footer_token_char);
+
+trailer_tok = trailer_tok_char+ (dash trailer_tok_char+)*;
 
 trailer_sep = trailer_sep_breaking | (ws '#');
 
-trailer_val = print+;
+trailer_val = utf8_print+;
 
-trailer_init = trailer_tok_breaking >mark @err(rewind) trailer_sep_breaking >set_current_footer_key @err(rewind) |
-               trailer_tok >mark @err(rewind) trailer_sep >set_current_footer_key @err(rewind);
+# Only the final paragraph contains footer trailers, unless they can be in any paragraph.
+trailer_init = (trailer_tok_breaking >mark @err(rewind) trailer_sep_breaking >set_current_footer_key @err(rewind) |
+               trailer_tok >mark @err(rewind) trailer_sep >set_current_footer_key @err(rewind)) when final_paragraph;
 
 # Count newlines that can be part of the body or just trailer separators.
 # Optionally match a trailer token followed by a trailer separator.
@@ -260,42 +552,80 @@ trailer_beg := nl* $count_nl (trailer_init @complete_trailer_parsing)?;
 
 # Match a trailer value.
 # Then, ignoring newlines, continue trying to detect other trailers.
-trailer_end := trailer_val >mark %set_footer nl* $count_nl @start_trailer_parsing;
+trailer_end := trailer_val >mark $err(err_trailer_value) $err(recover_trailer_value) %set_footer nl* $count_nl @start_trailer_parsing;
 
 # Match anything until two newlines (ie., a blank line).
 # Then, try detect a footer looking for a trailer token.
 body := (any >mark $err(append_body) %append_body %err(append_body_before_blank_line) when !blank_line_ahead)+ $err(start_trailer_parsing);
 
+# In git trailers mode, everything between the blank line after the header and the trailer block git finds is body content.
+git_body := (any+ >mark %set_git_body)?;
+
+# Skip everything until the end of the line, in recovery mode.
+# Then, try detect a footer looking for a trailer token.
+trailer_recovery := (any - nl)* nl @start_trailer_parsing;
+
+# Skip everything until the end of the header, and the blank line after it, in recovery mode.
+# Then, try detect a footer looking for a trailer token.
+header_recovery := (any - nl)* nl @skip_blank_line @start_trailer_parsing;
+
 # Expect a blank line after the description.
 # Try detect a footer looking for a trailer token.
 remainder = blank_line @start_trailer_parsing;
 
-main := minimal_types >eof(err_empty) >mark @err(err_type) %from(set_type) %to(check_early_exit)
+main := (gitmoji_prefix? <: minimal_types >eof(err_empty) >mark @err(err_type) %from(set_type) %to(check_early_exit)
 	scope? %to(check_early_exit)
 	breaking? %to(check_early_exit)
 	colon >err(err_colon) %to(check_early_exit)
-	description
+	description) $err(recover_header)
 	remainder?;
 
-conventional_types_main := conventional_types >eof(err_empty) >mark @err(err_type) %from(set_type) %to(check_early_exit)
+conventional_types_main := (gitmoji_prefix? <: conventional_types >eof(err_empty) >mark @err(err_type) %from(set_type) %to(check_early_exit)
 	scope? %to(check_early_exit)
 	breaking? %to(check_early_exit)
 	colon >err(err_colon) %to(check_early_exit)
-	description
+	description) $err(recover_header)
 	remainder?;
 
-falco_types_main := falco_types >eof(err_empty) >mark @err(err_type) %from(set_type) %to(check_early_exit)
+falco_types_main := (gitmoji_prefix? <: falco_types >eof(err_empty) >mark @err(err_type) %from(set_type) %to(check_early_exit)
 	scope? %to(check_early_exit)
 	breaking? %to(check_early_exit)
 	colon >err(err_colon) %to(check_early_exit)
-	description
+	description) $err(recover_header)
 	remainder?;
 
-free_form_types_main := free_form_types >eof(err_empty) >mark @err(err_type) %from(set_type) %to(check_early_exit)
+angular_types_main := (gitmoji_prefix? <: angular_types >eof(err_empty) >mark @err(err_type) %from(set_type) %to(check_early_exit)
+	angular_scope? %to(check_early_exit)
+	breaking? %to(check_early_exit)
+	colon >err(err_colon) %to(check_early_exit)
+	description) $err(recover_header)
+	remainder?;
+
+electron_types_main := (gitmoji_prefix? <: electron_types >eof(err_empty) >mark @err(err_type) %from(set_type) %to(check_early_exit)
+	scope? %to(check_early_exit)
+	breaking? %to(check_early_exit)
+	colon >err(err_colon) %to(check_early_exit)
+	description) $err(recover_header)
+	remainder?;
+
+eslint_types_main := (gitmoji_prefix? <: eslint_types >eof(err_empty) >mark @err(err_type) %from(set_type) %to(check_early_exit)
+	scope? %to(check_early_exit)
+	breaking? %to(check_early_exit)
+	colon >err(err_colon) %to(check_early_exit)
+	description) $err(recover_header)
+	remainder?;
+
+# The Linux kernel style (ie., "subsystem: description") has neither scopes nor exclamation marks
+linux_kernel_types_main := (gitmoji_prefix? <: linux_kernel_types >eof(err_empty) >mark @err(err_type) %from(set_type) %to(check_early_exit)
+	colon >err(err_colon) %to(check_early_exit)
+	description) $err(recover_header)
+	remainder?;
+
+free_form_types_main := (gitmoji_prefix? <: free_form_types >eof(err_empty) >mark @err(err_type) %from(set_type) %to(check_early_exit)
 	:> (scope? %to(check_early_exit))
 	breaking? %to(check_early_exit)
 	:>> colon >err(err_colon) %to(check_early_exit)
-	description
+	description) $err(recover_header)
 	remainder?;
 
 }%%
@@ -309,8 +639,34 @@ type machine struct {
 	pb               int
 	err              error
 	bestEffort       bool
+	recovery         bool
+	typeStart        int
+	errors           []error
+	strictWhitespace bool
+	gitmoji          bool
+	typeAliases      bool
+	headerMaxLength  int
+	descrMinLength   int
+	noTrailingPeriod bool
+	footerTokenChars string
+	footerKeys       map[string]bool
+	requiredFooters  []string
+	noDuplicates     bool
+	gitTrailers      bool
+	footerAnyParagraph bool
+	zeroCopy         bool
+	input            string
+	body             bodyBuilder
+	verbatimBody     bool
+	bodyStart        int
+	footerStart      int
+	finalParagraph   int
+	gitSeparators    string
 	typeConfig       conventionalcommits.TypeConfig
-	logger           *logrus.Logger
+	logger           conventionalcommits.StructuredLogger
+	listener         conventionalcommits.Listener
+	bodyChunk        conventionalcommits.Span
+	footerKeySpan    conventionalcommits.Span
 	currentFooterKey string
 	countNewlines    int
 	lastNewline      int
@@ -320,44 +676,353 @@ func (m *machine) text() []byte {
 	return m.data[m.pb:m.p]
 }
 
-func (m *machine) emitInfo(s string, args... interface{}) {
-	if m.logger != nil {
-		logEntry := logrus.NewEntry(m.logger)
-		for i := 0; i < len(args); i = i + 2 {
-			logEntry = m.logger.WithField(args[0].(string), args[1])
-		}
-		logEntry.Infoln(s)
+// str returns the input from the given start to the given end as a string.
+//
+// In zero-copy mode it does not allocate, since the string shares the memory of the input copy made at the beginning.
+func (m *machine) str(start, end int) string {
+	if m.zeroCopy {
+		return m.input[start:end]
 	}
+
+	return string(m.data[start:end])
 }
 
-func (m *machine) emitDebug(s string, args... interface{}) {
-	if m.logger != nil {
-		logEntry := logrus.NewEntry(m.logger)
-		for i := 0; i < len(args); i = i + 2 {
-			logEntry = m.logger.WithField(args[0].(string), args[1])
-		}
-		logEntry.Debugln(s)
+// bodyString returns the body content as a string.
+func (m *machine) bodyString() string {
+	if m.body.contiguous() {
+		return m.str(m.body.start, m.body.end)
 	}
+
+	return string(m.body.buf)
 }
 
-func (m *machine) emitError(s string, args... interface{}) error {
-	e := fmt.Errorf(s + ColumnPositionTemplate, args...)
+func (m *machine) emitError(p int, s string, args... interface{}) error {
+	col := m.column(p)
+	e := &Error{
+		message: fmt.Sprintf(s + ColumnPositionTemplate, append(args, col)...),
+		reason: fmt.Sprintf(s, args...),
+		template: s,
+		Section: sections[s],
+		Offset: p,
+		Column: col,
+	}
 	if m.logger != nil {
-		m.logger.Errorln(e)
+		m.logger.Error(e.message)
+	}
+	if m.listener != nil {
+		span := conventionalcommits.Span{Start: p, End: p}
+		if p < len(m.data) {
+			_, size := utf8.DecodeRune(m.data[p:])
+			span.End += size
+		}
+		m.listener.OnError(e, span)
 	}
 	return e
 }
 
 func (m *machine) emitErrorWithoutCharacter(messageTemplate string) error {
-	return m.emitError(messageTemplate, m.p)
+	return m.emitError(m.p, messageTemplate)
 }
 
 func (m *machine) emitErrorOnCurrentCharacter(messageTemplate string) error {
-	return m.emitError(messageTemplate, string(m.data[m.p]), m.p)
+	p := m.runeStart()
+	if p < m.p {
+		if r, size := utf8.DecodeRune(m.data[p:]); r != utf8.RuneError && p+size == m.p+1 {
+			// The current character is the last byte of a multi-byte character
+			return m.emitError(p, messageTemplate, string(r))
+		}
+		// The current character breaks a multi-byte character
+		return m.emitError(p, messageTemplate, string(utf8.RuneError))
+	}
+	r, _ := utf8.DecodeRune(m.data[m.p:])
+
+	return m.emitError(m.p, messageTemplate, string(r))
 }
 
 func (m *machine) emitErrorOnPreviousCharacter(messageTemplate string) error {
-	return m.emitError(messageTemplate, string(m.data[m.p - 1]), m.p)
+	r, _ := utf8.DecodeLastRune(m.data[:m.p])
+
+	return m.emitError(m.p, messageTemplate, string(r))
+}
+
+// column converts the input position into a column counting runes rather than bytes.
+func (m *machine) column(p int) int {
+	if p > len(m.data) {
+		return utf8.RuneCount(m.data) + p - len(m.data)
+	}
+
+	return utf8.RuneCount(m.data[:p])
+}
+
+// runeStart returns the position of the incomplete multi-byte character preceding the current position, if any.
+//
+// Otherwise, it returns the current position.
+func (m *machine) runeStart() int {
+	for i := m.p - 1; i >= 0 && i >= m.p - utf8.UTFMax + 1; i-- {
+		if utf8.RuneStart(m.data[i]) {
+			if m.data[i] >= 0xC0 && !utf8.FullRune(m.data[i:m.p]) {
+				return i
+			}
+			break
+		}
+	}
+
+	return m.p
+}
+
+// checkHeader enforces the limits about the header length and the description content.
+//
+// It assumes the machine has just parsed the description, thus the header ends at the current position.
+// Lengths are counted in runes, while the returned errors point to the first character beyond the limits.
+func (m *machine) checkHeader() error {
+	if m.headerMaxLength > 0 && utf8.RuneCount(m.data[:m.p]) > m.headerMaxLength {
+		i := 0
+		for n := 0; n < m.headerMaxLength; n++ {
+			_, size := utf8.DecodeRune(m.data[i:m.p])
+			i += size
+		}
+		r, _ := utf8.DecodeRune(m.data[i:m.p])
+
+		return m.emitError(i, ErrHeaderMaxLength, m.headerMaxLength, string(r))
+	}
+	if m.noTrailingPeriod && m.data[m.p-1] == 46 {
+		return m.emitError(m.p-1, ErrDescriptionTrailingPeriod)
+	}
+	if m.descrMinLength > 0 {
+		if n := utf8.RuneCount(m.text()); n < m.descrMinLength {
+			return m.emitError(m.p, ErrDescriptionMinLength, m.descrMinLength, n)
+		}
+	}
+
+	return nil
+}
+
+// checkFooter enforces the restrictions about the footer trailer tokens.
+//
+// It assumes the machine has just parsed the value of the current footer trailer.
+// The returned errors point to its token.
+func (m *machine) checkFooter(footers map[string][]string) error {
+	token := string(m.data[m.footerKeySpan.Start:m.footerKeySpan.End])
+	if m.footerKeys != nil && m.currentFooterKey != "breaking-change" && !m.footerKeys[m.currentFooterKey] {
+		return m.emitError(m.footerKeySpan.Start, ErrTrailerTokenNotAllowed, token)
+	}
+	if _, ok := footers[m.currentFooterKey]; ok && m.noDuplicates {
+		return m.emitError(m.footerKeySpan.Start, ErrTrailerTokenDuplicate, token)
+	}
+
+	return nil
+}
+
+// checkRequiredFooters returns the errors about the required footer trailers missing, pointing to the end of the input.
+func (m *machine) checkRequiredFooters(footers map[string][]string) []error {
+	var errs []error
+	for _, key := range m.requiredFooters {
+		if _, ok := footers[footerKey(key)]; !ok {
+			errs = append(errs, m.emitError(len(m.data), ErrTrailerTokenMissing, key))
+		}
+	}
+
+	return errs
+}
+
+// addGitTrailers adds the footer trailers of the trailer block git finds, enforcing the restrictions about their tokens.
+func (m *machine) addGitTrailers(footers map[string][]string, trailers []gitTrailer) {
+	for _, t := range trailers {
+		m.currentFooterKey = footerKey(string(m.data[t.token.Start:t.token.End]))
+		m.footerKeySpan = t.token
+		if err := m.checkFooter(footers); err != nil {
+			if !m.recovery {
+				m.err = err
+				return
+			}
+			m.errors = append(m.errors, err)
+			continue
+		}
+		footers[m.currentFooterKey] = append(footers[m.currentFooterKey], t.value)
+		if m.logger != nil {
+			m.logger.Info("valid commit message footer trailer", m.currentFooterKey, t.value)
+		}
+		if m.listener != nil {
+			if m.currentFooterKey == "breaking-change" {
+				m.listener.OnBreaking(t.token)
+			}
+			m.listener.OnFooter(m.currentFooterKey, t.value, t.token, t.valuePos)
+		}
+	}
+}
+
+// finalParagraph returns the offset where the final paragraph (ie., the one following the last blank line) starts.
+//
+// The trailing newlines do not count.
+func finalParagraph(input []byte) int {
+	end := len(input)
+	for end > 0 && input[end-1] == 10 {
+		end--
+	}
+
+	return bytes.LastIndex(input[:end], []byte("\n\n")) + 2
+}
+
+// breakingChangePrefixes contains the beginnings of the lines containing a breaking change footer trailer.
+var breakingChangePrefixes = [][]byte{[]byte("BREAKING CHANGE: "), []byte("BREAKING-CHANGE: "), []byte("BREAKING-CHANGE #")}
+
+// breakingChangeLine tells whether the current line starts with a breaking change footer trailer.
+//
+// Such footer trailers start the footer in any paragraph, so that a breaking change never silently ends up into the body.
+func (m *machine) breakingChangeLine() bool {
+	start := m.p
+	for start > 0 && m.data[start-1] != 10 {
+		start--
+	}
+	for _, prefix := range breakingChangePrefixes {
+		if bytes.HasPrefix(m.data[start:m.pe], prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// footerKey returns the key of the footer trailer token in the form the parser stores it.
+func footerKey(token string) string {
+	key := strings.ToLower(token)
+	if key == "breaking change" {
+		return "breaking-change"
+	}
+
+	return key
+}
+
+// typeToken returns the boundaries of the (possibly malformed) commit message type.
+func (m *machine) typeToken() (int, int) {
+	start := m.typeStart
+	for start < len(m.data) && m.data[start] == 32 {
+		start++
+	}
+	end := start
+	for end < len(m.data) && bytes.IndexByte([]byte("(!: \t\n"), m.data[end]) < 0 {
+		end++
+	}
+
+	return start, end
+}
+
+// suggestType attaches to the error the known commit message types similar to the one in the input.
+//
+// When there's only one closest type, it also attaches the edit replacing the input type with it.
+func (m *machine) suggestType(err error) error {
+	e := err.(*Error)
+	start, end := m.typeToken()
+	if start == end {
+		return e
+	}
+	token := strings.ToLower(string(m.data[start:end]))
+	e.Suggestions = suggestTypes(m.typeConfig, token)
+	if n := len(e.Suggestions); n == 1 || n > 1 && editDistance(token, e.Suggestions[0]) < editDistance(token, e.Suggestions[1]) {
+		e.Fixes = append(e.Fixes, Edit{Offset: start, Delete: end - start, Text: e.Suggestions[0]})
+	}
+
+	return e
+}
+
+// fixColon attaches to the error the edit inserting the missing colon.
+//
+// When the colon is missing because the commit message type is unknown, it suggests the similar types instead.
+// The colon goes only right after the type, or right after the scope (and the optional exclamation mark following it).
+// Elsewhere (eg., "Feature: x") the word is not a type, so the colon is not the fix.
+// Neither the colon nor the similar types are fixes when an exclamation mark not followed by a colon ends the word,
+// since it is a git autosquash message (eg., "fixup! fix: x") rather than a breaking change.
+func (m *machine) fixColon(err error) error {
+	e := m.suggestType(err).(*Error)
+	if _, end := m.typeToken(); end+1 < len(m.data) && m.data[end] == '!' && m.data[end+1] != ':' {
+		e.Fixes = nil
+
+		return e
+	}
+	if len(e.Suggestions) == 0 && m.colonBelongsAt(e.Offset) && m.data[e.Offset] != 10 {
+		text := ": "
+		if m.data[e.Offset] == 32 {
+			text = ":"
+		}
+		e.Fixes = append(e.Fixes, Edit{Offset: e.Offset, Text: text})
+	}
+
+	return e
+}
+
+// colonBelongsAt tells whether the type token ends at the given offset, eventually followed by the scope and the exclamation mark.
+func (m *machine) colonBelongsAt(offset int) bool {
+	if offset >= len(m.data) {
+		return false
+	}
+	_, end := m.typeToken()
+	if end == offset {
+		return true
+	}
+
+	return end < offset && m.data[end] == '(' && bytes.IndexByte([]byte(")!"), m.data[offset-1]) >= 0
+}
+
+// fixDescriptionInit attaches to the error the edit inserting the missing white-space before the description.
+func (m *machine) fixDescriptionInit(err error) error {
+	e := err.(*Error)
+	if e.Offset < len(m.data) {
+		switch m.data[e.Offset] {
+		case 9:
+			e.Fixes = append(e.Fixes, Edit{Offset: e.Offset, Delete: 1, Text: " "})
+		case 10:
+		default:
+			e.Fixes = append(e.Fixes, Edit{Offset: e.Offset, Text: " "})
+		}
+	}
+
+	return e
+}
+
+// fixBlankLine attaches to the error the edit inserting the missing blank line after the header.
+//
+// When the header is followed by a lone newline, the edit removes it instead.
+func (m *machine) fixBlankLine(err error) error {
+	e := err.(*Error)
+	if e.Offset < len(m.data) {
+		e.Fixes = append(e.Fixes, Edit{Offset: e.Offset, Text: "\n"})
+	} else if e.Offset == len(m.data) && e.Offset > 0 && m.data[e.Offset-1] == 10 {
+		e.Fixes = append(e.Fixes, Edit{Offset: e.Offset - 1, Delete: 1})
+	}
+
+	return e
+}
+
+// notifyBody coalesces the body content into lines, notifying the listener about every complete one.
+//
+// The pending content (ie., the last line) gets notified when flushing.
+func (m *machine) notifyBody(start, end int) {
+	if start != m.bodyChunk.End {
+		m.flushBody()
+		m.bodyChunk.Start = start
+	}
+	for i := start; i < end; i++ {
+		m.bodyChunk.End = i + 1
+		if m.data[i] == 10 {
+			m.flushBody()
+		}
+	}
+}
+
+// flushBody notifies the listener about the pending body content, if any.
+func (m *machine) flushBody() {
+	if m.bodyChunk.End > m.bodyChunk.Start {
+		m.listener.OnBodyChunk(string(m.data[m.bodyChunk.Start:m.bodyChunk.End]), m.bodyChunk)
+	}
+	m.bodyChunk.Start = m.bodyChunk.End
+}
+
+// recover records the current error, so that the machine can continue parsing.
+func (m *machine) recover() {
+	if m.err != nil {
+		m.errors = append(m.errors, m.err)
+		m.err = nil
+	}
 }
 
 // NewMachine creates a new FSM able to parse Conventional Commits.
@@ -389,15 +1054,46 @@ func (m *machine) Parse(input []byte) (conventionalcommits.Message, error) {
 	m.p = 0
 	m.pb = 0
 	m.pe = len(input)
-	m.eof = len(input)
+	var trailers []gitTrailer
+	if m.gitTrailers {
+		// Parse only what precedes the trailer block
+		m.pe = findGitTrailerBlock(input, m.gitSeparators)
+		trailers = parseGitTrailers(input, m.pe, m.gitSeparators)
+	}
+	m.eof = m.pe
+	m.input = ""
+	if m.zeroCopy {
+		// The only copy of the input, all the parts of the resulting message are its substrings
+		m.input = string(input)
+	}
+	m.body.reset()
+	m.finalParagraph = finalParagraph(input[:m.pe])
+	m.bodyStart = -1
+	m.footerStart = m.pe
 	m.err = nil
+	m.errors = nil
+	m.typeStart = 0
+	m.bodyChunk = conventionalcommits.Span{}
 	m.currentFooterKey = ""
 	m.countNewlines = 0
 	output := &conventionalCommit{}
 	output.footers = make(map[string][]string)
 	output.typeconfig = m.typeConfig
+	output.typealiases = m.typeAliases
 
 	switch m.typeConfig {
+	case conventionalcommits.TypesLinuxKernel:
+		m.cs = en_linux_kernel_types_main
+		break
+	case conventionalcommits.TypesESLint:
+		m.cs = en_eslint_types_main
+		break
+	case conventionalcommits.TypesElectron:
+		m.cs = en_electron_types_main
+		break
+	case conventionalcommits.TypesAngular:
+		m.cs = en_angular_types_main
+		break
 	case conventionalcommits.TypesFreeForm:
 		m.cs = en_free_form_types_main
 		break
@@ -415,7 +1111,46 @@ func (m *machine) Parse(input []byte) (conventionalcommits.Message, error) {
 	}
 	%% write exec;
 
-	if m.cs < first_final {
+	if m.listener != nil {
+		m.flushBody()
+	}
+	output.body = m.bodyString()
+
+	if len(trailers) > 0 && m.cs >= first_final && m.err == nil {
+		m.addGitTrailers(output.footers, trailers)
+	}
+
+	if m.verbatimBody && m.bodyStart >= 0 && m.footerStart > m.bodyStart {
+		verbatim := m.str(m.bodyStart, m.footerStart)
+		output.verbatim = &verbatim
+	}
+
+	if len(m.requiredFooters) > 0 && m.cs >= first_final && m.err == nil {
+		if errs := m.checkRequiredFooters(output.footers); len(errs) > 0 {
+			if m.recovery {
+				m.errors = append(m.errors, errs...)
+			} else {
+				m.err = errs[0]
+			}
+		}
+	}
+
+	if m.cs < first_final && m.err == nil && len(m.errors) == 0 {
+		// Never stop without telling why
+		m.err = m.emitErrorOnPreviousCharacter(ErrEarly)
+	}
+
+	if m.recovery {
+		if m.err != nil {
+			m.recover()
+		}
+		if len(m.errors) > 0 {
+			// Return whatever has been found, together with all the errors
+			return output.export(), Errors(m.errors)
+		}
+	}
+
+	if m.cs < first_final || m.err != nil {
 		if m.bestEffort && output.minimal() {
 			// An error occurred but partial parsing is on and partial message is minimally valid
 			return output.export(), m.err
@@ -436,12 +1171,134 @@ func (m *machine) HasBestEffort() bool {
 	return m.bestEffort
 }
 
+// WithErrorRecovery enables the error recovery mode.
+func (m *machine) WithErrorRecovery() {
+	m.recovery = true
+}
+
+// HasErrorRecovery tells whether the receiving machine has the error recovery mode on or off.
+func (m *machine) HasErrorRecovery() bool {
+	return m.recovery
+}
+
+// WithStrictWhitespace enables the strict whitespace mode.
+func (m *machine) WithStrictWhitespace() {
+	m.strictWhitespace = true
+}
+
+// HasStrictWhitespace tells whether the receiving machine has the strict whitespace mode on or off.
+func (m *machine) HasStrictWhitespace() bool {
+	return m.strictWhitespace
+}
+
+// WithGitmoji enables the gitmoji mode.
+func (m *machine) WithGitmoji() {
+	m.gitmoji = true
+}
+
+// HasGitmoji tells whether the receiving machine has the gitmoji mode on or off.
+func (m *machine) HasGitmoji() bool {
+	return m.gitmoji
+}
+
+// WithTypeAliases enables the type aliases mode.
+func (m *machine) WithTypeAliases() {
+	m.typeAliases = true
+}
+
+// HasTypeAliases tells whether the receiving machine has the type aliases mode on or off.
+func (m *machine) HasTypeAliases() bool {
+	return m.typeAliases
+}
+
+// WithHeaderMaxLength sets the maximum number of characters the header can have.
+func (m *machine) WithHeaderMaxLength(n int) {
+	m.headerMaxLength = n
+}
+
+// WithDescriptionMinLength sets the minimum number of characters the description must have.
+func (m *machine) WithDescriptionMinLength(n int) {
+	m.descrMinLength = n
+}
+
+// WithoutDescriptionTrailingPeriod forbids the description to end with a period.
+func (m *machine) WithoutDescriptionTrailingPeriod() {
+	m.noTrailingPeriod = true
+}
+
+// WithFooterTokenChars extends the characters the footer trailer tokens can contain.
+func (m *machine) WithFooterTokenChars(chars string) {
+	m.footerTokenChars = chars
+}
+
+// WithFooterKeys restricts the footer trailers to the ones with the given tokens.
+func (m *machine) WithFooterKeys(keys ...string) {
+	m.footerKeys = make(map[string]bool, len(keys))
+	for _, key := range keys {
+		m.footerKeys[footerKey(key)] = true
+	}
+}
+
+// WithRequiredFooterKeys sets the tokens of the footer trailers the commit messages must have.
+func (m *machine) WithRequiredFooterKeys(keys ...string) {
+	m.requiredFooters = keys
+}
+
+// WithoutDuplicateFooterKeys forbids the footer trailers to repeat their tokens.
+func (m *machine) WithoutDuplicateFooterKeys() {
+	m.noDuplicates = true
+}
+
+// WithVerbatimBody enables the verbatim body mode.
+func (m *machine) WithVerbatimBody() {
+	m.verbatimBody = true
+}
+
+// HasVerbatimBody tells whether the receiving machine has the verbatim body mode on or off.
+func (m *machine) HasVerbatimBody() bool {
+	return m.verbatimBody
+}
+
+// WithZeroCopy enables the zero-copy mode.
+func (m *machine) WithZeroCopy() {
+	m.zeroCopy = true
+}
+
+// HasZeroCopy tells whether the receiving machine has the zero-copy mode on or off.
+func (m *machine) HasZeroCopy() bool {
+	return m.zeroCopy
+}
+
+// WithFooterInAnyParagraph lets the footer start in any paragraph following the header.
+func (m *machine) WithFooterInAnyParagraph() {
+	m.footerAnyParagraph = true
+}
+
+// WithGitTrailers enables the git trailers compatibility mode, with the given footer trailer separators.
+func (m *machine) WithGitTrailers(separators string) {
+	m.gitTrailers = true
+	m.gitSeparators = separators
+	if m.gitSeparators == "" {
+		m.gitSeparators = ":"
+	}
+}
+
+// HasGitTrailers tells whether the receiving machine has the git trailers compatibility mode on or off.
+func (m *machine) HasGitTrailers() bool {
+	return m.gitTrailers
+}
+
 // WithTypes tells the parser which commit message types to consider.
 func (m *machine) WithTypes(t conventionalcommits.TypeConfig) {
 	m.typeConfig = t
 }
 
+// WithListener tells the parser which listener to notify about what it finds.
+func (m *machine) WithListener(l conventionalcommits.Listener) {
+	m.listener = l
+}
+
 // WithLogger tells the parser which logger to use.
-func (m *machine) WithLogger(l *logrus.Logger) {
+func (m *machine) WithLogger(l conventionalcommits.StructuredLogger) {
 	m.logger = l
 }
\ No newline at end of file
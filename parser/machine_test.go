@@ -4,10 +4,15 @@
 package parser
 
 import (
+	"bytes"
 	"fmt"
+	"log/slog"
+	"strings"
 	"testing"
 
 	"github.com/leodido/go-conventionalcommits"
+	"github.com/leodido/go-conventionalcommits/logrusadapter"
+	cctesting "github.com/leodido/go-conventionalcommits/testing"
 	"github.com/sirupsen/logrus"
 	logrustest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
@@ -29,6 +34,53 @@ func TestMachineParseWithFreeFormTypes(t *testing.T) {
 	runner(t, "freeformtypes", testCasesForFreeFormTypes, WithTypes(conventionalcommits.TypesFreeForm))
 }
 
+func TestMachineParseWithAngularTypes(t *testing.T) {
+	runner(t, "angulartypes", testCasesForAngularTypes, WithTypes(conventionalcommits.TypesAngular))
+}
+
+func TestMachineParseWithElectronTypes(t *testing.T) {
+	runner(t, "electrontypes", testCasesForElectronTypes, WithTypes(conventionalcommits.TypesElectron))
+}
+
+func TestMachineParseWithESLintTypes(t *testing.T) {
+	runner(t, "eslinttypes", testCasesForESLintTypes, WithTypes(conventionalcommits.TypesESLint))
+}
+
+func TestMachineParseWithLinuxKernelTypes(t *testing.T) {
+	runner(t, "linuxkerneltypes", testCasesForLinuxKernelTypes, WithTypes(conventionalcommits.TypesLinuxKernel))
+}
+
+func TestMachineParseWithTypeAliases(t *testing.T) {
+	runner(t, "typealiases", testCasesForTypeAliases, WithTypes(conventionalcommits.TypesConventional), WithTypeAliases())
+}
+
+func TestMachineParseWithUnicode(t *testing.T) {
+	runner(t, "unicode", testCasesForUnicode)
+}
+
+func TestMachineParseWithStrictWhitespace(t *testing.T) {
+	runner(t, "strictwhitespace", testCasesForStrictWhitespace, WithStrictWhitespace())
+}
+
+func TestMachineParseWithHeaderLimits(t *testing.T) {
+	opts := []conventionalcommits.MachineOption{
+		WithHeaderMaxLength(30),
+		WithDescriptionMinLength(3),
+		WithoutDescriptionTrailingPeriod(),
+	}
+	runner(t, "headerlimits", testCasesForHeaderLimits, opts...)
+}
+
+func TestMachineParseWithGitmoji(t *testing.T) {
+	runner(t, "gitmoji", testCasesForGitmoji, WithGitmoji())
+}
+
+func TestMachineParseWithZeroCopy(t *testing.T) {
+	runner(t, "zerocopy", testCases, WithZeroCopy())
+	runner(t, "zerocopy/conventional", testCasesForConventionalTypes, WithZeroCopy(), WithTypes(conventionalcommits.TypesConventional))
+	runner(t, "zerocopy/freeform", testCasesForFreeFormTypes, WithZeroCopy(), WithTypes(conventionalcommits.TypesFreeForm))
+}
+
 func runner(t *testing.T, label string, cases []testCase, machineOpts ...conventionalcommits.MachineOption) {
 	t.Helper()
 
@@ -79,6 +131,260 @@ func TestMachineBestEffortOption(t *testing.T) {
 	assert.True(t, p2.HasBestEffort())
 }
 
+func TestMachineErrorRecoveryOption(t *testing.T) {
+	p1 := NewMachine().(conventionalcommits.ErrorRecoverer)
+	assert.False(t, p1.HasErrorRecovery())
+
+	p2 := NewMachine(WithErrorRecovery()).(conventionalcommits.ErrorRecoverer)
+	assert.True(t, p2.HasErrorRecovery())
+}
+
+func TestMachineParseWithErrorRecovery(t *testing.T) {
+	cases := []struct {
+		title  string
+		input  []byte
+		value  conventionalcommits.Message
+		errors []string
+	}{
+		{
+			"valid",
+			[]byte("fix: ok\n\nbody"),
+			&conventionalcommits.ConventionalCommit{
+				Type:        "fix",
+				Description: "ok",
+				Body:        cctesting.StringAddress("body"),
+				TypeConfig:  1,
+			},
+			nil,
+		},
+		{
+			"invalid-type-keeps-body-and-footer",
+			[]byte("fx: desc\n\nsome body\n\nFixes: #1"),
+			&conventionalcommits.ConventionalCommit{
+				Body:       cctesting.StringAddress("some body"),
+				Footers:    map[string][]string{"fixes": {"#1"}},
+				TypeConfig: 1,
+			},
+			[]string{
+				fmt.Sprintf(ErrType+ColumnPositionTemplate, "x", 1),
+			},
+		},
+		{
+			"missing-blank-line-and-invalid-trailers",
+			[]byte("fix: desc\nbody directly\n\nFixes: #1\nBroken footer\nKey: a\tb\nReviewed-by: z"),
+			&conventionalcommits.ConventionalCommit{
+				Type:        "fix",
+				Description: "desc",
+				Body:        cctesting.StringAddress("body directly"),
+				Footers:     map[string][]string{"fixes": {"#1"}, "reviewed-by": {"z"}},
+				TypeConfig:  1,
+			},
+			[]string{
+				fmt.Sprintf(ErrMissingBlankLineAtBeginning+ColumnPositionTemplate, 10),
+				fmt.Sprintf(ErrTrailer+ColumnPositionTemplate, "f", 42),
+				fmt.Sprintf(ErrTrailer+ColumnPositionTemplate, "\t", 55),
+			},
+		},
+		{
+			"incomplete-trailer-at-the-end",
+			[]byte("fix: a\n\nRefs #1\nb #"),
+			&conventionalcommits.ConventionalCommit{
+				Type:        "fix",
+				Description: "a",
+				Footers:     map[string][]string{"refs": {"1"}},
+				TypeConfig:  1,
+			},
+			[]string{
+				fmt.Sprintf(ErrTrailerIncomplete+ColumnPositionTemplate, "#", 19),
+			},
+		},
+		{
+			"incomplete-trailer-in-body-paragraph",
+			[]byte("fix: a\n\nfoo #"),
+			&conventionalcommits.ConventionalCommit{
+				Type:        "fix",
+				Description: "a",
+				TypeConfig:  1,
+			},
+			[]string{
+				fmt.Sprintf(ErrTrailerIncomplete+ColumnPositionTemplate, "#", 13),
+			},
+		},
+		{
+			"incomplete-type",
+			[]byte("fi"),
+			&conventionalcommits.ConventionalCommit{
+				TypeConfig: 1,
+			},
+			[]string{
+				fmt.Sprintf(ErrTypeIncomplete+ColumnPositionTemplate, "i", 2),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.title, func(t *testing.T) {
+			message, err := NewMachine(WithErrorRecovery(), WithTypes(conventionalcommits.TypesConventional)).Parse(tc.input)
+			assert.Equal(t, tc.value, message)
+			if len(tc.errors) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			var errs Errors
+			if assert.ErrorAs(t, err, &errs) {
+				msgs := make([]string, len(errs))
+				for i, e := range errs {
+					msgs[i] = e.Error()
+				}
+				assert.Equal(t, tc.errors, msgs)
+			}
+		})
+	}
+}
+
+func TestMachineFixes(t *testing.T) {
+	cases := []struct {
+		title       string
+		input       string
+		suggestions []string
+		output      string
+	}{
+		{"missing-colon", "fix desc", nil, "fix: desc"},
+		{"missing-colon-after-scope", "fix(ui)desc", nil, "fix(ui): desc"},
+		{"missing-colon-after-exclamation", "fix(ui)!desc", nil, "fix(ui)!: desc"},
+		{"missing-colon-at-newline", "fix\n\nbody", nil, "fix\n\nbody"},
+		{"missing-colon-in-the-middle-of-a-word", "Feature: x", nil, "Feature: x"},
+		{"missing-colon-in-the-middle-of-a-plural-word", "features: x", nil, "features: x"},
+		{"missing-colon-in-git-fixup", "fixup! fix: x", []string{"fix"}, "fixup! fix: x"},
+		{"missing-colon-after-bare-exclamation", "fix! x", nil, "fix! x"},
+		{"missing-white-space", "fix:desc", nil, "fix: desc"},
+		{"tab-instead-of-white-space", "fix:\tdesc", nil, "fix: desc"},
+		{"missing-blank-line", "fix: desc\nbody", nil, "fix: desc\n\nbody"},
+		{"lone-newline-after-header", "fix: desc\n", nil, "fix: desc"},
+		{"transposed-type", "fxi: desc", []string{"fix"}, "fix: desc"},
+		{"transposed-uppercase-type", "Fxi(ui): desc", []string{"fix"}, "fix(ui): desc"},
+		{"type-with-extra-character", "fixx: desc", []string{"fix"}, "fix: desc"},
+		{"type-with-missing-character", "refactr: desc", []string{"refactor"}, "refactor: desc"},
+		{"ambiguous-type", "fi", []string{"ci", "fix"}, "fi"},
+		{"unknown-type", "cor: desc", nil, "cor: desc"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.title, func(t *testing.T) {
+			_, err := NewMachine(WithTypes(conventionalcommits.TypesConventional)).Parse([]byte(tc.input))
+			var e *Error
+			if assert.ErrorAs(t, err, &e) {
+				assert.Equal(t, tc.suggestions, e.Suggestions)
+			}
+			assert.Equal(t, tc.output, string(ApplyFixes([]byte(tc.input), err)))
+		})
+	}
+}
+
+func TestMachineFixesWithErrorRecovery(t *testing.T) {
+	input := []byte("fxi(ui)desc\n\nbody\n\nFixes: #1")
+	_, err := NewMachine(WithErrorRecovery(), WithTypes(conventionalcommits.TypesConventional)).Parse(input)
+	assert.Error(t, err)
+
+	fixed := ApplyFixes(input, err)
+	assert.Equal(t, "fix(ui)desc\n\nbody\n\nFixes: #1", string(fixed))
+
+	_, err = NewMachine(WithErrorRecovery(), WithTypes(conventionalcommits.TypesConventional)).Parse(fixed)
+	fixed = ApplyFixes(fixed, err)
+	assert.Equal(t, "fix(ui): desc\n\nbody\n\nFixes: #1", string(fixed))
+
+	_, err = NewMachine(WithTypes(conventionalcommits.TypesConventional)).Parse(fixed)
+	assert.NoError(t, err)
+}
+
+func TestMachineStrictWhitespaceOption(t *testing.T) {
+	p1 := NewMachine().(conventionalcommits.StrictWhitespacer)
+	assert.False(t, p1.HasStrictWhitespace())
+
+	p2 := NewMachine(WithStrictWhitespace()).(conventionalcommits.StrictWhitespacer)
+	assert.True(t, p2.HasStrictWhitespace())
+}
+
+func TestMachineGitmojiOption(t *testing.T) {
+	p1 := NewMachine().(conventionalcommits.Gitmojier)
+	assert.False(t, p1.HasGitmoji())
+
+	p2 := NewMachine(WithGitmoji()).(conventionalcommits.Gitmojier)
+	assert.True(t, p2.HasGitmoji())
+}
+
+func TestMachineZeroCopyOption(t *testing.T) {
+	p1 := NewMachine().(conventionalcommits.ZeroCopier)
+	assert.False(t, p1.HasZeroCopy())
+
+	p2 := NewMachine(WithZeroCopy()).(conventionalcommits.ZeroCopier)
+	assert.True(t, p2.HasZeroCopy())
+}
+
+func TestBodyBuilder(t *testing.T) {
+	data := []byte("ab\n\ncd\nef")
+	b := &bodyBuilder{}
+	assert.True(t, b.empty())
+
+	// Contiguous pieces extend the span of the input
+	b.add(data, 0, 2)
+	b.addNewlines(data, 4, 2)
+	b.add(data, 4, 6)
+	assert.True(t, b.contiguous())
+	assert.Equal(t, 0, b.start)
+	assert.Equal(t, 6, b.end)
+
+	// Non contiguous pieces get copied
+	b.add(data, 7, 9)
+	b.addNewlines(data, 2, 1)
+	assert.False(t, b.contiguous())
+	assert.Equal(t, "ab\n\ncdef\n", string(b.buf))
+
+	b.reset()
+	assert.True(t, b.empty())
+	b.add(data, 7, 7)
+	assert.True(t, b.empty())
+}
+
+func TestMachineGitmojiMeaning(t *testing.T) {
+	p := NewMachine(WithGitmoji(), WithTypes(conventionalcommits.TypesFreeForm))
+
+	feat, err := p.Parse([]byte(":sparkles: ui: new button"))
+	assert.NoError(t, err)
+	assert.True(t, feat.IsFeat())
+	assert.False(t, feat.IsFix())
+	assert.Equal(t, conventionalcommits.MinorVersion, feat.VersionBump(nil))
+
+	fix, err := p.Parse([]byte("🐛 ui: crash"))
+	assert.NoError(t, err)
+	assert.True(t, fix.IsFix())
+	assert.Equal(t, conventionalcommits.PatchVersion, fix.VersionBump(nil))
+
+	breaking, err := p.Parse([]byte("💥 api: drop v1"))
+	assert.NoError(t, err)
+	assert.True(t, breaking.IsBreakingChange())
+	assert.Equal(t, conventionalcommits.MajorVersion, breaking.VersionBump(nil))
+}
+
+func TestMachineTypeAliasesOption(t *testing.T) {
+	p1 := NewMachine().(conventionalcommits.TypeAliaser)
+	assert.False(t, p1.HasTypeAliases())
+
+	p2 := NewMachine(WithTypeAliases()).(conventionalcommits.TypeAliaser)
+	assert.True(t, p2.HasTypeAliases())
+
+	res, err := NewMachine().Parse([]byte("feature: x"))
+	assert.Nil(t, res)
+	assert.EqualError(t, err, fmt.Sprintf(ErrColon+ColumnPositionTemplate, "u", 4))
+
+	res, err = NewMachine(WithTypeAliases(), WithTypes(conventionalcommits.TypesFreeForm)).Parse([]byte("hotfix: x"))
+	assert.NoError(t, err)
+	assert.True(t, res.IsFix())
+	assert.Equal(t, conventionalcommits.PatchVersion, res.VersionBump(conventionalcommits.DefaultStrategy))
+}
+
 func TestMachineTypeConfigOption(t *testing.T) {
 	p := NewMachine(WithTypes(conventionalcommits.TypesFalco))
 	mes, err := p.Parse([]byte("new: ciao"))
@@ -97,7 +403,7 @@ func TestParseLoggingErrorsOnly(t *testing.T) {
 	l, hook := logrustest.NewNullLogger()
 	l.SetLevel(logrus.ErrorLevel)
 
-	p := NewMachine(WithLogger(l))
+	p := NewMachine(WithLogger(logrusadapter.New(l)))
 	res, err := p.Parse([]byte("fix: a wonderful logger\x0Aaaa"))
 	assert.Nil(t, res)
 	if assert.Error(t, err) {
@@ -115,7 +421,7 @@ func TestParseLoggingErrorsOnly(t *testing.T) {
 func TestParseLoggingEverything(t *testing.T) {
 	l, hook := logrustest.NewNullLogger()
 
-	p := NewMachine(WithLogger(l))
+	p := NewMachine(WithLogger(logrusadapter.New(l)))
 	res, err := p.Parse([]byte("fix: a wonderful logger\x0Aaaa"))
 	assert.Nil(t, res)
 	if assert.Error(t, err) {
@@ -136,3 +442,738 @@ func TestParseLoggingEverything(t *testing.T) {
 	hook.Reset()
 	assert.Nil(t, hook.LastEntry())
 }
+
+func TestParseLoggingAllFields(t *testing.T) {
+	l, hook := logrustest.NewNullLogger()
+	l.SetLevel(logrus.DebugLevel)
+
+	p := NewMachine(WithLogger(logrusadapter.New(l)))
+	_, err := p.Parse([]byte("fix: x\n\nFixes: #1"))
+	assert.NoError(t, err)
+
+	found := false
+	for _, e := range hook.AllEntries() {
+		if e.Message == "possibly valid footer token" {
+			found = true
+			assert.Equal(t, logrus.DebugLevel, e.Level)
+			assert.Equal(t, "fixes", e.Data["token"])
+			assert.Equal(t, 13, e.Data["pos"])
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestParseLoggingWithSlog(t *testing.T) {
+	var buf bytes.Buffer
+	l := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	p := NewMachine(WithLogger(l))
+	_, err := p.Parse([]byte("fix: x\n\nFixes: #1\nAcked-by"))
+	assert.Error(t, err)
+
+	out := buf.String()
+	assert.Contains(t, out, `level=INFO msg="valid commit message type" type=fix`)
+	assert.Contains(t, out, `level=DEBUG msg="possibly valid footer token" token=fixes pos=13`)
+	assert.Contains(t, out, `level=INFO msg="valid commit message footer trailer" fixes=#1`)
+	assert.Contains(t, out, `level=ERROR msg="incomplete footer trailer after 'y' character: col=26"`)
+}
+
+func TestParseWithoutLoggerDoesNotAllocateForLogging(t *testing.T) {
+	input := []byte("fix(scope): description\n\nbody\n\nFixes: #1\nAcked-by: Leo")
+	l := slog.New(slog.NewTextHandler(&bytes.Buffer{}, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	without := testing.AllocsPerRun(100, func() {
+		NewMachine().Parse(input)
+	})
+	with := testing.AllocsPerRun(100, func() {
+		NewMachine(WithLogger(l)).Parse(input)
+	})
+	assert.Less(t, without, with)
+}
+
+type event struct {
+	kind  string
+	value string
+	pos   conventionalcommits.Span
+}
+
+type recordingListener struct {
+	events []event
+}
+
+func (l *recordingListener) OnType(value string, pos conventionalcommits.Span) {
+	l.events = append(l.events, event{"type", value, pos})
+}
+
+func (l *recordingListener) OnScope(value string, pos conventionalcommits.Span) {
+	l.events = append(l.events, event{"scope", value, pos})
+}
+
+func (l *recordingListener) OnBreaking(pos conventionalcommits.Span) {
+	l.events = append(l.events, event{"breaking", "", pos})
+}
+
+func (l *recordingListener) OnDescription(value string, pos conventionalcommits.Span) {
+	l.events = append(l.events, event{"description", value, pos})
+}
+
+func (l *recordingListener) OnBodyChunk(value string, pos conventionalcommits.Span) {
+	l.events = append(l.events, event{"body", value, pos})
+}
+
+func (l *recordingListener) OnFooter(key, value string, keyPos, valuePos conventionalcommits.Span) {
+	l.events = append(l.events, event{"footer-key", key, keyPos}, event{"footer-value", value, valuePos})
+}
+
+func (l *recordingListener) OnError(err error, pos conventionalcommits.Span) {
+	l.events = append(l.events, event{"error", err.Error(), pos})
+}
+
+func TestMachineListener(t *testing.T) {
+	l := &recordingListener{}
+	input := []byte("fix(UI)!: description\n\nfirst line\nsecond line\n\nthird line\n\nFixes: #1\nBREAKING CHANGE: everything")
+	_, err := NewMachine(WithListener(l)).Parse(input)
+	assert.NoError(t, err)
+
+	span := func(start, end int) conventionalcommits.Span {
+		return conventionalcommits.Span{Start: start, End: end}
+	}
+	expected := []event{
+		{"type", "fix", span(0, 3)},
+		{"scope", "UI", span(4, 6)},
+		{"breaking", "", span(7, 8)},
+		{"description", "description", span(10, 21)},
+		{"body", "first line\n", span(23, 34)},
+		{"body", "second line\n", span(34, 46)},
+		{"body", "\n", span(46, 47)},
+		{"body", "third line", span(47, 57)},
+		{"footer-key", "fixes", span(59, 64)},
+		{"footer-value", "#1", span(66, 68)},
+		{"breaking", "", span(69, 84)},
+		{"footer-key", "breaking-change", span(69, 84)},
+		{"footer-value", "everything", span(86, 96)},
+	}
+	assert.Equal(t, expected, l.events)
+}
+
+func TestMachineListenerErrors(t *testing.T) {
+	l := &recordingListener{}
+	input := []byte("fix desc\n\nbody\n\nFixes: #1\nBroken footer")
+	_, err := NewMachine(WithListener(l), WithErrorRecovery()).Parse(input)
+	assert.Error(t, err)
+
+	var errs []event
+	for _, e := range l.events {
+		if e.kind == "error" {
+			errs = append(errs, e)
+		}
+	}
+	assert.Equal(t, []event{
+		{"error", fmt.Sprintf(ErrColon+ColumnPositionTemplate, " ", 3), conventionalcommits.Span{Start: 3, End: 4}},
+		{"error", fmt.Sprintf(ErrTrailer+ColumnPositionTemplate, "f", 33), conventionalcommits.Span{Start: 33, End: 34}},
+	}, errs)
+}
+
+// TestMachineListenerPositions checks that the positions the listener receives match the values for all the test cases.
+func TestMachineListenerPositions(t *testing.T) {
+	all := [][]testCase{testCases, testCasesForFalcoTypes, testCasesForConventionalTypes, testCasesForFreeFormTypes, testCasesForUnicode}
+	for _, cases := range all {
+		for _, tc := range cases {
+			l := &recordingListener{}
+			res, _ := NewMachine(WithListener(l), WithTypes(conventionalcommits.TypesFreeForm), WithBestEffort()).Parse(tc.input)
+
+			body := ""
+			for _, e := range l.events {
+				switch e.kind {
+				case "breaking", "error":
+					continue
+				case "footer-key":
+					assert.Equal(t, strings.ReplaceAll(e.value, "-", " "), strings.ReplaceAll(strings.ToLower(string(tc.input[e.pos.Start:e.pos.End])), "-", " "), tc.title)
+				case "body":
+					body += e.value
+					fallthrough
+				default:
+					assert.Equal(t, e.value, string(tc.input[e.pos.Start:e.pos.End]), tc.title)
+				}
+			}
+			if c, ok := res.(*conventionalcommits.ConventionalCommit); ok && c.Body != nil {
+				assert.Equal(t, *c.Body, strings.TrimSuffix(body, "\n\n"), tc.title)
+			}
+		}
+	}
+}
+
+func TestMachineTypeConfigTypes(t *testing.T) {
+	// Every type in the lists is accepted by the parser
+	for _, c := range conventionalcommits.TypeConfigs() {
+		for _, typ := range c.Types() {
+			_, err := NewMachine(WithTypes(c)).Parse([]byte(typ + ": description"))
+			assert.NoError(t, err, "%s: %s", c, typ)
+		}
+	}
+}
+
+func TestMessageAccessors(t *testing.T) {
+	res, err := NewMachine(WithTypes(conventionalcommits.TypesConventional)).Parse([]byte("feat(API)!: add the endpoint\n\nSome body.\n\nReviewed-by: Z\nRefs #133\nRefs #134\nBREAKING CHANGE: drop the old endpoint"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "feat", res.GetType())
+	assert.Equal(t, "api", res.GetScope())
+	assert.Equal(t, "add the endpoint", res.GetDescription())
+	assert.Equal(t, "Some body.", res.GetBody())
+	assert.Equal(t, "Z", res.Footer("Reviewed-By"))
+	assert.Equal(t, "133", res.Footer("refs"))
+	assert.Equal(t, []string{"133", "134"}, res.FooterValues("Refs"))
+	assert.Equal(t, "drop the old endpoint", res.Footer("breaking change"))
+	assert.Equal(t, "drop the old endpoint", res.Footer("BREAKING-CHANGE"))
+	assert.Equal(t, "", res.Footer("missing"))
+	assert.Nil(t, res.FooterValues("missing"))
+	assert.Equal(t, []string{"breaking-change", "refs", "reviewed-by"}, res.FooterKeys())
+	assert.Equal(t, "drop the old endpoint", res.BreakingChangeDescription())
+}
+
+func TestMessageAccessorsWithoutOptionalParts(t *testing.T) {
+	res, err := NewMachine().Parse([]byte("fix!: stop accepting empty inputs"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "fix", res.GetType())
+	assert.Equal(t, "", res.GetScope())
+	assert.Equal(t, "", res.GetBody())
+	assert.Nil(t, res.FooterValues("breaking-change"))
+	assert.Nil(t, res.FooterKeys())
+	// Without the breaking change footer the description says what breaks
+	assert.Equal(t, "stop accepting empty inputs", res.BreakingChangeDescription())
+
+	res, err = NewMachine().Parse([]byte("fix: typo"))
+	assert.NoError(t, err)
+	assert.Equal(t, "", res.BreakingChangeDescription())
+}
+
+func TestMessageBreakingChanges(t *testing.T) {
+	tests := []struct {
+		input string
+		opts  []conventionalcommits.MachineOption
+		want  []conventionalcommits.BreakingChange
+	}{
+		{"fix: typo", nil, nil},
+		{
+			"feat!: drop Node 6",
+			nil,
+			[]conventionalcommits.BreakingChange{{Note: "drop Node 6", Source: conventionalcommits.BreakingChangeHeader}},
+		},
+		{
+			"feat!: drop Node 6\n\nBREAKING CHANGE: use JavaScript features not available in Node 6",
+			nil,
+			[]conventionalcommits.BreakingChange{{Note: "use JavaScript features not available in Node 6", Source: conventionalcommits.BreakingChangeFooter}},
+		},
+		{
+			"fix: a\n\nBREAKING-CHANGE: x\nRefs #1\nBreaking-Change: y",
+			nil,
+			[]conventionalcommits.BreakingChange{
+				{Note: "x", Source: conventionalcommits.BreakingChangeFooter},
+				{Note: "y", Source: conventionalcommits.BreakingChangeFooter},
+			},
+		},
+		{
+			"breaking: remove the option",
+			[]conventionalcommits.MachineOption{WithTypes(conventionalcommits.TypesESLint)},
+			[]conventionalcommits.BreakingChange{{Note: "remove the option", Source: conventionalcommits.BreakingChangeHeader}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			res, err := NewMachine(tc.opts...).Parse([]byte(tc.input))
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.want, conventionalcommits.BreakingChanges(res))
+			}
+		})
+	}
+
+	assert.Equal(t, "header", conventionalcommits.BreakingChangeHeader.String())
+	assert.Equal(t, "footer", conventionalcommits.BreakingChangeFooter.String())
+}
+
+func TestMachineFooterTokens(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		opts    []conventionalcommits.MachineOption
+		footers map[string][]string
+		body    string
+		err     string
+	}{
+		{
+			"underscores-are-body-by-default",
+			"fix: a\n\nSigned_off_by: Jane",
+			nil,
+			nil,
+			"Signed_off_by: Jane",
+			"",
+		},
+		{
+			"extended-token-chars",
+			"fix: a\n\nSigned_off_by: Jane\nJira.Key #ABC-1\nChange-Id: I123",
+			[]conventionalcommits.MachineOption{WithFooterTokenChars("_.")},
+			map[string][]string{"signed_off_by": {"Jane"}, "jira.key": {"ABC-1"}, "change-id": {"I123"}},
+			"",
+			"",
+		},
+		{
+			"extended-token-chars-ignore-separators",
+			"fix: a\n\nRefs #1\nA:B: x",
+			[]conventionalcommits.MachineOption{WithFooterTokenChars(":# ")},
+			nil,
+			"",
+			"illegal 'B' character in trailer: col=18",
+		},
+		{
+			"allowed-keys",
+			"fix: a\n\nREFS #1\nBREAKING CHANGE: b\nReviewed-by: Z",
+			[]conventionalcommits.MachineOption{WithFooterKeys("Refs", "reviewed-by")},
+			map[string][]string{"refs": {"1"}, "breaking-change": {"b"}, "reviewed-by": {"Z"}},
+			"",
+			"",
+		},
+		{
+			"not-allowed-key",
+			"fix: a\n\nRefs #1\nAcked-by: Z",
+			[]conventionalcommits.MachineOption{WithFooterKeys("Refs")},
+			nil,
+			"",
+			"footer trailer token 'Acked-by' not allowed: col=16",
+		},
+		{
+			"required-keys",
+			"fix: a\n\nSigned-off-by: Jane\nChange-Id: I1",
+			[]conventionalcommits.MachineOption{WithRequiredFooterKeys("signed-off-by", "Change-Id")},
+			map[string][]string{"signed-off-by": {"Jane"}, "change-id": {"I1"}},
+			"",
+			"",
+		},
+		{
+			"missing-required-key",
+			"fix: a\n\nChange-Id: I1",
+			[]conventionalcommits.MachineOption{WithRequiredFooterKeys("Signed-off-by", "Change-Id")},
+			nil,
+			"",
+			"missing required footer trailer token 'Signed-off-by': col=21",
+		},
+		{
+			"missing-required-key-without-footer",
+			"fix: a",
+			[]conventionalcommits.MachineOption{WithRequiredFooterKeys("Signed-off-by")},
+			nil,
+			"",
+			"missing required footer trailer token 'Signed-off-by': col=06",
+		},
+		{
+			"duplicates-allowed-by-default",
+			"fix: a\n\nRefs #1\nrefs #2",
+			nil,
+			map[string][]string{"refs": {"1", "2"}},
+			"",
+			"",
+		},
+		{
+			"duplicate-key",
+			"fix: a\n\nRefs #1\nrefs #2",
+			[]conventionalcommits.MachineOption{WithoutDuplicateFooterKeys()},
+			nil,
+			"",
+			"duplicate footer trailer token 'refs': col=16",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := NewMachine(tc.opts...).Parse([]byte(tc.input))
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				assert.Nil(t, res)
+				return
+			}
+			if assert.NoError(t, err) {
+				commit := res.(*conventionalcommits.ConventionalCommit)
+				assert.Equal(t, tc.footers, commit.Footers)
+				assert.Equal(t, tc.body, res.GetBody())
+			}
+		})
+	}
+}
+
+func TestMachineFooterTokensWithErrorRecovery(t *testing.T) {
+	opts := []conventionalcommits.MachineOption{
+		WithErrorRecovery(),
+		WithFooterKeys("refs", "signed-off-by"),
+		WithRequiredFooterKeys("Signed-off-by"),
+		WithoutDuplicateFooterKeys(),
+	}
+	res, err := NewMachine(opts...).Parse([]byte("fix: a\n\nRefs #1\nAcked-by: Z\nRefs #2\nRefs #3"))
+
+	var errs Errors
+	if assert.ErrorAs(t, err, &errs) {
+		assert.Len(t, errs, 4)
+	}
+	assert.EqualError(t, err, "footer trailer token 'Acked-by' not allowed: col=16\nduplicate footer trailer token 'Refs': col=28\nduplicate footer trailer token 'Refs': col=36\nmissing required footer trailer token 'Signed-off-by': col=43")
+	assert.Equal(t, map[string][]string{"refs": {"1"}}, res.(*conventionalcommits.ConventionalCommit).Footers)
+
+	var e *Error
+	if assert.ErrorAs(t, err, &e) {
+		assert.Equal(t, "footer", e.Section)
+		assert.Equal(t, 16, e.Offset)
+	}
+}
+
+func TestMachineGitTrailers(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		separators string
+		footers    map[string][]string
+		body       string
+	}{
+		{
+			"trailers-after-header",
+			"fix: a\n\nSigned-off-by: Jane <jane@example.com>",
+			"",
+			map[string][]string{"signed-off-by": {"Jane <jane@example.com>"}},
+			"",
+		},
+		{
+			"only-last-paragraph",
+			"fix: a\n\nNote that: x\nmore body\n\nRefs: 1\nReviewed-by: Z",
+			"",
+			map[string][]string{"refs": {"1"}, "reviewed-by": {"Z"}},
+			"Note that: x\nmore body",
+		},
+		{
+			"non-trailer-line-without-git-generated-trailer",
+			"fix: a\n\nbody\n\nReviewed-by: Z\nnot a trailer\nRefs: 1",
+			"",
+			nil,
+			"body\n\nReviewed-by: Z\nnot a trailer\nRefs: 1",
+		},
+		{
+			"git-generated-trailer-and-25-percent-trailers",
+			"fix: a\n\nbody\n\nnot\nnot\nnot\nSigned-off-by: Jane <jane@example.com>",
+			"",
+			map[string][]string{"signed-off-by": {"Jane <jane@example.com>"}},
+			"body",
+		},
+		{
+			"git-generated-trailer-and-less-than-25-percent-trailers",
+			"fix: a\n\nbody\n\nnot\nnot\nnot\nnot\nSigned-off-by: Jane <jane@example.com>",
+			"",
+			nil,
+			"body\n\nnot\nnot\nnot\nnot\nSigned-off-by: Jane <jane@example.com>",
+		},
+		{
+			"continuation-lines",
+			"fix: a\n\nbody\n\nKey: value\n  continued here\n\tand here\nOther : x",
+			"",
+			map[string][]string{"key": {"value continued here and here"}, "other": {"x"}},
+			"body",
+		},
+		{
+			"equal-separator-not-configured",
+			"fix: a\n\nbody\n\nKey=value\nOther: x",
+			"",
+			nil,
+			"body\n\nKey=value\nOther: x",
+		},
+		{
+			"equal-separator",
+			"fix: a\n\nbody\n\nKey=value\nOther: x",
+			":=",
+			map[string][]string{"key": {"value"}, "other": {"x"}},
+			"body",
+		},
+		{
+			"breaking-change-with-white-space-is-not-a-git-trailer",
+			"fix: a\n\nBREAKING CHANGE: x\nRefs: 1",
+			"",
+			nil,
+			"BREAKING CHANGE: x\nRefs: 1",
+		},
+		{
+			"breaking-change-with-dash",
+			"fix: a\n\nBREAKING-CHANGE: x\nRefs: 1",
+			"",
+			map[string][]string{"breaking-change": {"x"}, "refs": {"1"}},
+			"",
+		},
+		{
+			"comments",
+			"fix: a\n\nRefs: 1\n# comment\nAcked-by: Z",
+			"",
+			map[string][]string{"refs": {"1"}, "acked-by": {"Z"}},
+			"",
+		},
+		{
+			"continuation-line-after-non-trailer-line",
+			"fix: x\n\nSigned-off-by: A <a@b.c>\nnot a trailer\n  cont\nKey: v",
+			"",
+			map[string][]string{"signed-off-by": {"A <a@b.c>"}, "key": {"v"}},
+			"",
+		},
+		{
+			"continuation-line-after-comment",
+			"fix: x\n\nSigned-off-by: A <a@b.c>\n# comment\n  cont\nKey: v",
+			"",
+			map[string][]string{"signed-off-by": {"A <a@b.c>"}, "key": {"v"}},
+			"",
+		},
+		{
+			"cherry-pick",
+			"fix: a\n\n(cherry picked from commit abc)\nSigned-off-by: Jane <jane@example.com>",
+			"",
+			map[string][]string{"signed-off-by": {"Jane <jane@example.com>"}},
+			"",
+		},
+		{
+			"trailing-blank-lines",
+			"fix: a\n\nbody\n\nRefs: 1\n\n\n",
+			"",
+			map[string][]string{"refs": {"1"}},
+			"body",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := NewMachine(WithGitTrailers(tc.separators)).Parse([]byte(tc.input))
+			if assert.NoError(t, err) {
+				commit := res.(*conventionalcommits.ConventionalCommit)
+				assert.Equal(t, tc.footers, commit.Footers)
+				assert.Equal(t, tc.body, res.GetBody())
+			}
+		})
+	}
+}
+
+func TestParseGitTrailers(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		separators string
+		footers    map[string][]string
+	}{
+		{
+			"merge-commit",
+			"Merge branch 'main' into feature\n\nSigned-off-by: Jane <jane@example.com>",
+			"",
+			map[string][]string{"signed-off-by": {"Jane <jane@example.com>"}},
+		},
+		{
+			"revert",
+			"Revert \"fix: a\"\n\nThis reverts commit abc.\n\nBREAKING-CHANGE: x\nRefs= 1",
+			":=",
+			map[string][]string{"breaking-change": {"x"}, "refs": {"1"}},
+		},
+		{
+			"no-trailers",
+			"fixup! fix: a",
+			"",
+			map[string][]string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.footers, ParseGitTrailers([]byte(tc.input), tc.separators))
+		})
+	}
+}
+
+func TestMachineGitTrailersErrors(t *testing.T) {
+	// The header still follows the specification
+	_, err := NewMachine(WithGitTrailers("")).Parse([]byte("fix a\n\nRefs: 1"))
+	assert.EqualError(t, err, "expecting colon (':') character, got ' ' character: col=03")
+
+	_, err = NewMachine(WithGitTrailers("")).Parse([]byte("fix: a\nRefs: 1"))
+	assert.EqualError(t, err, "missing a blank line: col=07")
+
+	// The restrictions about the footer trailer tokens apply
+	_, err = NewMachine(WithGitTrailers(""), WithFooterKeys("refs")).Parse([]byte("fix: a\n\nRefs: 1\nAcked-by: Z"))
+	assert.EqualError(t, err, "footer trailer token 'Acked-by' not allowed: col=16")
+
+	res, err := NewMachine(WithGitTrailers(""), WithErrorRecovery(), WithoutDuplicateFooterKeys(), WithRequiredFooterKeys("Signed-off-by")).Parse([]byte("fix: a\n\nRefs: 1\nRefs: 2"))
+	assert.EqualError(t, err, "duplicate footer trailer token 'Refs': col=16\nmissing required footer trailer token 'Signed-off-by': col=23")
+	assert.Equal(t, map[string][]string{"refs": {"1"}}, res.(*conventionalcommits.ConventionalCommit).Footers)
+}
+
+func TestMachineGitTrailersListener(t *testing.T) {
+	l := &recordingListener{}
+	input := "fix: a\n\nbody\n\nKey: value\n  continued"
+	_, err := NewMachine(WithGitTrailers(""), WithListener(l)).Parse([]byte(input))
+	assert.NoError(t, err)
+	span := func(start, end int) conventionalcommits.Span {
+		return conventionalcommits.Span{Start: start, End: end}
+	}
+	expected := []event{
+		{"type", "fix", span(0, 3)},
+		{"description", "a", span(5, 6)},
+		{"body", "body", span(8, 12)},
+		{"footer-key", "key", span(14, 17)},
+		{"footer-value", "value continued", span(19, 36)},
+	}
+	assert.Equal(t, expected, l.events)
+}
+
+func TestMachineFooterInFinalParagraph(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		footers map[string][]string
+		body    string
+		err     string
+		// What the parser finds when the footer can start in any paragraph
+		anyFooters map[string][]string
+		anyBody    string
+		anyErr     string
+	}{
+		{
+			"trailer-like-line-in-body",
+			"fix: a\n\nRefs #1\n\nNote that: foo\nbar",
+			nil,
+			"Refs #1\n\nNote that: foo\nbar",
+			"",
+			nil,
+			"",
+			"illegal 't' character in trailer: col=22",
+		},
+		{
+			"trailer-like-paragraph-in-body",
+			"fix: a\n\nFixes #1 by doing less\n\nSome more body.\n\nReviewed-by: Z",
+			map[string][]string{"reviewed-by": {"Z"}},
+			"Fixes #1 by doing less\n\nSome more body.",
+			"",
+			nil,
+			"",
+			"illegal 'm' character in trailer: col=37",
+		},
+		{
+			"trailers-in-final-paragraph",
+			"fix: a\n\nNote: this is body\n\nRefs #1\nBREAKING CHANGE: b\n\n",
+			map[string][]string{"refs": {"1"}, "breaking-change": {"b"}},
+			"Note: this is body",
+			"",
+			map[string][]string{"note": {"this is body"}, "refs": {"1"}, "breaking-change": {"b"}},
+			"",
+			"",
+		},
+		{
+			"breaking-change-followed-by-paragraph",
+			"fix: x\n\nBREAKING CHANGE: a\n\nthis continues the note",
+			nil,
+			"",
+			"illegal 'c' character in trailer: col=33",
+			nil,
+			"",
+			"illegal 'c' character in trailer: col=33",
+		},
+		{
+			"breaking-change-with-dash-followed-by-paragraph",
+			"fix: x\n\nbody\n\nBREAKING-CHANGE: a\n\nmore body\n\nRefs #1",
+			nil,
+			"",
+			"illegal 'm' character in trailer: col=34",
+			nil,
+			"",
+			"illegal 'b' character in trailer: col=39",
+		},
+		{
+			"non-trailer-after-trailer-in-final-paragraph",
+			"fix: a\n\nbody\n\nRefs #1\nsome text",
+			nil,
+			"",
+			"illegal 't' character in trailer: col=27",
+			nil,
+			"",
+			"illegal 't' character in trailer: col=27",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := NewMachine().Parse([]byte(tc.input))
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+			} else if assert.NoError(t, err) {
+				assert.Equal(t, tc.footers, res.(*conventionalcommits.ConventionalCommit).Footers)
+				assert.Equal(t, tc.body, res.GetBody())
+			}
+
+			res, err = NewMachine(WithFooterInAnyParagraph()).Parse([]byte(tc.input))
+			if tc.anyErr != "" {
+				assert.EqualError(t, err, tc.anyErr)
+			} else if assert.NoError(t, err) {
+				assert.Equal(t, tc.anyFooters, res.(*conventionalcommits.ConventionalCommit).Footers)
+				assert.Equal(t, tc.anyBody, res.GetBody())
+			}
+		})
+	}
+}
+
+func TestMachineVerbatimBody(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		body       string
+		verbatim   *string
+		normalized string
+	}{
+		{
+			"no-body",
+			"fix: a",
+			"",
+			nil,
+			"",
+		},
+		{
+			"body-with-trailing-whitespaces",
+			"fix: a\n\nfirst line  \n\n\n  indented\n\n",
+			"first line  \n\n\n  indented",
+			cctesting.StringAddress("first line  \n\n\n  indented\n\n"),
+			"first line\n\n  indented",
+		},
+		{
+			"body-and-footer",
+			"fix: a\n\n\nsome body\t\n\nRefs #1\nBREAKING CHANGE: b",
+			"\nsome body\t",
+			cctesting.StringAddress("\nsome body\t\n\n"),
+			"some body",
+		},
+		{
+			"only-footer",
+			"fix: a\n\nRefs #1",
+			"",
+			nil,
+			"",
+		},
+		{
+			"crlf-body",
+			"fix: a\n\nsome\r\nbody\r\n",
+			"some\r\nbody\r\n",
+			cctesting.StringAddress("some\r\nbody\r\n"),
+			"some\nbody",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := NewMachine(WithVerbatimBody()).Parse([]byte(tc.input))
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.body, res.GetBody())
+				assert.Equal(t, tc.verbatim, res.(*conventionalcommits.ConventionalCommit).VerbatimBody)
+				assert.Equal(t, tc.normalized, conventionalcommits.NormalizedBody(res))
+			}
+
+			res, err = NewMachine().Parse([]byte(tc.input))
+			if assert.NoError(t, err) {
+				assert.Nil(t, res.(*conventionalcommits.ConventionalCommit).VerbatimBody)
+			}
+		})
+	}
+}
@@ -5,7 +5,6 @@ package parser
 
 import (
 	"github.com/leodido/go-conventionalcommits"
-	"github.com/sirupsen/logrus"
 )
 
 // WithBestEffort enables the best effort mode.
@@ -20,6 +19,197 @@ func WithBestEffort() conventionalcommits.MachineOption {
 	}
 }
 
+// WithErrorRecovery enables the error recovery mode.
+//
+// Error recovery mode tells the parser not to stop at the first error.
+// Instead, it skips to the next line (or to the next footer trailer) and continues parsing,
+// returning what it found together with all the errors (as an Errors instance).
+func WithErrorRecovery() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.WithErrorRecovery()
+
+		return m
+	}
+}
+
+// WithStrictWhitespace enables the strict whitespace mode.
+//
+// Strict whitespace mode tells the parser to require exactly one white-space after the colon,
+// and to reject descriptions containing tabs or ending with white-spaces.
+func WithStrictWhitespace() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.WithStrictWhitespace()
+
+		return m
+	}
+}
+
+// WithGitmoji enables the gitmoji mode.
+//
+// Gitmoji mode tells the parser to accept an optional gitmoji before the type,
+// either as a shortcode (eg., ":sparkles: feat: ...") or as an Unicode emoji (eg., "✨ feat: ...").
+func WithGitmoji() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.WithGitmoji()
+
+		return m
+	}
+}
+
+// WithTypeAliases enables the type aliases mode.
+//
+// Type aliases mode tells the parser to accept the well-known aliases of the types (eg., "feature", "bugfix", "doc"),
+// and to canonicalize them (eg., "feat", "fix", "docs"), keeping the original type into the TypeAlias field.
+func WithTypeAliases() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.WithTypeAliases()
+
+		return m
+	}
+}
+
+// WithHeaderMaxLength limits the number of characters (runes) of the header.
+//
+// The header is the first line of the commit message, until the end of the description.
+// A value lower than or equal to zero disables the limit.
+func WithHeaderMaxLength(n int) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.WithHeaderMaxLength(n)
+
+		return m
+	}
+}
+
+// WithDescriptionMinLength sets the minimum number of characters (runes) of the description.
+//
+// A value lower than or equal to zero disables the check.
+func WithDescriptionMinLength(n int) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.WithDescriptionMinLength(n)
+
+		return m
+	}
+}
+
+// WithoutDescriptionTrailingPeriod tells the parser to reject descriptions ending with a period.
+func WithoutDescriptionTrailingPeriod() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.WithoutDescriptionTrailingPeriod()
+
+		return m
+	}
+}
+
+// WithVerbatimBody enables the verbatim body mode.
+//
+// Verbatim body mode tells the parser to also return the exact body, as a slice of the input,
+// so that tools re-emitting or hashing the commit messages do not alter them.
+// It is the text between the blank line after the header and the footer (or the end of the input),
+// including the trailing white-spaces and blank lines.
+// The normalized variant of the body is always available too (see ConventionalCommit.NormalizedBody).
+func WithVerbatimBody() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.WithVerbatimBody()
+
+		return m
+	}
+}
+
+// WithZeroCopy enables the zero-copy mode.
+//
+// Zero-copy mode tells the parser to copy the input only once, into a string,
+// so that all the parts of the resulting message (eg., the description, the body, the footer trailer values) are its substrings.
+// This way, the number of allocations does not grow with the size of the input.
+// Notice that the resulting message keeps the whole input copy alive, as long as any of its parts is in use.
+func WithZeroCopy() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.WithZeroCopy()
+
+		return m
+	}
+}
+
+// WithFooterInAnyParagraph lets the footer start in any paragraph following the header, as in the previous versions.
+//
+// By default, only the final paragraph can be the footer, so that the lines of the previous paragraphs
+// looking like footer trailers (eg., "Note that: ...", "Fixes #1 by ...") are body content.
+// With this option, the footer starts at the first paragraph beginning with a footer trailer,
+// and everything following it must be footer trailers.
+func WithFooterInAnyParagraph() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.WithFooterInAnyParagraph()
+
+		return m
+	}
+}
+
+// WithFooterTokenChars extends the characters the footer trailer tokens can contain (eg., "_" for "Signed_off_by").
+//
+// By default, the footer trailer tokens contain alphanumeric characters and dashes (eg., "Reviewed-by").
+// Only the printable ASCII characters are eligible, except for the ones separating the tokens from the values (ie., ':', '#', and white-spaces).
+func WithFooterTokenChars(chars string) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.WithFooterTokenChars(chars)
+
+		return m
+	}
+}
+
+// WithFooterKeys restricts the footer trailers to the ones with the given tokens (eg., "Reviewed-by", "Refs").
+//
+// The tokens are case-insensitive, and the breaking change one is always allowed.
+// The parser rejects the other footer trailers, pointing to their tokens.
+func WithFooterKeys(keys ...string) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.WithFooterKeys(keys...)
+
+		return m
+	}
+}
+
+// WithRequiredFooterKeys tells the parser to reject the commit messages missing footer trailers with the given tokens (eg., "Signed-off-by").
+//
+// The tokens are case-insensitive.
+func WithRequiredFooterKeys(keys ...string) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.WithRequiredFooterKeys(keys...)
+
+		return m
+	}
+}
+
+// WithoutDuplicateFooterKeys tells the parser to reject the footer trailers repeating the token of a previous one.
+//
+// The tokens are case-insensitive.
+func WithoutDuplicateFooterKeys() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.WithoutDuplicateFooterKeys()
+
+		return m
+	}
+}
+
+// WithGitTrailers enables the git trailers compatibility mode.
+//
+// Git trailers compatibility mode tells the parser to find the footer exactly as git interpret-trailers --parse does.
+// The footer is the last paragraph (but the header) when all its lines are footer trailers,
+// or when it contains a trailer git generates (eg., "Signed-off-by") and at least 25% of its lines are footer trailers.
+// The footer trailer tokens contain alphanumeric characters and dashes, and the separators split them from their values.
+// Lines starting with white-spaces continue the value of the previous footer trailer,
+// while the other lines in the footer (eg., comments) are ignored.
+// Everything between the header and the footer is body content.
+//
+// The separators are the characters separating the tokens from the values, like the git trailer.separators setting (eg., ":=").
+// When empty, the separator is the colon (':').
+// Notice that git does not recognize the "BREAKING CHANGE" token since it contains a white-space, while it recognizes "BREAKING-CHANGE".
+func WithGitTrailers(separators string) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.WithGitTrailers(separators)
+
+		return m
+	}
+}
+
 // WithTypes let you choose the types.
 func WithTypes(t conventionalcommits.TypeConfig) conventionalcommits.MachineOption {
 	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
@@ -29,8 +219,23 @@ func WithTypes(t conventionalcommits.TypeConfig) conventionalcommits.MachineOpti
 	}
 }
 
+// WithListener sets the listener to notify about what the parser finds.
+//
+// The listener receives the type, the scope, the breaking changes, the description,
+// the body, the footer trailers, and the errors, together with their positions in the input.
+func WithListener(l conventionalcommits.Listener) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.WithListener(l)
+
+		return m
+	}
+}
+
 // WithLogger enables a logger during parsing.
-func WithLogger(l *logrus.Logger) conventionalcommits.MachineOption {
+//
+// It accepts a *slog.Logger as is, while the logrusadapter package adapts a *logrus.Logger.
+// Without a logger, the parser does not spend anything on logging.
+func WithLogger(l conventionalcommits.StructuredLogger) conventionalcommits.MachineOption {
 	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
 		m.WithLogger(l)
 
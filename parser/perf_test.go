@@ -4,10 +4,13 @@
 package parser
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/leodido/go-conventionalcommits"
 	cctesting "github.com/leodido/go-conventionalcommits/testing"
+	"github.com/stretchr/testify/assert"
 )
 
 // Avoid compiler optimizations that could remove the actual call we are benchmarking during benchmarks.
@@ -98,6 +101,23 @@ Signed-off-by: Leonardo Di Donato <some@email.com>`),
 	},
 }
 
+// largeBodyInput returns a commit message with a body made of the given number of paragraphs, and a footer.
+func largeBodyInput(paragraphs int) []byte {
+	return []byte("fix: correct minor typos in code\n\n" + strings.Repeat("see the issue for details\non typos fixed.\n\n", paragraphs) + "Reviewed-by: Z\nRefs #133")
+}
+
+func TestMachineConstantAllocations(t *testing.T) {
+	for _, opts := range [][]conventionalcommits.MachineOption{nil, {WithZeroCopy()}} {
+		m := NewMachine(opts...)
+		small, large := largeBodyInput(1), largeBodyInput(1000)
+		assert.Equal(t, testing.AllocsPerRun(100, func() {
+			benchParseResult, _ = m.Parse(small)
+		}), testing.AllocsPerRun(100, func() {
+			benchParseResult, _ = m.Parse(large)
+		}))
+	}
+}
+
 func BenchmarkSlimParseMinimalTypes(b *testing.B) {
 	for _, tc := range benchCases {
 		tc := tc
@@ -121,3 +141,24 @@ func BenchmarkSlimParseConventionalTypes(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkSlimParseLargeBodies(b *testing.B) {
+	for _, paragraphs := range []int{1, 10, 100, 1000} {
+		input := largeBodyInput(paragraphs)
+		for _, mode := range []struct {
+			label string
+			opts  []conventionalcommits.MachineOption
+		}{
+			{"default", nil},
+			{"zero-copy", []conventionalcommits.MachineOption{WithZeroCopy()}},
+		} {
+			m := NewMachine(append(mode.opts, WithBestEffort())...)
+			b.Run(cctesting.RightPad(fmt.Sprintf("[ok] body of %d paragraphs (%s)", paragraphs, mode.label), 50), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					benchParseResult, _ = m.Parse(input)
+				}
+			})
+		}
+	}
+}
@@ -957,6 +957,69 @@ Signed-off-by: Leonardo Di Donato <some@email.com>`),
 		"",
 		nil,
 	},
+	// VALID / body starting with a character that can not start a trailer token
+	{
+		"valid-body-starting-with-non-alphanumeric-character",
+		[]byte("fix: description\n\n- item"),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "fix",
+			Description: "description",
+			Body:        cctesting.StringAddress("- item"),
+		},
+		&conventionalcommits.ConventionalCommit{
+			Type:        "fix",
+			Description: "description",
+			Body:        cctesting.StringAddress("- item"),
+		},
+		"",
+		&PatchVersion,
+	},
+	// INVALID / input ending right after the footer trailer separator
+	// VALID / until the last valid footer trailer
+	{
+		"invalid-incomplete-trailer-in-body-paragraph",
+		[]byte("fix: a\n\nfoo #"),
+		false,
+		nil,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "fix",
+			Description: "a",
+		},
+		fmt.Sprintf(ErrTrailerIncomplete+ColumnPositionTemplate, "#", 13),
+		nil,
+	},
+	// INVALID / input ending right after the footer trailer separator
+	// VALID / until the last valid footer trailer
+	{
+		"invalid-incomplete-one-letter-trailer",
+		[]byte("fix: a\n\nb #"),
+		false,
+		nil,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "fix",
+			Description: "a",
+		},
+		fmt.Sprintf(ErrTrailerIncomplete+ColumnPositionTemplate, "#", 11),
+		nil,
+	},
+	// INVALID / input ending right after the footer trailer separator
+	// VALID / until the last valid footer trailer
+	{
+		"invalid-incomplete-trailer-after-valid-trailer-at-end",
+		[]byte("fix: a\n\nRefs #1\nb #"),
+		false,
+		nil,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "fix",
+			Description: "a",
+			Footers: map[string][]string{
+				"refs": {"1"},
+			},
+		},
+		fmt.Sprintf(ErrTrailerIncomplete+ColumnPositionTemplate, "#", 19),
+		nil,
+	},
 }
 
 var testCasesForFalcoTypes = []testCase{
@@ -3227,7 +3290,7 @@ BREAKING CHANGE: APIs`),
 	},
 	// VALID / breaking change trailer after blank lines and other trailers
 	{
-		"valid-breaking-change-space-trailer-after-blanklines-and-others",
+		"valid-breaking-change-space-trailer-after-blanklines-and-trailer-like-body",
 		[]byte(`fix: description
 
 
@@ -3239,26 +3302,22 @@ BREAKING CHANGE: APIs`),
 		&conventionalcommits.ConventionalCommit{
 			Type:        "fix",
 			Description: "description",
+			Body:        cctesting.StringAddress("\nAcked-by: Leo Di Donato"),
 			Footers: map[string][]string{
 				"breaking-change": {
 					"APIs",
 				},
-				"acked-by": {
-					"Leo Di Donato",
-				},
 			},
 			TypeConfig: 3,
 		},
 		&conventionalcommits.ConventionalCommit{
 			Type:        "fix",
 			Description: "description",
+			Body:        cctesting.StringAddress("\nAcked-by: Leo Di Donato"),
 			Footers: map[string][]string{
 				"breaking-change": {
 					"APIs",
 				},
-				"acked-by": {
-					"Leo Di Donato",
-				},
 			},
 			TypeConfig: 3,
 		},
@@ -3311,6 +3370,39 @@ BREAKING CHANGE #5`),
 		fmt.Sprintf(ErrTrailer+ColumnPositionTemplate, " ", 48),
 		nil,
 	},
+	// INVALID / tab character in a footer trailer value
+	// VALID / until the last valid footer trailer
+	{
+		"invalid-tab-in-trailer-value-after-trailers",
+		[]byte("fix: description\n\nTested-by: Leo\nKey: a\tb"),
+		false,
+		nil,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "fix",
+			Description: "description",
+			Footers: map[string][]string{
+				"tested-by": {"Leo"},
+			},
+			TypeConfig: 3,
+		},
+		fmt.Sprintf(ErrTrailer+ColumnPositionTemplate, "\t", 39),
+		nil,
+	},
+	// INVALID / tab character in the value of the only footer trailer
+	// VALID / until the header
+	{
+		"invalid-tab-in-trailer-value",
+		[]byte("fix: description\n\nKey: a\tb"),
+		false,
+		nil,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "fix",
+			Description: "description",
+			TypeConfig:  3,
+		},
+		fmt.Sprintf(ErrTrailer+ColumnPositionTemplate, "\t", 24),
+		nil,
+	},
 	// INVALID / incomplete BREAKING CHANGE trailer
 	// VALID / until the last valid footer trailer
 	{
@@ -3439,4 +3531,782 @@ Another-trailer: x`),
 		fmt.Sprintf(ErrTrailer+ColumnPositionTemplate, "\n", 35),
 		nil,
 	},
+	// VALID / emoji type
+	{
+		"valid-emoji-type",
+		[]byte("🐛(ドキュメント): 説明を修正"),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "🐛",
+			Scope:       cctesting.StringAddress("ドキュメント"),
+			Description: "説明を修正",
+			TypeConfig:  3,
+		},
+		&conventionalcommits.ConventionalCommit{
+			Type:        "🐛",
+			Scope:       cctesting.StringAddress("ドキュメント"),
+			Description: "説明を修正",
+			TypeConfig:  3,
+		},
+		"",
+		&UnknownVersion,
+	},
+	// INVALID / invalid UTF-8 sequence in type
+	{
+		"invalid-utf8-sequence-in-type",
+		[]byte("ドキ\xe3\x83: x"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrType+ColumnPositionTemplate, "\ufffd", 2),
+		nil,
+	},
+}
+
+var testCasesForStrictWhitespace = []testCase{
+	// VALID / single whitespace
+	{
+		"valid-single-whitespace",
+		[]byte("fix: a  description"),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "fix",
+			Description: "a  description",
+		},
+		&conventionalcommits.ConventionalCommit{
+			Type:        "fix",
+			Description: "a  description",
+		},
+		"",
+		&PatchVersion,
+	},
+	// VALID / single whitespace with body
+	{
+		"valid-single-whitespace-with-body",
+		[]byte("feat(parser)!: strict\n\nbody with trailing whitespace   "),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "feat",
+			Scope:       cctesting.StringAddress("parser"),
+			Exclamation: true,
+			Description: "strict",
+			Body:        cctesting.StringAddress("body with trailing whitespace   "),
+		},
+		&conventionalcommits.ConventionalCommit{
+			Type:        "feat",
+			Scope:       cctesting.StringAddress("parser"),
+			Exclamation: true,
+			Description: "strict",
+			Body:        cctesting.StringAddress("body with trailing whitespace   "),
+		},
+		"",
+		&MajorVersion,
+	},
+	// INVALID / multiple whitespaces after colon
+	{
+		"invalid-multiple-whitespaces",
+		[]byte("fix:   description"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrDescriptionWhitespace+ColumnPositionTemplate, " ", 5),
+		nil,
+	},
+	// INVALID / tab after whitespace
+	{
+		"invalid-tab-after-whitespace",
+		[]byte("fix: \tdescription"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrDescriptionTab+ColumnPositionTemplate, 5),
+		nil,
+	},
+	// INVALID / tab into the description
+	{
+		"invalid-tab-in-description",
+		[]byte("fix: a\tdescription"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrDescriptionTab+ColumnPositionTemplate, 6),
+		nil,
+	},
+	// INVALID / trailing whitespace
+	{
+		"invalid-trailing-whitespace",
+		[]byte("fix: description "),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrDescriptionTrailingWhitespace+ColumnPositionTemplate, 16),
+		nil,
+	},
+	// INVALID / trailing whitespaces before the blank line
+	{
+		"invalid-trailing-whitespaces-before-blank-line",
+		[]byte("fix: description   \n\nbody"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrDescriptionTrailingWhitespace+ColumnPositionTemplate, 16),
+		nil,
+	},
+	// INVALID / trailing tab
+	{
+		"invalid-trailing-tab",
+		[]byte("fix: description \t"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrDescriptionTab+ColumnPositionTemplate, 17),
+		nil,
+	},
+	// INVALID / missing description
+	{
+		"invalid-missing-description",
+		[]byte("fix: "),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrDescription+ColumnPositionTemplate, " ", 5),
+		nil,
+	},
+	// INVALID / newline after whitespace
+	{
+		"invalid-newline-after-whitespace",
+		[]byte("fix: \n"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrNewline+ColumnPositionTemplate, 6),
+		nil,
+	},
+}
+
+var testCasesForHeaderLimits = []testCase{
+	// VALID / header as long as the limit
+	{
+		"valid-header-max-length",
+		[]byte("fix: a description of 30 chars"),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "fix",
+			Description: "a description of 30 chars",
+		},
+		&conventionalcommits.ConventionalCommit{
+			Type:        "fix",
+			Description: "a description of 30 chars",
+		},
+		"",
+		&PatchVersion,
+	},
+	// VALID / multi-byte header as long as the limit
+	{
+		"valid-multibyte-header-max-length",
+		[]byte("feat: ドキュメントの説明を追加しました。これは説明です"),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "feat",
+			Description: "ドキュメントの説明を追加しました。これは説明です",
+		},
+		&conventionalcommits.ConventionalCommit{
+			Type:        "feat",
+			Description: "ドキュメントの説明を追加しました。これは説明です",
+		},
+		"",
+		&MinorVersion,
+	},
+	// INVALID / header longer than the limit
+	// VALID / type and description with best effort
+	{
+		"invalid-header-max-length",
+		[]byte("fix: a description longer than 30 chars\n\nbody"),
+		false,
+		nil,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "fix",
+			Description: "a description longer than 30 chars",
+		},
+		fmt.Sprintf(ErrHeaderMaxLength+ColumnPositionTemplate, 30, " ", 30),
+		nil,
+	},
+	// INVALID / multi-byte header longer than the limit
+	// VALID / type and description with best effort
+	{
+		"invalid-multibyte-header-max-length",
+		[]byte("feat: ドキュメントの説明を追加しました。これはとても長い説明です"),
+		false,
+		nil,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "feat",
+			Description: "ドキュメントの説明を追加しました。これはとても長い説明です",
+		},
+		fmt.Sprintf(ErrHeaderMaxLength+ColumnPositionTemplate, 30, "い", 30),
+		nil,
+	},
+	// INVALID / description shorter than the limit
+	// VALID / type and description with best effort
+	{
+		"invalid-description-min-length",
+		[]byte("fix: x"),
+		false,
+		nil,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "fix",
+			Description: "x",
+		},
+		fmt.Sprintf(ErrDescriptionMinLength+ColumnPositionTemplate, 3, 1, 6),
+		nil,
+	},
+	// INVALID / multi-byte description shorter than the limit
+	// VALID / type and description with best effort
+	{
+		"invalid-multibyte-description-min-length",
+		[]byte("fix: 修正"),
+		false,
+		nil,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "fix",
+			Description: "修正",
+		},
+		fmt.Sprintf(ErrDescriptionMinLength+ColumnPositionTemplate, 3, 2, 7),
+		nil,
+	},
+	// INVALID / description with trailing period
+	// VALID / type and description with best effort
+	{
+		"invalid-description-trailing-period",
+		[]byte("fix: description.\n\nbody"),
+		false,
+		nil,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "fix",
+			Description: "description.",
+		},
+		fmt.Sprintf(ErrDescriptionTrailingPeriod+ColumnPositionTemplate, 16),
+		nil,
+	},
+}
+
+var testCasesForUnicode = []testCase{
+	// VALID / multi-byte scope, description, body, and footer
+	{
+		"valid-multibyte-scope-description-body-footer",
+		[]byte("feat(ドキュメント)!: 説明を追加\n\n本文\n\nReviewed-by: Jöhn Dœ"),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "feat",
+			Scope:       cctesting.StringAddress("ドキュメント"),
+			Exclamation: true,
+			Description: "説明を追加",
+			Body:        cctesting.StringAddress("本文"),
+			Footers: map[string][]string{
+				"reviewed-by": {"Jöhn Dœ"},
+			},
+		},
+		&conventionalcommits.ConventionalCommit{
+			Type:        "feat",
+			Scope:       cctesting.StringAddress("ドキュメント"),
+			Exclamation: true,
+			Description: "説明を追加",
+			Body:        cctesting.StringAddress("本文"),
+			Footers: map[string][]string{
+				"reviewed-by": {"Jöhn Dœ"},
+			},
+		},
+		"",
+		&MajorVersion,
+	},
+	// INVALID / multi-byte character in type
+	{
+		"invalid-multibyte-character-in-type",
+		[]byte("fé: x"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrType+ColumnPositionTemplate, "é", 1),
+		nil,
+	},
+	// INVALID / incomplete scope ending with a multi-byte character
+	{
+		"invalid-incomplete-scope-ending-with-multibyte-character",
+		[]byte("fix(ドキュ"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrScopeIncomplete+ColumnPositionTemplate, "ュ", 7),
+		nil,
+	},
+	// INVALID / invalid UTF-8 sequence in scope
+	{
+		"invalid-utf8-sequence-in-scope",
+		[]byte("fix(é\xe3\x81): x"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrScope+ColumnPositionTemplate, "\ufffd", 5),
+		nil,
+	},
+	// INVALID / invalid UTF-8 sequence in description
+	{
+		"invalid-utf8-sequence-in-description",
+		[]byte("fix: 説明\xff"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrDescriptionEncoding+ColumnPositionTemplate, 7),
+		nil,
+	},
+	// INVALID / missing blank line after multi-byte description
+	// VALID / type and description with best effort
+	{
+		"invalid-missing-blank-line-after-multibyte-description",
+		[]byte("fix: 説明\n本文"),
+		false,
+		nil,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "fix",
+			Description: "説明",
+		},
+		fmt.Sprintf(ErrMissingBlankLineAtBeginning+ColumnPositionTemplate, 8),
+		nil,
+	},
+}
+
+var testCasesForGitmoji = []testCase{
+	// VALID / gitmoji shortcode
+	{
+		"valid-gitmoji-shortcode",
+		[]byte(":sparkles: feat(ui): new button"),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Gitmoji:     cctesting.StringAddress(":sparkles:"),
+			Type:        "feat",
+			Scope:       cctesting.StringAddress("ui"),
+			Description: "new button",
+		},
+		&conventionalcommits.ConventionalCommit{
+			Gitmoji:     cctesting.StringAddress(":sparkles:"),
+			Type:        "feat",
+			Scope:       cctesting.StringAddress("ui"),
+			Description: "new button",
+		},
+		"",
+		&MinorVersion,
+	},
+	// VALID / unicode gitmoji
+	{
+		"valid-unicode-gitmoji",
+		[]byte("🐛 fix: a bug"),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Gitmoji:     cctesting.StringAddress("🐛"),
+			Type:        "fix",
+			Description: "a bug",
+		},
+		&conventionalcommits.ConventionalCommit{
+			Gitmoji:     cctesting.StringAddress("🐛"),
+			Type:        "fix",
+			Description: "a bug",
+		},
+		"",
+		&PatchVersion,
+	},
+	// VALID / unicode gitmoji with variation selector and without whitespace
+	{
+		"valid-unicode-gitmoji-with-variation-selector-without-whitespace",
+		[]byte("⚡️fix: faster"),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Gitmoji:     cctesting.StringAddress("⚡️"),
+			Type:        "fix",
+			Description: "faster",
+		},
+		&conventionalcommits.ConventionalCommit{
+			Gitmoji:     cctesting.StringAddress("⚡️"),
+			Type:        "fix",
+			Description: "faster",
+		},
+		"",
+		&PatchVersion,
+	},
+	// VALID / without gitmoji
+	{
+		"valid-without-gitmoji",
+		[]byte("fix: description"),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "fix",
+			Description: "description",
+		},
+		&conventionalcommits.ConventionalCommit{
+			Type:        "fix",
+			Description: "description",
+		},
+		"",
+		&PatchVersion,
+	},
+	// INVALID / illegal character in gitmoji shortcode
+	{
+		"invalid-character-in-gitmoji-shortcode",
+		[]byte(":spark les: feat: x"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrGitmoji+ColumnPositionTemplate, " ", 6),
+		nil,
+	},
+	// INVALID / incomplete gitmoji shortcode
+	{
+		"invalid-incomplete-gitmoji-shortcode",
+		[]byte(":sparkles"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrGitmojiIncomplete+ColumnPositionTemplate, "s", 9),
+		nil,
+	},
+	// INVALID / gitmoji without type
+	{
+		"invalid-gitmoji-without-type",
+		[]byte("✨ "),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrTypeIncomplete+ColumnPositionTemplate, " ", 2),
+		nil,
+	},
+	// INVALID / gitmoji with invalid type
+	{
+		"invalid-gitmoji-with-invalid-type",
+		[]byte("✨ new: x"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrType+ColumnPositionTemplate, "n", 2),
+		nil,
+	},
+}
+
+var testCasesForAngularTypes = []testCase{
+	// VALID / type and scope
+	{
+		"valid-type-and-scope",
+		[]byte("feat(compiler-cli): new flag"),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "feat",
+			Scope:       cctesting.StringAddress("compiler-cli"),
+			Description: "new flag",
+			TypeConfig:  4,
+		},
+		&conventionalcommits.ConventionalCommit{
+			Type:        "feat",
+			Scope:       cctesting.StringAddress("compiler-cli"),
+			Description: "new flag",
+			TypeConfig:  4,
+		},
+		"",
+		&MinorVersion,
+	},
+	// INVALID / type not in the Angular convention
+	{
+		"invalid-type",
+		[]byte("chore: x"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrType+ColumnPositionTemplate, "h", 1),
+		nil,
+	},
+	// INVALID / uppercase scope
+	{
+		"invalid-uppercase-scope",
+		[]byte("fix(Core): x"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrScope+ColumnPositionTemplate, "C", 4),
+		nil,
+	},
+	// INVALID / illegal character in scope
+	{
+		"invalid-character-in-scope",
+		[]byte("fix(core_ui): x"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrScope+ColumnPositionTemplate, "_", 8),
+		nil,
+	},
+}
+
+var testCasesForElectronTypes = []testCase{
+	// VALID / vendor type
+	{
+		"valid-vendor-type",
+		[]byte("vendor: bump chromium"),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "vendor",
+			Description: "bump chromium",
+			TypeConfig:  5,
+		},
+		&conventionalcommits.ConventionalCommit{
+			Type:        "vendor",
+			Description: "bump chromium",
+			TypeConfig:  5,
+		},
+		"",
+		&UnknownVersion,
+	},
+	// VALID / breaking change with scope
+	{
+		"valid-breaking-change-with-scope",
+		[]byte("refactor(api)!: remove deprecated method"),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "refactor",
+			Scope:       cctesting.StringAddress("api"),
+			Exclamation: true,
+			Description: "remove deprecated method",
+			TypeConfig:  5,
+		},
+		&conventionalcommits.ConventionalCommit{
+			Type:        "refactor",
+			Scope:       cctesting.StringAddress("api"),
+			Exclamation: true,
+			Description: "remove deprecated method",
+			TypeConfig:  5,
+		},
+		"",
+		&MajorVersion,
+	},
+	// INVALID / type not in the Electron convention
+	{
+		"invalid-type",
+		[]byte("revert: x"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrType+ColumnPositionTemplate, "v", 2),
+		nil,
+	},
+}
+
+var testCasesForESLintTypes = []testCase{
+	// VALID / capitalized tag
+	{
+		"valid-capitalized-tag",
+		[]byte("Update: support new syntax"),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "update",
+			Description: "support new syntax",
+			TypeConfig:  6,
+		},
+		&conventionalcommits.ConventionalCommit{
+			Type:        "update",
+			Description: "support new syntax",
+			TypeConfig:  6,
+		},
+		"",
+		&MinorVersion,
+	},
+	// VALID / breaking tag
+	{
+		"valid-breaking-tag",
+		[]byte("Breaking: drop node 10"),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "breaking",
+			Description: "drop node 10",
+			TypeConfig:  6,
+		},
+		&conventionalcommits.ConventionalCommit{
+			Type:        "breaking",
+			Description: "drop node 10",
+			TypeConfig:  6,
+		},
+		"",
+		&MajorVersion,
+	},
+	// INVALID / tag not in the ESLint convention
+	{
+		"invalid-tag",
+		[]byte("Feat: x"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrType+ColumnPositionTemplate, "e", 1),
+		nil,
+	},
+}
+
+var testCasesForLinuxKernelTypes = []testCase{
+	// VALID / subsystem path with fixes trailer
+	{
+		"valid-subsystem-path-with-fixes-trailer",
+		[]byte(`drm/i915: fix a null pointer dereference
+
+Check the pointer before using it.
+
+Fixes: 1234567890ab ("drm/i915: use the pointer")
+Signed-off-by: Jane Doe <jane@example.org>`),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "drm/i915",
+			Description: "fix a null pointer dereference",
+			Body:        cctesting.StringAddress("Check the pointer before using it."),
+			Footers: map[string][]string{
+				"fixes":         {`1234567890ab ("drm/i915: use the pointer")`},
+				"signed-off-by": {"Jane Doe <jane@example.org>"},
+			},
+			TypeConfig: 7,
+		},
+		&conventionalcommits.ConventionalCommit{
+			Type:        "drm/i915",
+			Description: "fix a null pointer dereference",
+			Body:        cctesting.StringAddress("Check the pointer before using it."),
+			Footers: map[string][]string{
+				"fixes":         {`1234567890ab ("drm/i915: use the pointer")`},
+				"signed-off-by": {"Jane Doe <jane@example.org>"},
+			},
+			TypeConfig: 7,
+		},
+		"",
+		&PatchVersion,
+	},
+	// VALID / nested subsystems
+	{
+		"valid-nested-subsystems",
+		[]byte("kvm: nVMX: truncate base/index GPR value"),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "kvm",
+			Description: "nVMX: truncate base/index GPR value",
+			TypeConfig:  7,
+		},
+		&conventionalcommits.ConventionalCommit{
+			Type:        "kvm",
+			Description: "nVMX: truncate base/index GPR value",
+			TypeConfig:  7,
+		},
+		"",
+		&UnknownVersion,
+	},
+	// INVALID / scope
+	{
+		"invalid-scope",
+		[]byte("kvm(nvmx): x"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrColon+ColumnPositionTemplate, "(", 3),
+		nil,
+	},
+	// INVALID / exclamation mark
+	{
+		"invalid-exclamation-mark",
+		[]byte("net!: x"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrColon+ColumnPositionTemplate, "!", 3),
+		nil,
+	},
+}
+
+var testCasesForTypeAliases = []testCase{
+	// VALID / feature alias
+	{
+		"valid-feature-alias",
+		[]byte("feature(ui): new button"),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "feat",
+			TypeAlias:   cctesting.StringAddress("feature"),
+			Scope:       cctesting.StringAddress("ui"),
+			Description: "new button",
+			TypeConfig:  1,
+		},
+		&conventionalcommits.ConventionalCommit{
+			Type:        "feat",
+			TypeAlias:   cctesting.StringAddress("feature"),
+			Scope:       cctesting.StringAddress("ui"),
+			Description: "new button",
+			TypeConfig:  1,
+		},
+		"",
+		&MinorVersion,
+	},
+	// VALID / bugfix alias keeps the original token
+	{
+		"valid-bugfix-alias-keeps-original-token",
+		[]byte("BugFix: crash"),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "fix",
+			TypeAlias:   cctesting.StringAddress("BugFix"),
+			Description: "crash",
+			TypeConfig:  1,
+		},
+		&conventionalcommits.ConventionalCommit{
+			Type:        "fix",
+			TypeAlias:   cctesting.StringAddress("BugFix"),
+			Description: "crash",
+			TypeConfig:  1,
+		},
+		"",
+		&PatchVersion,
+	},
+	// VALID / doc alias
+	{
+		"valid-doc-alias",
+		[]byte("doc: typo"),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "docs",
+			TypeAlias:   cctesting.StringAddress("doc"),
+			Description: "typo",
+			TypeConfig:  1,
+		},
+		&conventionalcommits.ConventionalCommit{
+			Type:        "docs",
+			TypeAlias:   cctesting.StringAddress("doc"),
+			Description: "typo",
+			TypeConfig:  1,
+		},
+		"",
+		&UnknownVersion,
+	},
+	// VALID / canonical type
+	{
+		"valid-canonical-type",
+		[]byte("docs: typo"),
+		true,
+		&conventionalcommits.ConventionalCommit{
+			Type:        "docs",
+			Description: "typo",
+			TypeConfig:  1,
+		},
+		&conventionalcommits.ConventionalCommit{
+			Type:        "docs",
+			Description: "typo",
+			TypeConfig:  1,
+		},
+		"",
+		&UnknownVersion,
+	},
+	// INVALID / unknown alias
+	{
+		"invalid-unknown-alias",
+		[]byte("features: x"),
+		false,
+		nil,
+		nil,
+		fmt.Sprintf(ErrColon+ColumnPositionTemplate, "s", 7),
+		nil,
+	},
 }
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package conventionalcommits
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// PersonTrailerKeys contains the keys of the footer trailers whose values are identities (eg., "Jane Doe <jane@example.com>").
+var PersonTrailerKeys = []string{
+	"signed-off-by",
+	"co-authored-by",
+	"reviewed-by",
+	"acked-by",
+	"tested-by",
+	"reported-by",
+	"suggested-by",
+}
+
+// emailAddress loosely matches the RFC 5322 addresses, also accepting the ones of the bots (eg., "dependabot[bot]@users.noreply.github.com").
+var emailAddress = regexp.MustCompile(`^[^\s<>@"(),:;\\]+@[[:alnum:]]([[:alnum:]-]*[[:alnum:]])?(\.[[:alnum:]]([[:alnum:]-]*[[:alnum:]])?)+$`)
+
+// Person represents an identity, with a name and an email address.
+type Person struct {
+	Name  string
+	Email string
+}
+
+// String returns the identity in the "Name <email>" form.
+func (p Person) String() string {
+	return fmt.Sprintf("%s <%s>", p.Name, p.Email)
+}
+
+// PersonTrailer represents a footer trailer whose value is an identity (eg., "Signed-off-by: Jane Doe <jane@example.com>").
+type PersonTrailer struct {
+	// Key is the lowercase key of the footer trailer (eg., "signed-off-by").
+	Key string
+	Person
+}
+
+// ParsePerson parses an identity in the "Name <email>" form.
+//
+// The name can not be empty, and the email address must look like a RFC 5322 address with a fully qualified domain.
+func ParsePerson(s string) (Person, error) {
+	s = strings.TrimSpace(s)
+	open := strings.LastIndexByte(s, '<')
+	if open < 0 || !strings.HasSuffix(s, ">") {
+		return Person{}, fmt.Errorf("missing email address in %q", s)
+	}
+	name := strings.TrimSpace(s[:open])
+	if name == "" {
+		return Person{}, fmt.Errorf("missing name in %q", s)
+	}
+	email := s[open+1 : len(s)-1]
+	if !emailAddress.MatchString(email) {
+		return Person{}, fmt.Errorf("invalid email address %q", email)
+	}
+
+	return Person{Name: name, Email: email}, nil
+}
+
+// PersonTrailers returns the footer trailers of the commit message whose values are identities (see PersonTrailerKeys).
+//
+// They are in the order of PersonTrailerKeys, then in order of appearance.
+// The values that are not valid identities are missing from the result, and the error tells about them.
+func PersonTrailers(m Message) ([]PersonTrailer, error) {
+	var res []PersonTrailer
+	var errs []error
+	for _, key := range PersonTrailerKeys {
+		for _, value := range m.FooterValues(key) {
+			p, err := ParsePerson(value)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", key, err))
+				continue
+			}
+			res = append(res, PersonTrailer{Key: key, Person: p})
+		}
+	}
+
+	return res, errors.Join(errs...)
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package conventionalcommits
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersonTrailers(t *testing.T) {
+	m := &ConventionalCommit{
+		Type:        "fix",
+		Description: "typo",
+		Footers: map[string][]string{
+			"co-authored-by": {"Jane Doe <jane@example.com>"},
+			"signed-off-by":  {"John Doe <JOHN@example.com>", "Jane Doe <jane@example.com>"},
+			"refs":           {"1"},
+			"reviewed-by":    {"Z"},
+			"acked-by":       {"<nobody@example.com>"},
+			"tested-by":      {"Tester <tester@example>"},
+		},
+	}
+	persons, err := PersonTrailers(m)
+	assert.Equal(t, []PersonTrailer{
+		{Key: "signed-off-by", Person: Person{Name: "John Doe", Email: "JOHN@example.com"}},
+		{Key: "signed-off-by", Person: Person{Name: "Jane Doe", Email: "jane@example.com"}},
+		{Key: "co-authored-by", Person: Person{Name: "Jane Doe", Email: "jane@example.com"}},
+	}, persons)
+	assert.EqualError(t, err, "reviewed-by: missing email address in \"Z\"\nacked-by: missing name in \"<nobody@example.com>\"\ntested-by: invalid email address \"tester@example\"")
+
+	persons, err = PersonTrailers(&ConventionalCommit{Type: "fix", Description: "typo"})
+	assert.NoError(t, err)
+	assert.Nil(t, persons)
+}
+
+func TestParsePerson(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Person
+		err   string
+	}{
+		{"Jane Doe <jane@example.com>", Person{Name: "Jane Doe", Email: "jane@example.com"}, ""},
+		{"  dependabot[bot] <49699333+dependabot[bot]@users.noreply.github.com> ", Person{Name: "dependabot[bot]", Email: "49699333+dependabot[bot]@users.noreply.github.com"}, ""},
+		{"J. R. \"Bob\" Dobbs <bob@sub.example.org>", Person{Name: "J. R. \"Bob\" Dobbs", Email: "bob@sub.example.org"}, ""},
+		{"Jane Doe", Person{}, `missing email address in "Jane Doe"`},
+		{"Jane Doe <jane@example.com", Person{}, `missing email address in "Jane Doe <jane@example.com"`},
+		{"Jane Doe <jane>", Person{}, `invalid email address "jane"`},
+		{"Jane Doe <jane doe@example.com>", Person{}, `invalid email address "jane doe@example.com"`},
+		{" <jane@example.com>", Person{}, `missing name in "<jane@example.com>"`},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.input, func(t *testing.T) {
+			p, err := ParsePerson(tc.input)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, p)
+			assert.Equal(t, strings.TrimSpace(tc.input), p.String())
+		})
+	}
+}
@@ -0,0 +1,263 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package conventionalcommits
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ReferenceAction represents what a commit message does to the issue or pull request it references.
+type ReferenceAction int
+
+const (
+	// ReferenceMention means the commit message only mentions the issue.
+	ReferenceMention ReferenceAction = iota
+	// ReferenceRefs means the commit message refers to the issue (eg., "Refs #133").
+	ReferenceRefs
+	// ReferenceCloses means the commit message closes the issue (eg., "Closes #133").
+	ReferenceCloses
+	// ReferenceFixes means the commit message fixes the issue (eg., "Fixes #133").
+	ReferenceFixes
+	// ReferenceResolves means the commit message resolves the issue (eg., "Resolves #133").
+	ReferenceResolves
+)
+
+var referenceActionNames = []string{
+	ReferenceMention:  "mention",
+	ReferenceRefs:     "refs",
+	ReferenceCloses:   "closes",
+	ReferenceFixes:    "fixes",
+	ReferenceResolves: "resolves",
+}
+
+// String returns the name of the reference action (eg., "closes").
+func (a ReferenceAction) String() string {
+	if a < 0 || int(a) >= len(referenceActionNames) {
+		return fmt.Sprintf("ReferenceAction(%d)", int(a))
+	}
+
+	return referenceActionNames[a]
+}
+
+// ReferenceLocation represents the part of the commit message containing a reference.
+type ReferenceLocation int
+
+const (
+	// ReferenceInDescription means the reference is in the description.
+	ReferenceInDescription ReferenceLocation = iota
+	// ReferenceInBody means the reference is in the body.
+	ReferenceInBody
+	// ReferenceInFooter means the reference is in the value of a footer trailer.
+	ReferenceInFooter
+)
+
+var referenceLocationNames = []string{
+	ReferenceInDescription: "description",
+	ReferenceInBody:        "body",
+	ReferenceInFooter:      "footer",
+}
+
+// String returns the name of the commit message part (eg., "body").
+func (l ReferenceLocation) String() string {
+	if l < 0 || int(l) >= len(referenceLocationNames) {
+		return fmt.Sprintf("ReferenceLocation(%d)", int(l))
+	}
+
+	return referenceLocationNames[l]
+}
+
+// Reference represents an issue or a pull request a commit message references.
+type Reference struct {
+	Action ReferenceAction
+	// Repository is the repository qualifier (eg., "owner/repo"), empty for the current repository.
+	Repository string
+	// Prefix is the prefix of the issue number (eg., "#", "!"), empty for the issue keys (eg., "JIRA-123").
+	Prefix string
+	// Key is the issue number (eg., "133") or the issue key (eg., "JIRA-123").
+	Key      string
+	Location ReferenceLocation
+	// Footer is the key of the footer trailer containing the reference, when in the footer.
+	Footer string
+	// Offset is the byte offset of the reference in the description, in the body, or in the footer trailer value.
+	Offset int
+}
+
+// String returns the reference as it would appear in a commit message (eg., "owner/repo#7").
+func (r Reference) String() string {
+	if r.Repository != "" {
+		return r.Repository + r.Prefix + r.Key
+	}
+
+	return r.Prefix + r.Key
+}
+
+type referenceConfig struct {
+	prefixes []string
+	projects []string
+	keys     bool
+}
+
+// ReferenceOption represents the type of option setters for the references extraction.
+type ReferenceOption func(c *referenceConfig)
+
+// WithReferencePrefixes sets the prefixes of the issue numbers (eg., "#" for GitHub, "!" for GitLab merge requests).
+//
+// The default is "#".
+func WithReferencePrefixes(prefixes ...string) ReferenceOption {
+	return func(c *referenceConfig) {
+		c.prefixes = prefixes
+	}
+}
+
+// WithReferenceKeys makes the references extraction also find the issue keys (eg., "JIRA-123").
+//
+// When projects are given (eg., "JIRA"), only the keys of such projects are references.
+// Otherwise, any uppercase project key is.
+func WithReferenceKeys(projects ...string) ReferenceOption {
+	return func(c *referenceConfig) {
+		c.keys = true
+		c.projects = projects
+	}
+}
+
+var (
+	referenceActions = map[string]ReferenceAction{
+		"close":      ReferenceCloses,
+		"closes":     ReferenceCloses,
+		"closed":     ReferenceCloses,
+		"fix":        ReferenceFixes,
+		"fixes":      ReferenceFixes,
+		"fixed":      ReferenceFixes,
+		"resolve":    ReferenceResolves,
+		"resolves":   ReferenceResolves,
+		"resolved":   ReferenceResolves,
+		"ref":        ReferenceRefs,
+		"refs":       ReferenceRefs,
+		"references": ReferenceRefs,
+	}
+	// referenceActionKeyword matches the action keywords preceding the references
+	referenceActionKeyword = regexp.MustCompile(`(?i)\b(close[sd]?|fix(?:e[sd])?|resolve[sd]?|refs?|references)\b`)
+	// referenceSeparator matches the text allowed between an action keyword and its references, or between two references
+	referenceSeparator = regexp.MustCompile(`(?i)^(?:[\s:,]|\band\b)*$`)
+)
+
+// pattern returns the regular expression matching the references, or nil when nothing can be a reference.
+func (c *referenceConfig) pattern() *regexp.Regexp {
+	var alternatives []string
+	if len(c.prefixes) > 0 {
+		prefixes := make([]string, len(c.prefixes))
+		for i, p := range c.prefixes {
+			prefixes[i] = regexp.QuoteMeta(p)
+		}
+		alternatives = append(alternatives, `(?:\b(?P<repo>[\w.-]+/[\w.-]+))?(?P<prefix>`+strings.Join(prefixes, "|")+`)(?P<number>\d+)\b`)
+	}
+	if c.keys {
+		projects := `[A-Z][A-Z0-9_]+`
+		if len(c.projects) > 0 {
+			quoted := make([]string, len(c.projects))
+			for i, p := range c.projects {
+				quoted[i] = regexp.QuoteMeta(p)
+			}
+			projects = `(?:` + strings.Join(quoted, "|") + `)`
+		}
+		alternatives = append(alternatives, `\b(?P<key>`+projects+`-\d+)\b`)
+	}
+	if len(alternatives) == 0 {
+		return nil
+	}
+
+	return regexp.MustCompile(strings.Join(alternatives, "|"))
+}
+
+// References returns the issues and the pull requests the commit message references.
+//
+// It looks for them in the description, in the body, and in the values of the footer trailers, in this order.
+// The footer trailers are in alphabetical order of their keys.
+// The action of a reference comes from the keyword preceding it (eg., "Closes #12, #14"),
+// or from the key of its footer trailer (eg., "Fixes: #7").
+// Since the parsers drop the "#" separating the footer trailer key from its value (eg., "Refs #133"),
+// a value of a footer trailer with an action key starting with a number is an issue number with the "#" prefix,
+// when "#" is among the prefixes.
+func References(m Message, options ...ReferenceOption) []Reference {
+	config := &referenceConfig{prefixes: []string{"#"}}
+	for _, opt := range options {
+		opt(config)
+	}
+	pattern := config.pattern()
+	if pattern == nil {
+		return nil
+	}
+	hash := false
+	for _, p := range config.prefixes {
+		hash = hash || p == "#"
+	}
+
+	res := findReferences(pattern, m.GetDescription(), ReferenceMention, ReferenceInDescription)
+	res = append(res, findReferences(pattern, m.GetBody(), ReferenceMention, ReferenceInBody)...)
+
+	for _, key := range m.FooterKeys() {
+		action, isAction := referenceActions[key]
+		for _, value := range m.FooterValues(key) {
+			shift := 0
+			if isAction && hash && len(value) > 0 && value[0] >= '0' && value[0] <= '9' {
+				value = "#" + value
+				shift = 1
+			}
+			refs := findReferences(pattern, value, action, ReferenceInFooter)
+			for i := range refs {
+				refs[i].Footer = key
+				if refs[i].Offset >= shift {
+					refs[i].Offset -= shift
+				}
+			}
+			res = append(res, refs...)
+		}
+	}
+
+	return res
+}
+
+// findReferences returns the references in the text.
+//
+// The references following an action keyword, or following such references, get its action.
+// The other ones get the given action.
+func findReferences(pattern *regexp.Regexp, text string, action ReferenceAction, location ReferenceLocation) []Reference {
+	var res []Reference
+
+	keywords := referenceActionKeyword.FindAllStringSubmatchIndex(text, -1)
+	current, last := action, 0
+	for _, m := range pattern.FindAllStringSubmatchIndex(text, -1) {
+		// Find the last action keyword before the reference
+		for len(keywords) > 0 && keywords[0][1] <= m[0] {
+			current = referenceActions[strings.ToLower(text[keywords[0][2]:keywords[0][3]])]
+			last = keywords[0][1]
+			keywords = keywords[1:]
+		}
+		if !referenceSeparator.MatchString(text[last:m[0]]) {
+			current = action
+		}
+		last = m[1]
+
+		ref := Reference{Action: current, Location: location, Offset: m[0]}
+		group := func(name string) string {
+			i := pattern.SubexpIndex(name)
+			if i < 0 || m[2*i] < 0 {
+				return ""
+			}
+			return text[m[2*i]:m[2*i+1]]
+		}
+		if key := group("key"); key != "" {
+			ref.Key = key
+		} else {
+			ref.Repository = group("repo")
+			ref.Prefix = group("prefix")
+			ref.Key = group("number")
+		}
+		res = append(res, ref)
+	}
+
+	return res
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package conventionalcommits
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// commit returns a commit message with the given description, body, and footer trailers.
+func commit(description, body string, footers map[string][]string) *ConventionalCommit {
+	c := &ConventionalCommit{Type: "fix", Description: description, Footers: footers}
+	if body != "" {
+		c.Body = &body
+	}
+
+	return c
+}
+
+func TestReferences(t *testing.T) {
+	ref := func(action ReferenceAction, repo, prefix, key string, location ReferenceLocation, footer string, offset int) Reference {
+		return Reference{Action: action, Repository: repo, Prefix: prefix, Key: key, Location: location, Footer: footer, Offset: offset}
+	}
+
+	tests := []struct {
+		title   string
+		message Message
+		options []ReferenceOption
+		want    []Reference
+	}{
+		{"no-references", commit("typo", "", nil), nil, nil},
+		{
+			"description-and-body",
+			commit("crash on start, see #3", "Fixes owner/repo#7 and #8.\nRelated to #9, closes #10, #11", nil),
+			nil,
+			[]Reference{
+				ref(ReferenceMention, "", "#", "3", ReferenceInDescription, "", 20),
+				ref(ReferenceFixes, "owner/repo", "#", "7", ReferenceInBody, "", 6),
+				ref(ReferenceFixes, "", "#", "8", ReferenceInBody, "", 23),
+				ref(ReferenceMention, "", "#", "9", ReferenceInBody, "", 38),
+				ref(ReferenceCloses, "", "#", "10", ReferenceInBody, "", 49),
+				ref(ReferenceCloses, "", "#", "11", ReferenceInBody, "", 54),
+			},
+		},
+		{
+			"footer",
+			commit("typo", "", map[string][]string{
+				"refs":        {"133"},
+				"closes":      {"#12, #14"},
+				"resolves":    {"owner/repo#2"},
+				"reviewed-by": {"Z"},
+			}),
+			nil,
+			[]Reference{
+				ref(ReferenceCloses, "", "#", "12", ReferenceInFooter, "closes", 0),
+				ref(ReferenceCloses, "", "#", "14", ReferenceInFooter, "closes", 5),
+				ref(ReferenceRefs, "", "#", "133", ReferenceInFooter, "refs", 0),
+				ref(ReferenceResolves, "owner/repo", "#", "2", ReferenceInFooter, "resolves", 0),
+			},
+		},
+		{
+			"gitlab-and-jira",
+			commit("typo", "Fixes JIRA-123, #4 and group/project!5", map[string][]string{"refs": {"ABC-1, LOWER-2"}}),
+			[]ReferenceOption{WithReferencePrefixes("!"), WithReferenceKeys("JIRA", "ABC")},
+			[]Reference{
+				ref(ReferenceFixes, "", "", "JIRA-123", ReferenceInBody, "", 6),
+				ref(ReferenceMention, "group/project", "!", "5", ReferenceInBody, "", 23),
+				ref(ReferenceRefs, "", "", "ABC-1", ReferenceInFooter, "refs", 0),
+			},
+		},
+		{
+			"any-project-key",
+			commit("typo", "Fixes PROJ-7", nil),
+			[]ReferenceOption{WithReferenceKeys()},
+			[]Reference{
+				ref(ReferenceFixes, "", "", "PROJ-7", ReferenceInBody, "", 6),
+			},
+		},
+		{"no-prefixes", commit("typo #1", "", nil), []ReferenceOption{WithReferencePrefixes()}, nil},
+		{"footer-without-hash-prefix", commit("typo", "", map[string][]string{"refs": {"133"}}), []ReferenceOption{WithReferencePrefixes("!")}, nil},
+		{
+			"footer-with-hash-among-prefixes",
+			commit("typo", "", map[string][]string{"refs": {"133"}}),
+			[]ReferenceOption{WithReferencePrefixes("!", "#")},
+			[]Reference{
+				ref(ReferenceRefs, "", "#", "133", ReferenceInFooter, "refs", 0),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.title, func(t *testing.T) {
+			assert.Equal(t, tc.want, References(tc.message, tc.options...))
+		})
+	}
+
+	assert.Equal(t, "owner/repo#7", ref(ReferenceFixes, "owner/repo", "#", "7", ReferenceInBody, "", 0).String())
+	assert.Equal(t, "closes", ReferenceCloses.String())
+	assert.Equal(t, "footer", ReferenceInFooter.String())
+}
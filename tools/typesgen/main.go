@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2020- Leonardo Di Donato <leodidonato@gmail.com>
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+)
+
+// source is the single source of truth about the commit message types of every type configuration.
+type source struct {
+	// Descriptions contains the default descriptions of the types.
+	Descriptions map[string]string `json:"descriptions"`
+	// Aliases maps the well-known aliases of the types to their canonical types.
+	Aliases map[string]string `json:"aliases"`
+	Configs []struct {
+		Name  string `json:"name"`
+		Const string `json:"const"`
+		// Types maps the types to their descriptions, empty for the default one.
+		Types map[string]string `json:"types"`
+	} `json:"configs"`
+}
+
+const header = "Code generated by tools/typesgen from common/types.json. DO NOT EDIT."
+
+func sorted(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// ragel generates the Ragel machines matching the types of every type configuration.
+func ragel(src *source) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%%%%{\n\n# %s\n\nmachine types;\n", header)
+	for _, c := range src.Configs {
+		fmt.Fprintf(&b, "\n%s_types_list = ", c.Name)
+		for i, t := range sorted(c.Types) {
+			if i > 0 {
+				b.WriteString(" | ")
+			}
+			fmt.Fprintf(&b, "'%s'i", t)
+		}
+		b.WriteString(";\n")
+	}
+	for _, c := range src.Configs {
+		fmt.Fprintf(&b, "\n%s_type_aliases_list = ", c.Name)
+		n := 0
+		for _, a := range sorted(src.Aliases) {
+			if _, ok := c.Types[src.Aliases[a]]; !ok {
+				continue
+			}
+			if n > 0 {
+				b.WriteString(" | ")
+			}
+			fmt.Fprintf(&b, "'%s'i", a)
+			n++
+		}
+		if n == 0 {
+			b.WriteString("empty")
+		}
+		b.WriteString(";\n")
+	}
+	b.WriteString("\n}%%\n")
+
+	return b.Bytes()
+}
+
+// golang generates the Go variables containing the types, and their descriptions, of every type configuration,
+// and the aliases of the types.
+func golang(src *source) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// %s\n\npackage conventionalcommits\n\n", header)
+	b.WriteString("var typeAliases = map[string]string{\n")
+	for _, a := range sorted(src.Aliases) {
+		if _, ok := src.Descriptions[src.Aliases[a]]; !ok {
+			return nil, fmt.Errorf("unknown canonical type %q for alias %q", src.Aliases[a], a)
+		}
+		fmt.Fprintf(&b, "%q: %q,\n", a, src.Aliases[a])
+	}
+	b.WriteString("}\n\n")
+	b.WriteString("var typeDescriptions = map[TypeConfig][]TypeDescription{\n")
+	for _, c := range src.Configs {
+		fmt.Fprintf(&b, "%s: {\n", c.Const)
+		for _, t := range sorted(c.Types) {
+			descr := c.Types[t]
+			if descr == "" {
+				descr = src.Descriptions[t]
+			}
+			if descr == "" {
+				return nil, fmt.Errorf("missing description for type %q of %s", t, c.Name)
+			}
+			fmt.Fprintf(&b, "{Name: %q, Description: %q},\n", t, descr)
+		}
+		b.WriteString("},\n")
+	}
+	b.WriteString("}\n")
+
+	return format.Source(b.Bytes())
+}
+
+func main() {
+	if len(os.Args) < 4 {
+		log.Fatal("usage: typesgen <types.json> <types.rl> <types.go>")
+	}
+
+	buf, err := os.ReadFile(os.Args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+	src := &source{}
+	if err := json.Unmarshal(buf, src); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(os.Args[2], ragel(src), 0644); err != nil {
+		log.Fatal(err)
+	}
+	code, err := golang(src)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(os.Args[3], code, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,78 @@
+// Code generated by tools/typesgen from common/types.json. DO NOT EDIT.
+
+package conventionalcommits
+
+var typeAliases = map[string]string{
+	"bugfix":  "fix",
+	"doc":     "docs",
+	"feature": "feat",
+	"hotfix":  "fix",
+	"tests":   "test",
+}
+
+var typeDescriptions = map[TypeConfig][]TypeDescription{
+	TypesMinimal: {
+		{Name: "feat", Description: "A new feature"},
+		{Name: "fix", Description: "A bug fix"},
+	},
+	TypesConventional: {
+		{Name: "build", Description: "Changes that affect the build system or external dependencies"},
+		{Name: "chore", Description: "Other changes that don't modify source or test files"},
+		{Name: "ci", Description: "Changes to the CI configuration files and scripts"},
+		{Name: "docs", Description: "Documentation only changes"},
+		{Name: "feat", Description: "A new feature"},
+		{Name: "fix", Description: "A bug fix"},
+		{Name: "perf", Description: "A code change that improves performance"},
+		{Name: "refactor", Description: "A code change that neither fixes a bug nor adds a feature"},
+		{Name: "revert", Description: "Reverts a previous commit"},
+		{Name: "style", Description: "Changes that do not affect the meaning of the code (white-space, formatting, missing semi-colons, etc)"},
+		{Name: "test", Description: "Adding missing tests or correcting existing tests"},
+	},
+	TypesFalco: {
+		{Name: "build", Description: "Changes that affect the build system or external dependencies"},
+		{Name: "chore", Description: "Other changes that don't modify source or test files"},
+		{Name: "ci", Description: "Changes to the CI configuration files and scripts"},
+		{Name: "docs", Description: "Documentation only changes"},
+		{Name: "feat", Description: "A new feature"},
+		{Name: "fix", Description: "A bug fix"},
+		{Name: "new", Description: "A new feature"},
+		{Name: "perf", Description: "A code change that improves performance"},
+		{Name: "revert", Description: "Reverts a previous commit"},
+		{Name: "rule", Description: "Changes to the Falco rules"},
+		{Name: "test", Description: "Adding missing tests or correcting existing tests"},
+		{Name: "update", Description: "An enhancement to an existing feature"},
+	},
+	TypesAngular: {
+		{Name: "build", Description: "Changes that affect the build system or external dependencies"},
+		{Name: "ci", Description: "Changes to the CI configuration files and scripts"},
+		{Name: "docs", Description: "Documentation only changes"},
+		{Name: "feat", Description: "A new feature"},
+		{Name: "fix", Description: "A bug fix"},
+		{Name: "perf", Description: "A code change that improves performance"},
+		{Name: "refactor", Description: "A code change that neither fixes a bug nor adds a feature"},
+		{Name: "test", Description: "Adding missing tests or correcting existing tests"},
+	},
+	TypesElectron: {
+		{Name: "build", Description: "Changes that affect the build system or external dependencies"},
+		{Name: "chore", Description: "Other changes that don't modify source or test files"},
+		{Name: "ci", Description: "Changes to the CI configuration files and scripts"},
+		{Name: "docs", Description: "Documentation only changes"},
+		{Name: "feat", Description: "A new feature"},
+		{Name: "fix", Description: "A bug fix"},
+		{Name: "perf", Description: "A code change that improves performance"},
+		{Name: "refactor", Description: "A code change that neither fixes a bug nor adds a feature"},
+		{Name: "style", Description: "Changes that do not affect the meaning of the code (white-space, formatting, missing semi-colons, etc)"},
+		{Name: "test", Description: "Adding missing tests or correcting existing tests"},
+		{Name: "vendor", Description: "Bumping a dependency (eg., Chromium, Node.js)"},
+	},
+	TypesESLint: {
+		{Name: "breaking", Description: "A backwards-incompatible change"},
+		{Name: "build", Description: "Changes to the build process only"},
+		{Name: "chore", Description: "Refactoring, testing infrastructure, or other changes not visible to the users"},
+		{Name: "docs", Description: "Documentation only changes"},
+		{Name: "fix", Description: "A bug fix"},
+		{Name: "new", Description: "A new feature"},
+		{Name: "update", Description: "A backwards-compatible enhancement to an existing feature"},
+		{Name: "upgrade", Description: "A dependency upgrade"},
+	},
+}